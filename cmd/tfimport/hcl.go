@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// labels generates unique resource labels from the names of the resources.
+type labels map[string]int
+
+func (l labels) next(resourceType string, name string) string {
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" {
+		label = "main"
+	}
+	if label[0] >= '0' && label[0] <= '9' {
+		label = "r_" + label
+	}
+
+	key := resourceType + "." + label
+	l[key]++
+	if l[key] > 1 {
+		return fmt.Sprintf("%s_%d", label, l[key])
+	}
+	return label
+}
+
+// writeImport appends an import block and the starter configuration of an imported resource to the file.
+func writeImport(file *hclwrite.File, resource *schema.Resource, resourceType string, label string, importID string, d *schema.ResourceData) {
+	body := file.Body()
+
+	importBlock := body.AppendNewBlock("import", nil).Body()
+	importBlock.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	importBlock.SetAttributeValue("id", cty.StringVal(importID))
+	body.AppendNewline()
+
+	resourceBlock := body.AppendNewBlock("resource", []string{resourceType, label}).Body()
+	sensitive := writeAttributes(resourceBlock, resource.SchemaMap(), func(key string) interface{} {
+		return d.Get(key)
+	})
+	if len(sensitive) > 0 {
+		resourceBlock.AppendUnstructuredTokens(hclwrite.Tokens{{
+			Type:  hclsyntax.TokenComment,
+			Bytes: []byte("# Sensitive arguments are not exported, set them before applying: " + strings.Join(sensitive, ", ") + "\n"),
+		}})
+	}
+	body.AppendNewline()
+}
+
+// writeAttributes writes the arguments of a schema with their current value.
+// Computed-only, deprecated and write-only attributes are skipped, as well as optional attributes left to their default value,
+// so that the generated configuration plans without changes.
+// It returns the sensitive arguments, which are never exported.
+func writeAttributes(body *hclwrite.Body, schemaMap map[string]*schema.Schema, get func(key string) interface{}) []string {
+	keys := make([]string, 0, len(schemaMap))
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	written := map[string]bool{}
+	sensitive := []string(nil)
+
+	for _, key := range keys {
+		s := schemaMap[key]
+		if !s.Optional && !s.Required || s.Deprecated != "" || s.WriteOnly {
+			continue
+		}
+		if s.Sensitive {
+			if s.Required {
+				sensitive = append(sensitive, key)
+			}
+			continue
+		}
+		if conflicts(s, written) {
+			continue
+		}
+
+		value := get(key)
+		if set, isSet := value.(*schema.Set); isSet {
+			value = set.List()
+		}
+		if !s.Required && isDefault(s, value) {
+			continue
+		}
+
+		if nested, isNested := s.Elem.(*schema.Resource); isNested {
+			for _, element := range value.([]interface{}) {
+				elementValues, _ := element.(map[string]interface{})
+				blockBody := body.AppendNewBlock(key, nil).Body()
+				sensitive = append(sensitive, writeAttributes(blockBody, nested.SchemaMap(), func(key string) interface{} {
+					return elementValues[key]
+				})...)
+			}
+		} else {
+			body.SetAttributeValue(key, toCtyValue(value))
+		}
+		written[key] = true
+	}
+
+	return sensitive
+}
+
+// conflicts returns true if an attribute conflicting with the given schema was already written.
+func conflicts(s *schema.Schema, written map[string]bool) bool {
+	for _, conflict := range s.ConflictsWith {
+		if written[conflict] {
+			return true
+		}
+	}
+	return false
+}
+
+// isDefault returns true if an optional attribute can be omitted without changing the plan.
+func isDefault(s *schema.Schema, value interface{}) bool {
+	if s.Default != nil {
+		return reflect.DeepEqual(s.Default, value)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func toCtyValue(value interface{}) cty.Value {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case *schema.Set:
+		return toCtyValue(v.List())
+	case []interface{}:
+		if len(v) == 0 {
+			return cty.EmptyTupleVal
+		}
+		values := make([]cty.Value, 0, len(v))
+		for _, element := range v {
+			values = append(values, toCtyValue(element))
+		}
+		return cty.TupleVal(values)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal
+		}
+		values := make(map[string]cty.Value, len(v))
+		for key, element := range v {
+			values[key] = toCtyValue(element)
+		}
+		return cty.ObjectVal(values)
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
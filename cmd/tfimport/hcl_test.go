@@ -0,0 +1,78 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabels(t *testing.T) {
+	l := labels{}
+
+	assert.Equal(t, "my_server", l.next("scaleway_instance_server", "My Server"))
+	assert.Equal(t, "my_server_2", l.next("scaleway_instance_server", "my-server"))
+	assert.Equal(t, "my_server", l.next("scaleway_instance_ip", "my-server"))
+	assert.Equal(t, "r_51_15_1_1", l.next("scaleway_instance_ip", "51.15.1.1"))
+	assert.Equal(t, "main", l.next("scaleway_vpc", "---"))
+}
+
+func TestWriteImport(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":        {Type: schema.TypeString, Optional: true, Computed: true},
+			"type":        {Type: schema.TypeString, Required: true},
+			"password":    {Type: schema.TypeString, Required: true, Sensitive: true},
+			"enable_ipv6": {Type: schema.TypeBool, Optional: true, Default: true},
+			"protected":   {Type: schema.TypeBool, Optional: true},
+			"tags":        {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"ip":          {Type: schema.TypeString, Computed: true},
+			"image":       {Type: schema.TypeString, Optional: true, ConflictsWith: []string{"snapshot"}},
+			"snapshot":    {Type: schema.TypeString, Optional: true, ConflictsWith: []string{"image"}},
+			"root_volume": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size_in_gb": {Type: schema.TypeInt, Optional: true},
+					},
+				},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name":        "web",
+		"type":        "DEV1-S",
+		"password":    "secret",
+		"enable_ipv6": true,
+		"protected":   false,
+		"tags":        []interface{}{"front", "prod"},
+		"image":       "ubuntu",
+		"snapshot":    "snap",
+		"root_volume": []interface{}{map[string]interface{}{"size_in_gb": 20}},
+	})
+	_ = d.Set("ip", "51.15.1.1")
+
+	file := hclwrite.NewEmptyFile()
+	writeImport(file, resource, "scaleway_instance_server", "web", "fr-par-1/11111111-1111-1111-1111-111111111111", d)
+
+	assert.Equal(t, `import {
+  to = scaleway_instance_server.web
+  id = "fr-par-1/11111111-1111-1111-1111-111111111111"
+}
+
+resource "scaleway_instance_server" "web" {
+  image = "ubuntu"
+  name  = "web"
+  root_volume {
+    size_in_gb = 20
+  }
+  tags = ["front", "prod"]
+  type = "DEV1-S"
+  # Sensitive arguments are not exported, set them before applying: password
+}
+
+`, string(file.Bytes()))
+}
@@ -0,0 +1,196 @@
+// tfimport generates import blocks and the starter configuration of the resources of a project.
+//
+// It scans a project in a zone and a region with the list endpoints of the Scaleway APIs,
+// then reads every resource found with the provider, as terraform would after an import,
+// so that the generated configuration matches the schema of the provider and plans without changes.
+//
+//	go run ./cmd/tfimport -project-id 11111111-1111-1111-1111-111111111111 -zone fr-par-1 -output imports.tf
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
+)
+
+func main() {
+	var (
+		projectID     = flag.String("project-id", "", "ID of the project to scan, defaults to the project of the active profile")
+		zone          = flag.String("zone", "", "Zone to scan for zonal resources, defaults to the zone of the active profile")
+		region        = flag.String("region", "", "Region to scan for regional resources, defaults to the region of the zone")
+		resourceTypes = flag.String("types", "", "Comma separated list of resource types to scan, defaults to every supported type")
+		output        = flag.String("output", "", "File to write the configuration to, defaults to the standard output")
+		list          = flag.Bool("list", false, "List the supported resource types")
+	)
+	flag.Parse()
+
+	if *list {
+		for _, s := range scanners {
+			fmt.Println(s.ResourceType)
+		}
+		return
+	}
+
+	ctx := context.Background()
+
+	m, err := meta.NewMeta(ctx, &meta.Config{
+		TerraformVersion: "tfimport",
+		ForceZone:        scw.Zone(*zone),
+		ForceProjectID:   *projectID,
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	s, err := newScope(m.ScwClient(), *region)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	selectedScanners, err := selectScanners(*resourceTypes)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	file, err := generate(ctx, m, s, selectedScanners)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if _, err := file.WriteTo(w); err != nil {
+		log.Fatalln(err) //nolint:gocritic
+	}
+}
+
+// newScope returns the scope to scan, using the defaults of the client for the values not given as flags.
+func newScope(client *scw.Client, region string) (scope, error) {
+	s := scope{}
+
+	projectID, exists := client.GetDefaultProjectID()
+	if !exists {
+		return s, fmt.Errorf("no project ID, please set -project-id")
+	}
+	s.ProjectID = projectID
+
+	zone, exists := client.GetDefaultZone()
+	if !exists {
+		return s, fmt.Errorf("no zone, please set -zone")
+	}
+	s.Zone = zone
+
+	if region == "" {
+		r, err := zone.Region()
+		if err != nil {
+			return s, err
+		}
+		s.Region = r
+	} else {
+		r, err := scw.ParseRegion(region)
+		if err != nil {
+			return s, err
+		}
+		s.Region = r
+	}
+
+	return s, nil
+}
+
+// selectScanners returns the scanners of the given comma separated resource types, or every scanner.
+func selectScanners(resourceTypes string) ([]scanner, error) {
+	if resourceTypes == "" {
+		return scanners, nil
+	}
+
+	selected := []scanner(nil)
+	for _, resourceType := range strings.Split(resourceTypes, ",") {
+		found := false
+		for _, s := range scanners {
+			if s.ResourceType == strings.TrimSpace(resourceType) {
+				selected = append(selected, s)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unsupported resource type %q, use -list to list the supported ones", resourceType)
+		}
+	}
+	return selected, nil
+}
+
+// generate scans the resources and returns the file of their import blocks and configuration.
+func generate(ctx context.Context, m *meta.Meta, s scope, selectedScanners []scanner) (*hclwrite.File, error) {
+	resources := provider.Provider(provider.DefaultConfig())().ResourcesMap
+	file := hclwrite.NewEmptyFile()
+	usedLabels := labels{}
+
+	for _, sc := range selectedScanners {
+		resource, exists := resources[sc.ResourceType]
+		if !exists || resource.Importer == nil {
+			return nil, fmt.Errorf("resource %s cannot be imported", sc.ResourceType)
+		}
+
+		discovered, err := sc.Scan(ctx, m.ScwClient(), s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", sc.ResourceType, err)
+		}
+		log.Printf("found %d %s", len(discovered), sc.ResourceType)
+
+		for _, r := range discovered {
+			d, err := importResource(ctx, resource, r.ID, m)
+			if err != nil {
+				return nil, fmt.Errorf("failed to import %s %s: %w", sc.ResourceType, r.ID, err)
+			}
+			if d == nil {
+				log.Printf("%s %s disappeared while it was imported, skipping it", sc.ResourceType, r.ID)
+				continue
+			}
+
+			writeImport(file, resource, sc.ResourceType, usedLabels.next(sc.ResourceType, r.Name), r.ID, d)
+		}
+	}
+
+	return file, nil
+}
+
+// importResource imports and reads a resource the same way terraform does for an import block.
+// It returns nil if the resource does not exist anymore.
+func importResource(ctx context.Context, resource *schema.Resource, id string, m *meta.Meta) (*schema.ResourceData, error) {
+	imported, err := resource.Importer.StateContext(ctx, resource.Data(&terraform.InstanceState{ID: id}), m)
+	if err != nil {
+		return nil, err
+	}
+	if len(imported) == 0 {
+		return nil, nil
+	}
+
+	state, diags := resource.RefreshWithoutUpgrade(ctx, imported[0].State(), m)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s", diags[0].Summary)
+	}
+	if state == nil || state.ID == "" {
+		return nil, nil
+	}
+
+	return resource.Data(state), nil
+}
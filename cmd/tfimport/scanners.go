@@ -0,0 +1,374 @@
+package main
+
+import (
+	"context"
+
+	container "github.com/scaleway/scaleway-sdk-go/api/container/v1beta1"
+	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/registry/v1"
+	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	rdbservice "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/rdb"
+)
+
+// scope is the project and localities to scan.
+type scope struct {
+	ProjectID string
+	Zone      scw.Zone
+	Region    scw.Region
+}
+
+// discoveredResource is a resource found by a scanner.
+// Its ID uses the format expected by the importer of its resource type.
+type discoveredResource struct {
+	ID   string
+	Name string
+}
+
+// scanner lists the resources of a type using the list endpoint of its API.
+type scanner struct {
+	ResourceType string
+	Scan         func(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error)
+}
+
+// scanners are the resource types that can be imported, in the order of the generated file.
+var scanners = []scanner{
+	{ResourceType: "scaleway_vpc", Scan: scanVPCs},
+	{ResourceType: "scaleway_vpc_private_network", Scan: scanPrivateNetworks},
+	{ResourceType: "scaleway_instance_security_group", Scan: scanInstanceSecurityGroups},
+	{ResourceType: "scaleway_instance_ip", Scan: scanInstanceIPs},
+	{ResourceType: "scaleway_instance_volume", Scan: scanInstanceVolumes},
+	{ResourceType: "scaleway_instance_server", Scan: scanInstanceServers},
+	{ResourceType: "scaleway_lb", Scan: scanLBs},
+	{ResourceType: "scaleway_k8s_cluster", Scan: scanK8SClusters},
+	{ResourceType: "scaleway_k8s_pool", Scan: scanK8SPools},
+	{ResourceType: "scaleway_rdb_instance", Scan: scanRDBInstances},
+	{ResourceType: "scaleway_rdb_database", Scan: scanRDBDatabases},
+	{ResourceType: "scaleway_registry_namespace", Scan: scanRegistryNamespaces},
+	{ResourceType: "scaleway_container_namespace", Scan: scanContainerNamespaces},
+	{ResourceType: "scaleway_function_namespace", Scan: scanFunctionNamespaces},
+	{ResourceType: "scaleway_secret", Scan: scanSecrets},
+}
+
+func scanVPCs(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	res, err := vpc.NewAPI(client).ListVPCs(&vpc.ListVPCsRequest{
+		Region:    s.Region,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.Vpcs))
+	for _, v := range res.Vpcs {
+		resources = append(resources, discoveredResource{ID: regional.NewIDString(v.Region, v.ID), Name: v.Name})
+	}
+	return resources, nil
+}
+
+func scanPrivateNetworks(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	res, err := vpc.NewAPI(client).ListPrivateNetworks(&vpc.ListPrivateNetworksRequest{
+		Region:    s.Region,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.PrivateNetworks))
+	for _, pn := range res.PrivateNetworks {
+		resources = append(resources, discoveredResource{ID: regional.NewIDString(pn.Region, pn.ID), Name: pn.Name})
+	}
+	return resources, nil
+}
+
+func scanInstanceSecurityGroups(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	res, err := instance.NewAPI(client).ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+		Zone:    s.Zone,
+		Project: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.SecurityGroups))
+	for _, sg := range res.SecurityGroups {
+		resources = append(resources, discoveredResource{ID: zonal.NewIDString(sg.Zone, sg.ID), Name: sg.Name})
+	}
+	return resources, nil
+}
+
+func scanInstanceIPs(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	res, err := instance.NewAPI(client).ListIPs(&instance.ListIPsRequest{
+		Zone:    s.Zone,
+		Project: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.IPs))
+	for _, ip := range res.IPs {
+		resources = append(resources, discoveredResource{ID: zonal.NewIDString(ip.Zone, ip.ID), Name: ip.Address.String()})
+	}
+	return resources, nil
+}
+
+// scanInstanceVolumes lists the volumes that are not the root volume of a server, which are managed by scaleway_instance_server.
+func scanInstanceVolumes(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	api := instance.NewAPI(client)
+
+	servers, err := api.ListServers(&instance.ListServersRequest{
+		Zone:    s.Zone,
+		Project: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+	rootVolumes := map[string]bool{}
+	for _, server := range servers.Servers {
+		if rootVolume, ok := server.Volumes["0"]; ok {
+			rootVolumes[rootVolume.ID] = true
+		}
+	}
+
+	res, err := api.ListVolumes(&instance.ListVolumesRequest{
+		Zone:    s.Zone,
+		Project: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.Volumes))
+	for _, volume := range res.Volumes {
+		if rootVolumes[volume.ID] {
+			continue
+		}
+		resources = append(resources, discoveredResource{ID: zonal.NewIDString(volume.Zone, volume.ID), Name: volume.Name})
+	}
+	return resources, nil
+}
+
+func scanInstanceServers(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	res, err := instance.NewAPI(client).ListServers(&instance.ListServersRequest{
+		Zone:    s.Zone,
+		Project: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.Servers))
+	for _, server := range res.Servers {
+		resources = append(resources, discoveredResource{ID: zonal.NewIDString(server.Zone, server.ID), Name: server.Name})
+	}
+	return resources, nil
+}
+
+func scanLBs(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	res, err := lb.NewZonedAPI(client).ListLBs(&lb.ZonedAPIListLBsRequest{
+		Zone:      s.Zone,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.LBs))
+	for _, l := range res.LBs {
+		resources = append(resources, discoveredResource{ID: zonal.NewIDString(l.Zone, l.ID), Name: l.Name})
+	}
+	return resources, nil
+}
+
+func listK8SClusters(ctx context.Context, client *scw.Client, s scope) ([]*k8s.Cluster, error) {
+	res, err := k8s.NewAPI(client).ListClusters(&k8s.ListClustersRequest{
+		Region:    s.Region,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+	return res.Clusters, nil
+}
+
+func scanK8SClusters(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	clusters, err := listK8SClusters(ctx, client, s)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(clusters))
+	for _, cluster := range clusters {
+		resources = append(resources, discoveredResource{ID: regional.NewIDString(cluster.Region, cluster.ID), Name: cluster.Name})
+	}
+	return resources, nil
+}
+
+func scanK8SPools(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	clusters, err := listK8SClusters(ctx, client, s)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []discoveredResource(nil)
+	for _, cluster := range clusters {
+		res, err := k8s.NewAPI(client).ListPools(&k8s.ListPoolsRequest{
+			Region:    cluster.Region,
+			ClusterID: cluster.ID,
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return nil, err
+		}
+		for _, pool := range res.Pools {
+			resources = append(resources, discoveredResource{ID: regional.NewIDString(pool.Region, pool.ID), Name: cluster.Name + "_" + pool.Name})
+		}
+	}
+	return resources, nil
+}
+
+func listRDBInstances(ctx context.Context, client *scw.Client, s scope) ([]*rdb.Instance, error) {
+	res, err := rdb.NewAPI(client).ListInstances(&rdb.ListInstancesRequest{
+		Region:    s.Region,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+	return res.Instances, nil
+}
+
+func scanRDBInstances(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	instances, err := listRDBInstances(ctx, client, s)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(instances))
+	for _, i := range instances {
+		resources = append(resources, discoveredResource{ID: regional.NewIDString(i.Region, i.ID), Name: i.Name})
+	}
+	return resources, nil
+}
+
+// scanRDBDatabases lists the databases created by users, the managed ones such as rdb are skipped.
+func scanRDBDatabases(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	instances, err := listRDBInstances(ctx, client, s)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []discoveredResource(nil)
+	for _, i := range instances {
+		res, err := rdb.NewAPI(client).ListDatabases(&rdb.ListDatabasesRequest{
+			Region:     i.Region,
+			InstanceID: i.ID,
+			Managed:    scw.BoolPtr(false),
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return nil, err
+		}
+		for _, database := range res.Databases {
+			resources = append(resources, discoveredResource{ID: rdbservice.ResourceRdbDatabaseID(i.Region, i.ID, database.Name), Name: i.Name + "_" + database.Name})
+		}
+	}
+	return resources, nil
+}
+
+// scanRegistryNamespaces lists the registry namespaces, except the ones created by container and function namespaces.
+func scanRegistryNamespaces(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	managed := map[string]bool{}
+	containerNamespaces, err := container.NewAPI(client).ListNamespaces(&container.ListNamespacesRequest{
+		Region:    s.Region,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+	for _, ns := range containerNamespaces.Namespaces {
+		managed[ns.RegistryNamespaceID] = true
+	}
+	functionNamespaces, err := function.NewAPI(client).ListNamespaces(&function.ListNamespacesRequest{
+		Region:    s.Region,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+	for _, ns := range functionNamespaces.Namespaces {
+		managed[ns.RegistryNamespaceID] = true
+	}
+
+	res, err := registry.NewAPI(client).ListNamespaces(&registry.ListNamespacesRequest{
+		Region:    s.Region,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.Namespaces))
+	for _, ns := range res.Namespaces {
+		if managed[ns.ID] {
+			continue
+		}
+		resources = append(resources, discoveredResource{ID: regional.NewIDString(ns.Region, ns.ID), Name: ns.Name})
+	}
+	return resources, nil
+}
+
+func scanContainerNamespaces(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	res, err := container.NewAPI(client).ListNamespaces(&container.ListNamespacesRequest{
+		Region:    s.Region,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.Namespaces))
+	for _, ns := range res.Namespaces {
+		resources = append(resources, discoveredResource{ID: regional.NewIDString(ns.Region, ns.ID), Name: ns.Name})
+	}
+	return resources, nil
+}
+
+func scanFunctionNamespaces(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	res, err := function.NewAPI(client).ListNamespaces(&function.ListNamespacesRequest{
+		Region:    s.Region,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.Namespaces))
+	for _, ns := range res.Namespaces {
+		resources = append(resources, discoveredResource{ID: regional.NewIDString(ns.Region, ns.ID), Name: ns.Name})
+	}
+	return resources, nil
+}
+
+func scanSecrets(ctx context.Context, client *scw.Client, s scope) ([]discoveredResource, error) {
+	res, err := secret.NewAPI(client).ListSecrets(&secret.ListSecretsRequest{
+		Region:    s.Region,
+		ProjectID: &s.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]discoveredResource, 0, len(res.Secrets))
+	for _, sec := range res.Secrets {
+		resources = append(resources, discoveredResource{ID: regional.NewIDString(sec.Region, sec.ID), Name: sec.Name})
+	}
+	return resources, nil
+}
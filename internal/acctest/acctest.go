@@ -8,7 +8,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest/fakeapi"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
@@ -21,7 +23,11 @@ type TestTools struct {
 	T                 *testing.T
 	Meta              *meta.Meta
 	ProviderFactories map[string]func() (*schema.Provider, error)
-	Cleanup           func()
+	// ProtoV5ProviderFactories also serve the resources written with terraform-plugin-framework
+	ProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error)
+	Cleanup                  func()
+	// Fake is the fake of the Scaleway APIs used by the provider, when created with NewFakeTestTools
+	Fake *fakeapi.Server
 }
 
 func NewTestTools(t *testing.T) *TestTools {
@@ -58,6 +64,15 @@ func NewTestTools(t *testing.T) *TestTools {
 				return provider.Provider(&provider.Config{Meta: m})(), nil
 			},
 		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"scaleway": func() (tfprotov5.ProviderServer, error) {
+				providerServer, err := provider.NewProviderServer(ctx, &provider.Config{Meta: m})
+				if err != nil {
+					return nil, err
+				}
+				return providerServer(), nil
+			},
+		},
 		Cleanup: cleanup,
 	}
 }
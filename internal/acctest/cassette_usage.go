@@ -0,0 +1,193 @@
+package acctest
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	// UnusedInteractionsReport logs the unused interactions of a cassette
+	UnusedInteractionsReport = "report"
+	// UnusedInteractionsFail fails the test if its cassette has unused interactions,
+	// and the requests that do not match the next interaction of their method and path
+	UnusedInteractionsFail = "fail"
+	// UnusedInteractionsPrune removes the unused interactions from the cassette
+	UnusedInteractionsPrune = "prune"
+)
+
+// UnusedInteractions sets what to do with the interactions of a replayed cassette that the test did not use.
+// It is checked once the test succeeded, see UnusedInteractionsReport, UnusedInteractionsFail and UnusedInteractionsPrune.
+var UnusedInteractions = flag.String("cassettes-unused", os.Getenv("TF_CASSETTES_UNUSED"), "What to do with the unused interactions of replayed cassettes: report, fail or prune")
+
+// cassetteUsage tracks the interactions of a cassette that a test replayed.
+//
+// Interactions are replayed in the order they were recorded for each method and path:
+// a request replays the first unused interaction of its method and path that matches its query and body.
+// Recorded interactions skipped this way stay available for requests sent concurrently,
+// and are reported if they are still unused at the end of the test.
+// In strict mode, used with UnusedInteractionsFail, nothing can be skipped: a request only replays the first unused
+// interaction of its method and path, and fails if it does not match it.
+type cassetteUsage struct {
+	mu sync.Mutex
+
+	cassette *cassette.Cassette
+	strict   bool
+	// interactions lists the indexes of the interactions for each method and path, in the order they were recorded
+	interactions map[string][]int
+	used         []bool
+	// skippedBy is the interaction replayed before an unused one of the same method and path
+	skippedBy map[int]int
+
+	// next is the interaction that request should replay
+	request *http.Request
+	next    int
+}
+
+func newCassetteUsage(cassetteName string, strict bool) (*cassetteUsage, error) {
+	c, err := cassette.Load(cassetteName)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &cassetteUsage{
+		cassette:     c,
+		strict:       strict,
+		interactions: make(map[string][]int),
+		used:         make([]bool, len(c.Interactions)),
+		skippedBy:    make(map[int]int),
+		next:         -1,
+	}
+	for index, i := range c.Interactions {
+		key, err := recordedRequestKey(i.Request)
+		if err != nil {
+			return nil, fmt.Errorf("interaction %d of cassette %s: %w", index, cassetteName, err)
+		}
+		u.interactions[key] = append(u.interactions[key], index)
+	}
+	return u, nil
+}
+
+func requestKey(method string, u *url.URL) string {
+	return method + " " + u.Path
+}
+
+func recordedRequestKey(r cassette.Request) (string, error) {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return "", err
+	}
+	return requestKey(r.Method, u), nil
+}
+
+// matcher replaces cassetteMatcher in the recorder. It only matches the interaction that the request should replay
+// and marks it as used, the recorder replays the first interaction matched.
+func (u *cassetteUsage) matcher(actual *http.Request, expected cassette.Request) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if actual != u.request {
+		u.request = actual
+		u.next = u.nextInteraction(actual)
+	}
+	if u.next < 0 || !reflect.DeepEqual(expected, u.cassette.Interactions[u.next].Request) {
+		return false
+	}
+
+	key := requestKey(actual.Method, actual.URL)
+	for _, index := range u.interactions[key] {
+		if index == u.next {
+			break
+		}
+		if _, skipped := u.skippedBy[index]; !u.used[index] && !skipped {
+			u.skippedBy[index] = u.next
+		}
+	}
+	u.used[u.next] = true
+	u.request = nil
+	u.next = -1
+	return true
+}
+
+// nextInteraction returns the first unused interaction of the method and path of the request that matches it, or -1.
+// In strict mode, it returns -1 if the first unused interaction of the method and path does not match the request.
+func (u *cassetteUsage) nextInteraction(actual *http.Request) int {
+	for _, index := range u.interactions[requestKey(actual.Method, actual.URL)] {
+		if u.used[index] {
+			continue
+		}
+		if cassetteMatcher(actual, u.cassette.Interactions[index].Request) {
+			return index
+		}
+		if u.strict {
+			return -1
+		}
+	}
+	return -1
+}
+
+// unused returns the description of the interactions that were not replayed.
+func (u *cassetteUsage) unused() []string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	descriptions := []string(nil)
+	for index, i := range u.cassette.Interactions {
+		if u.used[index] {
+			continue
+		}
+		description := fmt.Sprintf("interaction %d (%s %s) was not used", index, i.Request.Method, i.Request.URL)
+		if skippedBy, skipped := u.skippedBy[index]; skipped {
+			description += fmt.Sprintf(", interaction %d of the same method and path was replayed before it", skippedBy)
+		}
+		descriptions = append(descriptions, description)
+	}
+	return descriptions
+}
+
+// report handles the unused interactions of the cassette according to the mode, see UnusedInteractions.
+func (u *cassetteUsage) report(t *testing.T, mode string) {
+	t.Helper()
+	unused := u.unused()
+	if len(unused) == 0 {
+		return
+	}
+
+	switch mode {
+	case UnusedInteractionsFail:
+		for _, description := range unused {
+			t.Errorf("cassette %s: %s", u.cassette.File, description)
+		}
+		t.Errorf("cassette %s: remove the unused interactions with -cassettes-unused=%s or record the cassette again", u.cassette.File, UnusedInteractionsPrune)
+	case UnusedInteractionsPrune:
+		u.mu.Lock()
+		defer u.mu.Unlock()
+		for index, i := range u.cassette.Interactions {
+			i.DiscardOnSave = !u.used[index]
+		}
+		if err := u.cassette.Save(); err != nil {
+			t.Errorf("cassette %s: failed to remove unused interactions: %s", u.cassette.File, err)
+			return
+		}
+		t.Logf("cassette %s: removed %d unused interactions", u.cassette.File, len(unused))
+	default:
+		for _, description := range unused {
+			t.Logf("cassette %s: %s", u.cassette.File, description)
+		}
+	}
+}
+
+func validateUnusedInteractionsMode(mode string) error {
+	switch mode {
+	case "", UnusedInteractionsReport, UnusedInteractionsFail, UnusedInteractionsPrune:
+		return nil
+	}
+	return fmt.Errorf("unknown mode %q for unused interactions, expected %s, %s or %s", mode, UnusedInteractionsReport, UnusedInteractionsFail, UnusedInteractionsPrune)
+}
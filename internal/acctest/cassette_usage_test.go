@@ -0,0 +1,130 @@
+package acctest_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const usageTestURL = "https://api.scaleway.com/test/v1/zones/fr-par-1/items"
+
+func usageTestInteraction(method string, url string, requestBody string, responseBody string) *cassette.Interaction {
+	return &cassette.Interaction{
+		Request: cassette.Request{Method: method, URL: url, Body: requestBody},
+		Response: cassette.Response{
+			Code:    http.StatusOK,
+			Headers: http.Header{"Content-Type": {"application/json"}},
+			Body:    responseBody,
+		},
+	}
+}
+
+func usageTestRequest(t *testing.T, client *http.Client, method string, body string) string {
+	t.Helper()
+	req, err := http.NewRequest(method, usageTestURL, bytes.NewReader([]byte(body)))
+	require.NoError(t, err)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	responseBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(responseBody)
+}
+
+func TestAccCassettes_UnusedInteractions(t *testing.T) {
+	if *acctest.UpdateCassettes {
+		t.Skip("the cassette of this test is written by the test")
+	}
+
+	require.NoError(t, os.MkdirAll("testdata", 0o755))
+	c := cassette.New("testdata/cassettes-unused-interactions.cassette")
+	for _, i := range []*cassette.Interaction{
+		usageTestInteraction(http.MethodGet, usageTestURL, "", `{"count":1}`),
+		usageTestInteraction(http.MethodPost, usageTestURL, `{"name":"first"}`, `{"name":"first"}`),
+		usageTestInteraction(http.MethodPost, usageTestURL, `{"name":"second"}`, `{"name":"second"}`),
+		usageTestInteraction(http.MethodGet, usageTestURL, "", `{"count":2}`),
+		usageTestInteraction(http.MethodDelete, usageTestURL, "", `{}`),
+	} {
+		c.AddInteraction(i)
+	}
+	require.NoError(t, c.Save())
+	defer func() {
+		_ = os.Remove("testdata/cassettes-unused-interactions.cassette.yaml")
+		_ = os.Remove("testdata")
+	}()
+
+	unusedInteractions := *acctest.UnusedInteractions
+	*acctest.UnusedInteractions = acctest.UnusedInteractionsPrune
+	defer func() { *acctest.UnusedInteractions = unusedInteractions }()
+
+	tt := acctest.NewTestTools(t)
+	client := tt.Meta.HTTPClient()
+
+	// Identical requests replay the interactions in the order they were recorded,
+	// requests with different bodies replay the interaction that matches them
+	assert.JSONEq(t, `{"count":1}`, usageTestRequest(t, client, http.MethodGet, ""))
+	assert.JSONEq(t, `{"name":"second"}`, usageTestRequest(t, client, http.MethodPost, `{"name":"second"}`))
+	assert.JSONEq(t, `{"count":2}`, usageTestRequest(t, client, http.MethodGet, ""))
+	assert.JSONEq(t, `{"name":"first"}`, usageTestRequest(t, client, http.MethodPost, `{"name":"first"}`))
+
+	_, err := client.Get(usageTestURL)
+	require.Error(t, err, "every GET interaction has been used")
+
+	tt.Cleanup()
+
+	pruned, err := cassette.Load("testdata/cassettes-unused-interactions.cassette")
+	require.NoError(t, err)
+	methods := []string(nil)
+	for _, i := range pruned.Interactions {
+		methods = append(methods, i.Request.Method)
+	}
+	assert.Equal(t, []string{http.MethodGet, http.MethodPost, http.MethodPost, http.MethodGet}, methods)
+}
+
+func TestAccCassettes_UnusedInteractionsOrder(t *testing.T) {
+	if *acctest.UpdateCassettes {
+		t.Skip("the cassette of this test is written by the test")
+	}
+
+	require.NoError(t, os.MkdirAll("testdata", 0o755))
+	c := cassette.New("testdata/cassettes-unused-interactions-order.cassette")
+	for _, i := range []*cassette.Interaction{
+		usageTestInteraction(http.MethodPost, usageTestURL, `{"name":"first"}`, `{"name":"first"}`),
+		usageTestInteraction(http.MethodPost, usageTestURL, `{"name":"second"}`, `{"name":"second"}`),
+	} {
+		c.AddInteraction(i)
+	}
+	require.NoError(t, c.Save())
+	defer func() {
+		_ = os.Remove("testdata/cassettes-unused-interactions-order.cassette.yaml")
+		_ = os.Remove("testdata")
+	}()
+
+	unusedInteractions := *acctest.UnusedInteractions
+	*acctest.UnusedInteractions = acctest.UnusedInteractionsFail
+	defer func() { *acctest.UnusedInteractions = unusedInteractions }()
+
+	tt := acctest.NewTestTools(t)
+	defer tt.Cleanup()
+	client := tt.Meta.HTTPClient()
+
+	// In fail mode, a request cannot skip the first unused interaction of its method and path
+	req, err := http.NewRequest(http.MethodPost, usageTestURL, bytes.NewReader([]byte(`{"name":"second"}`)))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	_, err = client.Do(req)
+	require.Error(t, err, "the first POST interaction has not been used")
+
+	assert.JSONEq(t, `{"name":"first"}`, usageTestRequest(t, client, http.MethodPost, `{"name":"first"}`))
+	assert.JSONEq(t, `{"name":"second"}`, usageTestRequest(t, client, http.MethodPost, `{"name":"second"}`))
+}
@@ -0,0 +1,63 @@
+package acctest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest/fakeapi"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/require"
+)
+
+// NewFakeTestTools returns test tools whose provider sends its requests to an in-memory fake of the
+// Instance, VPC, IPAM and Load Balancer APIs instead of recording or replaying cassettes.
+// Tests using them run without credentials nor network access, and can inspect the fake with TestTools.Fake.
+func NewFakeTestTools(t *testing.T) *TestTools {
+	t.Helper()
+	ctx := context.Background()
+
+	fake := fakeapi.New()
+
+	m, err := meta.NewMeta(ctx, &meta.Config{
+		ProviderSchema:      nil,
+		TerraformVersion:    "terraform-tests",
+		ForceZone:           scw.ZoneFrPar1,
+		ForceProjectID:      fakeapi.ProjectID,
+		ForceOrganizationID: fakeapi.OrganizationID,
+		ForceAccessKey:      fakeapi.AccessKey,
+		ForceSecretKey:      fakeapi.SecretKey,
+		HTTPClient:          fake.Client(),
+	})
+	require.NoError(t, err)
+
+	// The fake reaches the final state of an object after a few reads, there is no need to wait between them
+	tmp := 0 * time.Second
+	transport.DefaultWaitRetryInterval = &tmp
+
+	return &TestTools{
+		T:    t,
+		Meta: m,
+		Fake: fake,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"scaleway": func() (*schema.Provider, error) {
+				return provider.Provider(&provider.Config{Meta: m})(), nil
+			},
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"scaleway": func() (tfprotov5.ProviderServer, error) {
+				providerServer, err := provider.NewProviderServer(ctx, &provider.Config{Meta: m})
+				if err != nil {
+					return nil, err
+				}
+				return providerServer(), nil
+			},
+		},
+		Cleanup: func() {},
+	}
+}
@@ -0,0 +1,79 @@
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	instancechecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+	lbchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/lb/testfuncs"
+	vpcchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc/testfuncs"
+)
+
+func TestAccFake_ServerBehindLoadBalancer(t *testing.T) {
+	tt := acctest.NewFakeTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			instancechecks.IsServerDestroyed(tt),
+			lbchecks.IsIPDestroyed(tt),
+			vpcchecks.CheckPrivateNetworkDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_vpc_private_network" "main" {
+						name = "tf-tests-fake"
+					}
+
+					resource "scaleway_instance_server" "main" {
+						name  = "tf-tests-fake"
+						type  = "DEV1-S"
+						image = "ubuntu_jammy"
+						state = "started"
+
+						private_network {
+							pn_id = scaleway_vpc_private_network.main.id
+						}
+					}
+
+					resource "scaleway_lb_ip" "main" {}
+
+					resource "scaleway_lb" "main" {
+						name   = "tf-tests-fake"
+						type   = "LB-S"
+						ip_ids = [scaleway_lb_ip.main.id]
+
+						private_network {
+							private_network_id = scaleway_vpc_private_network.main.id
+						}
+					}
+
+					resource "scaleway_lb_backend" "main" {
+						lb_id            = scaleway_lb.main.id
+						forward_protocol = "tcp"
+						forward_port     = 80
+						server_ips       = ["10.0.0.1"]
+					}
+
+					resource "scaleway_lb_frontend" "main" {
+						lb_id        = scaleway_lb.main.id
+						backend_id   = scaleway_lb_backend.main.id
+						inbound_port = 80
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					vpcchecks.IsPrivateNetworkPresent(tt, "scaleway_vpc_private_network.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "state", "started"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "private_network.0.status", "available"),
+					resource.TestCheckResourceAttrSet("scaleway_instance_server.main", "private_network.0.mac_address"),
+					resource.TestCheckResourceAttrPair("scaleway_lb.main", "ip_address", "scaleway_lb_ip.main", "ip_address"),
+					resource.TestCheckResourceAttr("scaleway_lb.main", "private_network.0.status", "ready"),
+					resource.TestCheckResourceAttr("scaleway_lb.main", "private_network.0.ipam_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
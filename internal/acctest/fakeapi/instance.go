@@ -0,0 +1,1073 @@
+package fakeapi
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// serverTypes are the commercial types available in every zone, with the total size of their local volumes.
+var serverTypes = map[string]scw.Size{
+	"DEV1-S": 20 * scw.GB,
+	"DEV1-M": 40 * scw.GB,
+	"DEV1-L": 80 * scw.GB,
+	"GP1-XS": 150 * scw.GB,
+}
+
+type instanceState struct {
+	servers        map[string]*instance.Server
+	volumes        map[string]*instance.Volume
+	ips            map[string]*instance.IP
+	securityGroups map[string]*instance.SecurityGroup
+	// userData are the user data of each server, by server ID and key
+	userData map[string]map[string][]byte
+}
+
+func (s *Server) registerInstance() {
+	s.instance = instanceState{
+		servers:        map[string]*instance.Server{},
+		volumes:        map[string]*instance.Volume{},
+		ips:            map[string]*instance.IP{},
+		securityGroups: map[string]*instance.SecurityGroup{},
+		userData:       map[string]map[string][]byte{},
+	}
+
+	s.handle("GET /instance/v1/zones/{zone}/products/servers", s.listServerTypes)
+
+	s.handle("GET /instance/v1/zones/{zone}/servers", s.listServers)
+	s.handle("POST /instance/v1/zones/{zone}/servers", s.createServer)
+	s.handle("GET /instance/v1/zones/{zone}/servers/{id}", s.getServer)
+	s.handle("PATCH /instance/v1/zones/{zone}/servers/{id}", s.updateServer)
+	s.handle("DELETE /instance/v1/zones/{zone}/servers/{id}", s.deleteServer)
+	s.handle("POST /instance/v1/zones/{zone}/servers/{id}/action", s.serverAction)
+
+	s.handle("GET /instance/v1/zones/{zone}/servers/{id}/user_data", s.listServerUserData)
+	s.handle("GET /instance/v1/zones/{zone}/servers/{id}/user_data/{key}", s.getServerUserData)
+	s.handle("PATCH /instance/v1/zones/{zone}/servers/{id}/user_data/{key}", s.setServerUserData)
+	s.handle("DELETE /instance/v1/zones/{zone}/servers/{id}/user_data/{key}", s.deleteServerUserData)
+
+	s.handle("GET /instance/v1/zones/{zone}/servers/{id}/private_nics", s.listPrivateNICs)
+	s.handle("POST /instance/v1/zones/{zone}/servers/{id}/private_nics", s.createPrivateNIC)
+	s.handle("GET /instance/v1/zones/{zone}/servers/{id}/private_nics/{nic}", s.getPrivateNIC)
+	s.handle("PATCH /instance/v1/zones/{zone}/servers/{id}/private_nics/{nic}", s.updatePrivateNIC)
+	s.handle("DELETE /instance/v1/zones/{zone}/servers/{id}/private_nics/{nic}", s.deletePrivateNIC)
+
+	s.handle("GET /instance/v1/zones/{zone}/volumes", s.listVolumes)
+	s.handle("GET /instance/v1/zones/{zone}/volumes/{id}", s.getVolume)
+	s.handle("DELETE /instance/v1/zones/{zone}/volumes/{id}", s.deleteVolume)
+
+	s.handle("GET /instance/v1/zones/{zone}/ips", s.listIPs)
+	s.handle("POST /instance/v1/zones/{zone}/ips", s.createIP)
+	s.handle("GET /instance/v1/zones/{zone}/ips/{id}", s.getIP)
+	s.handle("PATCH /instance/v1/zones/{zone}/ips/{id}", s.updateIP)
+	s.handle("DELETE /instance/v1/zones/{zone}/ips/{id}", s.deleteIP)
+
+	s.handle("GET /instance/v1/zones/{zone}/security_groups", s.listSecurityGroups)
+	s.handle("GET /instance/v1/zones/{zone}/security_groups/{id}", s.getSecurityGroup)
+}
+
+func serverTypeNames() []string {
+	names := make([]string, 0, len(serverTypes))
+	for name := range serverTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (s *Server) listServerTypes(_ *request) (any, error) {
+	res := &instance.ListServersTypesResponse{
+		TotalCount: uint32(len(serverTypes)),
+		Servers:    map[string]*instance.ServerType{},
+	}
+	for name, localVolumeSize := range serverTypes {
+		res.Servers[name] = &instance.ServerType{
+			HourlyPrice: 0.01,
+			Ncpus:       2,
+			RAM:         2 * 1024 * 1024 * 1024,
+			Arch:        instance.ArchX86_64,
+			VolumesConstraint: &instance.ServerTypeVolumeConstraintSizes{
+				MinSize: 0,
+				MaxSize: localVolumeSize,
+			},
+			PerVolumeConstraint: &instance.ServerTypeVolumeConstraintsByType{
+				LSSD: &instance.ServerTypeVolumeConstraintSizes{
+					MinSize: scw.GB,
+					MaxSize: localVolumeSize,
+				},
+			},
+			Network: &instance.ServerTypeNetwork{IPv6Support: true},
+		}
+	}
+	return listResponse{body: res, totalCount: len(serverTypes)}, nil
+}
+
+func (s *Server) findServer(zone scw.Zone, id string) (*instance.Server, error) {
+	server, exists := s.instance.servers[id]
+	if !exists || server.Zone != zone {
+		return nil, notFound("instance_server", id)
+	}
+	return server, nil
+}
+
+func (s *Server) listServers(r *request) (any, error) {
+	privateNetworks := r.values("private_networks")
+	ids := r.values("servers")
+	servers := []*instance.Server(nil)
+	for _, server := range sorted(s.instance.servers) {
+		if server.Zone != r.zone() || !r.matchesName(server.Name) || !r.matchesTags(server.Tags) {
+			continue
+		}
+		if !r.matches(map[string]string{
+			"project":         server.Project,
+			"organization":    server.Organization,
+			"commercial_type": server.CommercialType,
+			"state":           string(server.State),
+			"with_ip":         "",
+			"without_ip":      "",
+		}) {
+			continue
+		}
+		if len(ids) > 0 && !slices.Contains(ids, server.ID) {
+			continue
+		}
+		if len(privateNetworks) > 0 && !slices.ContainsFunc(server.PrivateNics, func(nic *instance.PrivateNIC) bool {
+			return slices.Contains(privateNetworks, nic.PrivateNetworkID)
+		}) {
+			continue
+		}
+		servers = append(servers, server)
+	}
+
+	return listResponse{
+		body: &instance.ListServersResponse{
+			TotalCount: uint32(len(servers)),
+			Servers:    page(r, servers),
+		},
+		totalCount: len(servers),
+	}, nil
+}
+
+//gocyclo:ignore
+func (s *Server) createServer(r *request) (any, error) {
+	req := &instance.CreateServerRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	localVolumeSize, exists := serverTypes[req.CommercialType]
+	if !exists {
+		return nil, invalidArgument("commercial_type", fmt.Sprintf("%q is not a commercial type of zone %s", req.CommercialType, r.zone()))
+	}
+
+	projectID := project(req.Project)
+	server := &instance.Server{
+		ID:             s.newID(),
+		Name:           req.Name,
+		Organization:   OrganizationID,
+		Project:        projectID,
+		Tags:           append([]string{}, req.Tags...),
+		CommercialType: req.CommercialType,
+		CreationDate:   now(),
+		Hostname:       req.Name,
+		PublicIPs:      []*instance.ServerIP{},
+		State:          instance.ServerStateStopped,
+		BootType:       instance.BootTypeLocal,
+		Volumes:        map[string]*instance.VolumeServer{},
+		Arch:           instance.ArchX86_64,
+		PrivateNics:    []*instance.PrivateNIC{},
+		Zone:           r.zone(),
+		EnableIPv6:     scw.BoolPtr(false),
+	}
+	if server.Name == "" {
+		server.Name = "scw-" + server.ID[:8]
+		server.Hostname = server.Name
+	}
+	if req.DynamicIPRequired != nil {
+		server.DynamicIPRequired = *req.DynamicIPRequired
+	}
+	if req.EnableIPv6 != nil {
+		server.EnableIPv6 = req.EnableIPv6
+	}
+	if req.BootType != nil {
+		server.BootType = *req.BootType
+	}
+	server.RoutedIPEnabled = scw.BoolPtr(true)
+
+	if req.Image != nil {
+		image := s.localImage(*req.Image)
+		if image == nil {
+			return nil, notFound("instance_image", *req.Image)
+		}
+		server.Image = &instance.Image{
+			ID:     image.ID,
+			Name:   image.Label,
+			Arch:   instance.Arch(image.Arch),
+			Public: true,
+			State:  instance.ImageStateAvailable,
+			Zone:   image.Zone,
+		}
+	}
+
+	securityGroup, err := s.serverSecurityGroup(r.zone(), projectID, req.SecurityGroup)
+	if err != nil {
+		return nil, err
+	}
+	server.SecurityGroup = &instance.SecurityGroupSummary{ID: securityGroup.ID, Name: securityGroup.Name}
+
+	// Volumes
+	totalLocalSize := scw.Size(0)
+	for _, template := range req.Volumes {
+		if template.ID == nil && template.VolumeType != instance.VolumeVolumeTypeLSSD {
+			return nil, invalidArgument("volumes", "only local volumes can be created with a server")
+		}
+		if template.Size != nil {
+			totalLocalSize += *template.Size
+		}
+	}
+	if totalLocalSize > localVolumeSize {
+		return nil, invalidArgument("volumes", fmt.Sprintf("the total size of local volumes of %s must be at most %d", req.CommercialType, localVolumeSize))
+	}
+	if len(req.Volumes) == 0 && server.Image != nil {
+		req.Volumes = map[string]*instance.VolumeServerTemplate{"0": {VolumeType: instance.VolumeVolumeTypeLSSD}}
+	}
+	for index, template := range req.Volumes {
+		var volume *instance.Volume
+		if template.ID != nil {
+			volume, exists = s.instance.volumes[*template.ID]
+			if !exists || volume.Zone != r.zone() {
+				return nil, notFound("instance_volume", *template.ID)
+			}
+			if volume.Server != nil {
+				return nil, preconditionFailed("volume_in_use", "volume "+volume.ID+" is attached to another server")
+			}
+		} else {
+			volume = &instance.Volume{
+				ID:           s.newID(),
+				Name:         server.Name + "-vol-" + index,
+				Size:         localVolumeSize - totalLocalSize,
+				VolumeType:   instance.VolumeVolumeTypeLSSD,
+				CreationDate: now(),
+				Organization: OrganizationID,
+				Project:      projectID,
+				Tags:         []string{},
+				State:        instance.VolumeStateAvailable,
+				Zone:         r.zone(),
+			}
+			if template.Name != nil && *template.Name != "" {
+				volume.Name = *template.Name
+			}
+			if template.Size != nil {
+				volume.Size = *template.Size
+			}
+			s.instance.volumes[volume.ID] = volume
+		}
+		volume.Server = &instance.ServerSummary{ID: server.ID, Name: server.Name}
+		server.Volumes[index] = serverVolume(volume, index == "0")
+	}
+
+	// Public IPs
+	ipIDs := []string(nil)
+	if req.PublicIP != nil { //nolint:staticcheck
+		ipIDs = append(ipIDs, *req.PublicIP) //nolint:staticcheck
+	}
+	if req.PublicIPs != nil {
+		ipIDs = append(ipIDs, *req.PublicIPs...)
+	}
+	for _, ipID := range ipIDs {
+		if err := s.attachServerIP(server, ipID); err != nil {
+			return nil, err
+		}
+	}
+
+	s.instance.servers[server.ID] = server
+	s.instance.userData[server.ID] = map[string][]byte{}
+	securityGroup.Servers = append(securityGroup.Servers, &instance.ServerSummary{ID: server.ID, Name: server.Name})
+	return &instance.CreateServerResponse{Server: server}, nil
+}
+
+func serverVolume(volume *instance.Volume, boot bool) *instance.VolumeServer {
+	state := instance.VolumeServerState(volume.State)
+	return &instance.VolumeServer{
+		ID:           volume.ID,
+		Name:         &volume.Name,
+		Organization: &volume.Organization,
+		Project:      &volume.Project,
+		Server:       volume.Server,
+		Size:         &volume.Size,
+		VolumeType:   instance.VolumeServerVolumeType(volume.VolumeType),
+		CreationDate: volume.CreationDate,
+		State:        &state,
+		Boot:         boot,
+		Zone:         volume.Zone,
+	}
+}
+
+func (s *Server) getServer(r *request) (any, error) {
+	server, err := s.findServer(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	s.advance(server.ID)
+	if _, exists := s.instance.servers[server.ID]; !exists {
+		return nil, notFound("instance_server", server.ID)
+	}
+	return &instance.GetServerResponse{Server: server}, nil
+}
+
+func (s *Server) updateServer(r *request) (any, error) {
+	server, err := s.findServer(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &instance.UpdateServerRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		server.Name = *req.Name
+	}
+	if req.Tags != nil {
+		server.Tags = *req.Tags
+	}
+	if req.DynamicIPRequired != nil {
+		server.DynamicIPRequired = *req.DynamicIPRequired
+	}
+	if req.EnableIPv6 != nil {
+		server.EnableIPv6 = req.EnableIPv6
+	}
+	if req.Protected != nil {
+		server.Protected = *req.Protected
+	}
+	if req.BootType != nil {
+		server.BootType = *req.BootType
+	}
+	if req.CommercialType != nil {
+		if _, exists := serverTypes[*req.CommercialType]; !exists {
+			return nil, invalidArgument("commercial_type", fmt.Sprintf("%q is not a commercial type of zone %s", *req.CommercialType, r.zone()))
+		}
+		if server.State != instance.ServerStateStopped {
+			return nil, preconditionFailed("server_not_stopped", "the server must be stopped to change its commercial type")
+		}
+		server.CommercialType = *req.CommercialType
+	}
+	if req.SecurityGroup != nil {
+		securityGroup, err := s.serverSecurityGroup(r.zone(), server.Project, &req.SecurityGroup.ID)
+		if err != nil {
+			return nil, err
+		}
+		server.SecurityGroup = &instance.SecurityGroupSummary{ID: securityGroup.ID, Name: securityGroup.Name}
+	}
+	if req.PlacementGroup != nil && req.PlacementGroup.Null {
+		server.PlacementGroup = nil
+	}
+	if req.PublicIPs != nil {
+		for _, ip := range slices.Clone(server.PublicIPs) {
+			if !ip.Dynamic && !slices.Contains(*req.PublicIPs, ip.ID) {
+				s.detachServerIP(server, ip.ID)
+			}
+		}
+		for _, ipID := range *req.PublicIPs {
+			if slices.ContainsFunc(server.PublicIPs, func(ip *instance.ServerIP) bool { return ip.ID == ipID }) {
+				continue
+			}
+			if err := s.attachServerIP(server, ipID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if req.Volumes != nil {
+		if err := s.setServerVolumes(server, *req.Volumes); err != nil {
+			return nil, err
+		}
+	}
+	server.ModificationDate = now()
+
+	return &instance.UpdateServerResponse{Server: server}, nil
+}
+
+// setServerVolumes attaches the given volumes to a server and detaches the others.
+func (s *Server) setServerVolumes(server *instance.Server, templates map[string]*instance.VolumeServerTemplate) error {
+	volumes := map[string]*instance.VolumeServer{}
+	for index, template := range templates {
+		if template.ID == nil {
+			return invalidArgument("volumes", "volumes must be created before being attached to a server")
+		}
+		volume, exists := s.instance.volumes[*template.ID]
+		if !exists || volume.Zone != server.Zone {
+			return notFound("instance_volume", *template.ID)
+		}
+		if volume.Server != nil && volume.Server.ID != server.ID {
+			return preconditionFailed("volume_in_use", "volume "+volume.ID+" is attached to another server")
+		}
+		volume.Server = &instance.ServerSummary{ID: server.ID, Name: server.Name}
+		volumes[index] = serverVolume(volume, index == "0")
+	}
+	attached := map[string]bool{}
+	for _, volume := range volumes {
+		attached[volume.ID] = true
+	}
+	for _, current := range server.Volumes {
+		if volume, exists := s.instance.volumes[current.ID]; exists && !attached[volume.ID] {
+			volume.Server = nil
+		}
+	}
+	server.Volumes = volumes
+	return nil
+}
+
+func (s *Server) deleteServer(r *request) (any, error) {
+	server, err := s.findServer(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	if server.State != instance.ServerStateStopped {
+		return nil, preconditionFailed("server_not_stopped", "the server must be stopped to be deleted")
+	}
+
+	s.removeServer(server)
+	return nil, nil
+}
+
+// removeServer deletes a server, detaching its volumes and public IPs and releasing the IPs of its private NICs.
+func (s *Server) removeServer(server *instance.Server) {
+	for _, volume := range server.Volumes {
+		if v, exists := s.instance.volumes[volume.ID]; exists {
+			v.Server = nil
+		}
+	}
+	for _, ip := range slices.Clone(server.PublicIPs) {
+		s.detachServerIP(server, ip.ID)
+	}
+	for _, nic := range server.PrivateNics {
+		s.releaseResourceIPs(nic.ID)
+		s.forget(nic.ID)
+	}
+	for _, securityGroup := range s.instance.securityGroups {
+		securityGroup.Servers = slices.DeleteFunc(securityGroup.Servers, func(summary *instance.ServerSummary) bool {
+			return summary.ID == server.ID
+		})
+	}
+	delete(s.instance.servers, server.ID)
+	delete(s.instance.userData, server.ID)
+	s.forget(server.ID)
+}
+
+// serverAction runs an action on a server: the server goes through the transitional state of the action
+// and reaches its final state after being read again.
+func (s *Server) serverAction(r *request) (any, error) {
+	server, err := s.findServer(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &instance.ServerActionRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	if server.State == instance.ServerStateStarting || server.State == instance.ServerStateStopping {
+		return nil, transientState("instance_server", server.ID, string(server.State))
+	}
+
+	allowedStates := map[instance.ServerAction][]instance.ServerState{
+		instance.ServerActionPoweron:     {instance.ServerStateStopped, instance.ServerStateStoppedInPlace},
+		instance.ServerActionPoweroff:    {instance.ServerStateRunning, instance.ServerStateStoppedInPlace},
+		instance.ServerActionStopInPlace: {instance.ServerStateRunning},
+		instance.ServerActionReboot:      {instance.ServerStateRunning},
+		instance.ServerActionTerminate:   {instance.ServerStateRunning, instance.ServerStateStopped, instance.ServerStateStoppedInPlace},
+	}
+	if req.Action == instance.ServerActionEnableRoutedIP {
+		server.RoutedIPEnabled = scw.BoolPtr(true)
+		return serverActionResponse(server, req.Action), nil
+	}
+	states, isSupported := allowedStates[req.Action]
+	if !isSupported {
+		return nil, invalidArgument("action", fmt.Sprintf("action %q is not supported", req.Action))
+	}
+	if !slices.Contains(states, server.State) {
+		return nil, preconditionFailed("server_state", fmt.Sprintf("action %s cannot be run on a server in state %s", req.Action, server.State))
+	}
+
+	// The transitional state is reported by the first read of the server
+	s.then(server.ID, func() {})
+	switch req.Action {
+	case instance.ServerActionPoweron, instance.ServerActionReboot:
+		server.State = instance.ServerStateStarting
+		s.then(server.ID, func() {
+			server.State = instance.ServerStateRunning
+			s.allocateDynamicIP(server)
+		})
+	case instance.ServerActionPoweroff:
+		server.State = instance.ServerStateStopping
+		s.then(server.ID, func() {
+			server.State = instance.ServerStateStopped
+			s.releaseDynamicIP(server)
+		})
+	case instance.ServerActionStopInPlace:
+		server.State = instance.ServerStateStopping
+		s.then(server.ID, func() {
+			server.State = instance.ServerStateStoppedInPlace
+		})
+	case instance.ServerActionTerminate:
+		server.State = instance.ServerStateStopping
+		s.then(server.ID, func() {
+			for _, volume := range server.Volumes {
+				if volume.VolumeType == instance.VolumeServerVolumeTypeLSSD {
+					delete(s.instance.volumes, volume.ID)
+				}
+			}
+			s.removeServer(server)
+		})
+	}
+	server.ModificationDate = now()
+
+	return serverActionResponse(server, req.Action), nil
+}
+
+func serverActionResponse(server *instance.Server, action instance.ServerAction) *instance.ServerActionResponse {
+	return &instance.ServerActionResponse{
+		Task: &instance.Task{
+			ID:          server.ID,
+			Description: "server_" + string(action),
+			StartedAt:   now(),
+			Status:      instance.TaskStatusPending,
+			HrefFrom:    "/servers/" + server.ID + "/action",
+			Zone:        server.Zone,
+		},
+	}
+}
+
+// allocateDynamicIP gives a dynamic public IP to a started server that requires one and has none.
+func (s *Server) allocateDynamicIP(server *instance.Server) {
+	if !server.DynamicIPRequired || len(server.PublicIPs) > 0 {
+		return
+	}
+	ip := &instance.ServerIP{
+		ID:               s.newID(),
+		Address:          s.newPublicIP(),
+		Gateway:          net.IPv4(62, 210, 0, 1).To4(),
+		Netmask:          "32",
+		Family:           instance.ServerIPIPFamilyInet,
+		Dynamic:          true,
+		ProvisioningMode: instance.ServerIPProvisioningModeDHCP,
+		Tags:             []string{},
+		State:            instance.ServerIPStateAttached,
+	}
+	server.PublicIPs = append(server.PublicIPs, ip)
+	server.PublicIP = ip //nolint:staticcheck
+}
+
+// releaseDynamicIP releases the dynamic public IP of a stopped server.
+func (s *Server) releaseDynamicIP(server *instance.Server) {
+	server.PublicIPs = slices.DeleteFunc(server.PublicIPs, func(ip *instance.ServerIP) bool { return ip.Dynamic })
+	setServerPublicIP(server)
+}
+
+func setServerPublicIP(server *instance.Server) {
+	server.PublicIP = nil //nolint:staticcheck
+	if len(server.PublicIPs) > 0 {
+		server.PublicIP = server.PublicIPs[0] //nolint:staticcheck
+	}
+}
+
+func (s *Server) serverUserData(r *request) (map[string][]byte, error) {
+	server, err := s.findServer(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	return s.instance.userData[server.ID], nil
+}
+
+func (s *Server) listServerUserData(r *request) (any, error) {
+	userData, err := s.serverUserData(r)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	for key := range userData {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return &instance.ListServerUserDataResponse{UserData: keys}, nil
+}
+
+func (s *Server) getServerUserData(r *request) (any, error) {
+	userData, err := s.serverUserData(r)
+	if err != nil {
+		return nil, err
+	}
+
+	value, exists := userData[r.PathValue("key")]
+	if !exists {
+		return nil, notFound("instance_user_data", r.PathValue("key"))
+	}
+	return rawResponse(value), nil
+}
+
+func (s *Server) setServerUserData(r *request) (any, error) {
+	userData, err := s.serverUserData(r)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	userData[r.PathValue("key")] = value
+	return nil, nil
+}
+
+func (s *Server) deleteServerUserData(r *request) (any, error) {
+	userData, err := s.serverUserData(r)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(userData, r.PathValue("key"))
+	return nil, nil
+}
+
+func (s *Server) findPrivateNIC(r *request) (*instance.Server, *instance.PrivateNIC, error) {
+	server, err := s.findServer(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, nic := range server.PrivateNics {
+		if nic.ID == r.PathValue("nic") {
+			return server, nic, nil
+		}
+	}
+	return nil, nil, notFound("instance_private_nic", r.PathValue("nic"))
+}
+
+func (s *Server) listPrivateNICs(r *request) (any, error) {
+	server, err := s.findServer(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	nics := []*instance.PrivateNIC(nil)
+	for _, nic := range server.PrivateNics {
+		if r.matchesTags(nic.Tags) {
+			nics = append(nics, nic)
+		}
+	}
+	return listResponse{
+		body: &instance.ListPrivateNICsResponse{
+			PrivateNics: page(r, nics),
+			TotalCount:  uint64(len(nics)),
+		},
+		totalCount: len(nics),
+	}, nil
+}
+
+// createPrivateNIC attaches a server to a private network: the NIC is syncing until it is read again,
+// and an IP of the private network is booked for it unless IPs booked beforehand are given.
+func (s *Server) createPrivateNIC(r *request) (any, error) {
+	server, err := s.findServer(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &instance.CreatePrivateNICRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	region, err := r.zone().Region()
+	if err != nil {
+		return nil, invalidArgument("zone", err.Error())
+	}
+	if _, err := s.findPrivateNetwork(region, req.PrivateNetworkID); err != nil {
+		return nil, err
+	}
+	for _, nic := range server.PrivateNics {
+		if nic.PrivateNetworkID == req.PrivateNetworkID {
+			return nil, preconditionFailed("private_network_already_attached", "the server is already attached to private network "+req.PrivateNetworkID)
+		}
+	}
+
+	nic := &instance.PrivateNIC{
+		ID:               s.newID(),
+		ServerID:         server.ID,
+		PrivateNetworkID: req.PrivateNetworkID,
+		MacAddress:       s.newMACAddress(),
+		State:            instance.PrivateNICStateSyncing,
+		Tags:             append([]string{}, req.Tags...),
+	}
+	resource := &ipam.Resource{
+		Type:       ipam.ResourceTypeInstancePrivateNic,
+		ID:         nic.ID,
+		MacAddress: &nic.MacAddress,
+		Name:       &server.Name,
+	}
+	if len(req.IpamIPIDs) > 0 {
+		for _, ipID := range req.IpamIPIDs {
+			if _, err := s.attachResourceIP(region, ipID, req.PrivateNetworkID, resource); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		if _, err := s.bookResourceIP(region, server.Project, req.PrivateNetworkID, resource); err != nil {
+			return nil, err
+		}
+	}
+
+	server.PrivateNics = append(server.PrivateNics, nic)
+	s.then(nic.ID, func() {
+		nic.State = instance.PrivateNICStateAvailable
+	})
+	return &instance.CreatePrivateNICResponse{PrivateNic: nic}, nil
+}
+
+func (s *Server) getPrivateNIC(r *request) (any, error) {
+	_, nic, err := s.findPrivateNIC(r)
+	if err != nil {
+		return nil, err
+	}
+	s.advance(nic.ID)
+	return &instance.GetPrivateNICResponse{PrivateNic: nic}, nil
+}
+
+func (s *Server) updatePrivateNIC(r *request) (any, error) {
+	_, nic, err := s.findPrivateNIC(r)
+	if err != nil {
+		return nil, err
+	}
+	req := &instance.UpdatePrivateNICRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	if req.Tags != nil {
+		nic.Tags = *req.Tags
+	}
+	return nic, nil
+}
+
+func (s *Server) deletePrivateNIC(r *request) (any, error) {
+	server, nic, err := s.findPrivateNIC(r)
+	if err != nil {
+		return nil, err
+	}
+	if nic.State == instance.PrivateNICStateSyncing {
+		return nil, transientState("instance_private_nic", nic.ID, string(nic.State))
+	}
+
+	server.PrivateNics = slices.DeleteFunc(server.PrivateNics, func(n *instance.PrivateNIC) bool { return n.ID == nic.ID })
+	s.releaseResourceIPs(nic.ID)
+	s.forget(nic.ID)
+	return nil, nil
+}
+
+func (s *Server) findVolume(zone scw.Zone, id string) (*instance.Volume, error) {
+	volume, exists := s.instance.volumes[id]
+	if !exists || volume.Zone != zone {
+		return nil, notFound("instance_volume", id)
+	}
+	return volume, nil
+}
+
+func (s *Server) listVolumes(r *request) (any, error) {
+	volumes := []*instance.Volume(nil)
+	for _, volume := range sorted(s.instance.volumes) {
+		if volume.Zone != r.zone() || !r.matchesName(volume.Name) || !r.matchesTags(volume.Tags) {
+			continue
+		}
+		if !r.matches(map[string]string{
+			"project":      volume.Project,
+			"organization": volume.Organization,
+			"volume_type":  string(volume.VolumeType),
+		}) {
+			continue
+		}
+		volumes = append(volumes, volume)
+	}
+
+	return listResponse{
+		body: &instance.ListVolumesResponse{
+			TotalCount: uint32(len(volumes)),
+			Volumes:    page(r, volumes),
+		},
+		totalCount: len(volumes),
+	}, nil
+}
+
+func (s *Server) getVolume(r *request) (any, error) {
+	volume, err := s.findVolume(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	return &instance.GetVolumeResponse{Volume: volume}, nil
+}
+
+func (s *Server) deleteVolume(r *request) (any, error) {
+	volume, err := s.findVolume(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	if volume.Server != nil {
+		return nil, preconditionFailed("volume_in_use", "the volume is attached to server "+volume.Server.ID)
+	}
+
+	delete(s.instance.volumes, volume.ID)
+	return nil, nil
+}
+
+func (s *Server) findIP(zone scw.Zone, id string) (*instance.IP, error) {
+	// IPs can also be found by address
+	for _, ip := range s.instance.ips {
+		if ip.Zone == zone && (ip.ID == id || ip.Address.String() == id) {
+			return ip, nil
+		}
+	}
+	return nil, notFound("instance_ip", id)
+}
+
+// attachServerIP attaches a flexible IP to a server.
+func (s *Server) attachServerIP(server *instance.Server, id string) error {
+	ip, err := s.findIP(server.Zone, id)
+	if err != nil {
+		return err
+	}
+	if ip.Server != nil && ip.Server.ID != server.ID {
+		return preconditionFailed("ip_in_use", "IP "+ip.ID+" is attached to server "+ip.Server.ID)
+	}
+
+	serverIP := &instance.ServerIP{
+		ID:               ip.ID,
+		Address:          ip.Address,
+		Netmask:          "32",
+		Family:           instance.ServerIPIPFamilyInet,
+		ProvisioningMode: instance.ServerIPProvisioningModeDHCP,
+		Tags:             ip.Tags,
+		State:            instance.ServerIPStateAttached,
+	}
+	if ip.Type == instance.IPTypeRoutedIPv6 {
+		serverIP.Address = ip.Prefix.IP
+		serverIP.Netmask = "64"
+		serverIP.Family = instance.ServerIPIPFamilyInet6
+		serverIP.ProvisioningMode = instance.ServerIPProvisioningModeSlaac
+	}
+
+	// A flexible IP replaces the dynamic IP of the server
+	server.PublicIPs = slices.DeleteFunc(server.PublicIPs, func(i *instance.ServerIP) bool { return i.Dynamic })
+	server.PublicIPs = append(server.PublicIPs, serverIP)
+	setServerPublicIP(server)
+	ip.Server = &instance.ServerSummary{ID: server.ID, Name: server.Name}
+	ip.State = instance.IPStateAttached
+	return nil
+}
+
+// detachServerIP detaches a flexible IP from a server.
+func (s *Server) detachServerIP(server *instance.Server, id string) {
+	server.PublicIPs = slices.DeleteFunc(server.PublicIPs, func(i *instance.ServerIP) bool { return i.ID == id })
+	setServerPublicIP(server)
+	if ip, exists := s.instance.ips[id]; exists {
+		ip.Server = nil
+		ip.State = instance.IPStateDetached
+	}
+}
+
+func (s *Server) listIPs(r *request) (any, error) {
+	ips := []*instance.IP(nil)
+	for _, ip := range sorted(s.instance.ips) {
+		if ip.Zone != r.zone() || !r.matchesTags(ip.Tags) {
+			continue
+		}
+		if !r.matches(map[string]string{
+			"project":      ip.Project,
+			"organization": ip.Organization,
+			"type":         string(ip.Type),
+		}) {
+			continue
+		}
+		ips = append(ips, ip)
+	}
+
+	return listResponse{
+		body: &instance.ListIPsResponse{
+			TotalCount: uint32(len(ips)),
+			IPs:        page(r, ips),
+		},
+		totalCount: len(ips),
+	}, nil
+}
+
+func (s *Server) createIP(r *request) (any, error) {
+	req := &instance.CreateIPRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	ip := &instance.IP{
+		ID:           s.newID(),
+		Organization: OrganizationID,
+		Tags:         append([]string{}, req.Tags...),
+		Project:      project(req.Project),
+		Type:         req.Type,
+		State:        instance.IPStateDetached,
+		IpamID:       s.newID(),
+		Zone:         r.zone(),
+	}
+	switch ip.Type {
+	case instance.IPTypeRoutedIPv6:
+		ip.Prefix = scw.IPNet{IPNet: net.IPNet{IP: s.newPublicIPv6(), Mask: net.CIDRMask(64, 128)}}
+	case instance.IPTypeNat, instance.IPTypeRoutedIPv4:
+		ip.Address = s.newPublicIP()
+		ip.Prefix = scw.IPNet{IPNet: net.IPNet{IP: ip.Address, Mask: net.CIDRMask(32, 32)}}
+	default:
+		ip.Type = instance.IPTypeRoutedIPv4
+		ip.Address = s.newPublicIP()
+		ip.Prefix = scw.IPNet{IPNet: net.IPNet{IP: ip.Address, Mask: net.CIDRMask(32, 32)}}
+	}
+	s.instance.ips[ip.ID] = ip
+
+	if req.Server != nil {
+		server, err := s.findServer(r.zone(), *req.Server)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.attachServerIP(server, ip.ID); err != nil {
+			return nil, err
+		}
+	}
+	return &instance.CreateIPResponse{IP: ip}, nil
+}
+
+func (s *Server) getIP(r *request) (any, error) {
+	ip, err := s.findIP(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	return &instance.GetIPResponse{IP: ip}, nil
+}
+
+func (s *Server) updateIP(r *request) (any, error) {
+	ip, err := s.findIP(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &instance.UpdateIPRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	if req.Tags != nil {
+		ip.Tags = *req.Tags
+	}
+	if req.Reverse != nil {
+		ip.Reverse = nil
+		if !req.Reverse.Null {
+			ip.Reverse = &req.Reverse.Value
+		}
+	}
+	if req.Server != nil {
+		if ip.Server != nil {
+			if server, exists := s.instance.servers[ip.Server.ID]; exists {
+				s.detachServerIP(server, ip.ID)
+			}
+		}
+		if !req.Server.Null {
+			server, err := s.findServer(r.zone(), req.Server.Value)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.attachServerIP(server, ip.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &instance.UpdateIPResponse{IP: ip}, nil
+}
+
+func (s *Server) deleteIP(r *request) (any, error) {
+	ip, err := s.findIP(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	if ip.Server != nil {
+		if server, exists := s.instance.servers[ip.Server.ID]; exists {
+			s.detachServerIP(server, ip.ID)
+		}
+	}
+	delete(s.instance.ips, ip.ID)
+	return nil, nil
+}
+
+// serverSecurityGroup returns the security group of a new server, which is the default security group of its project
+// when none is given.
+func (s *Server) serverSecurityGroup(zone scw.Zone, projectID string, id *string) (*instance.SecurityGroup, error) {
+	if id != nil && *id != "" {
+		securityGroup, exists := s.instance.securityGroups[*id]
+		if !exists || securityGroup.Zone != zone {
+			return nil, notFound("instance_security_group", *id)
+		}
+		return securityGroup, nil
+	}
+
+	for _, securityGroup := range s.instance.securityGroups {
+		if securityGroup.Zone == zone && securityGroup.Project == projectID && securityGroup.ProjectDefault {
+			return securityGroup, nil
+		}
+	}
+	securityGroup := &instance.SecurityGroup{
+		ID:                    s.newID(),
+		Name:                  "Default security group",
+		Description:           "Auto generated security group.",
+		EnableDefaultSecurity: true,
+		InboundDefaultPolicy:  instance.SecurityGroupPolicyAccept,
+		OutboundDefaultPolicy: instance.SecurityGroupPolicyAccept,
+		Organization:          OrganizationID,
+		Project:               projectID,
+		Tags:                  []string{},
+		ProjectDefault:        true,
+		CreationDate:          now(),
+		ModificationDate:      now(),
+		Servers:               []*instance.ServerSummary{},
+		Stateful:              true,
+		State:                 instance.SecurityGroupStateAvailable,
+		Zone:                  zone,
+	}
+	s.instance.securityGroups[securityGroup.ID] = securityGroup
+	return securityGroup, nil
+}
+
+func (s *Server) listSecurityGroups(r *request) (any, error) {
+	securityGroups := []*instance.SecurityGroup(nil)
+	for _, securityGroup := range sorted(s.instance.securityGroups) {
+		if securityGroup.Zone != r.zone() || !r.matchesName(securityGroup.Name) || !r.matchesTags(securityGroup.Tags) {
+			continue
+		}
+		if !r.matches(map[string]string{
+			"project":         securityGroup.Project,
+			"organization":    securityGroup.Organization,
+			"project_default": strconv.FormatBool(securityGroup.ProjectDefault),
+		}) {
+			continue
+		}
+		securityGroups = append(securityGroups, securityGroup)
+	}
+
+	return listResponse{
+		body: &instance.ListSecurityGroupsResponse{
+			TotalCount:     uint32(len(securityGroups)),
+			SecurityGroups: page(r, securityGroups),
+		},
+		totalCount: len(securityGroups),
+	}, nil
+}
+
+func (s *Server) getSecurityGroup(r *request) (any, error) {
+	securityGroup, exists := s.instance.securityGroups[r.PathValue("id")]
+	if !exists || securityGroup.Zone != r.zone() {
+		return nil, notFound("instance_security_group", r.PathValue("id"))
+	}
+	return &instance.GetSecurityGroupResponse{SecurityGroup: securityGroup}, nil
+}
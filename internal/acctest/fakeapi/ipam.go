@@ -0,0 +1,344 @@
+package fakeapi
+
+import (
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+type ipamState struct {
+	ips map[string]*ipam.IP
+	// managed are the IPs booked by the other APIs, which are released with their resource
+	managed map[string]bool
+}
+
+func (s *Server) registerIPAM() {
+	s.ipam = ipamState{
+		ips:     map[string]*ipam.IP{},
+		managed: map[string]bool{},
+	}
+
+	s.handle("GET /ipam/v1/regions/{region}/ips", s.listIPAMIPs)
+	s.handle("POST /ipam/v1/regions/{region}/ips", s.bookIPAMIP)
+	s.handle("GET /ipam/v1/regions/{region}/ips/{id}", s.getIPAMIP)
+	s.handle("PATCH /ipam/v1/regions/{region}/ips/{id}", s.updateIPAMIP)
+	s.handle("DELETE /ipam/v1/regions/{region}/ips/{id}", s.releaseIPAMIP)
+	s.handle("POST /ipam/v1/regions/{region}/ips/{id}/attach", s.attachIPAMIP)
+	s.handle("POST /ipam/v1/regions/{region}/ips/{id}/detach", s.detachIPAMIP)
+	s.handle("POST /ipam/v1/regions/{region}/ips/{id}/move", s.moveIPAMIP)
+}
+
+func (s *Server) findIPAMIP(region scw.Region, id string) (*ipam.IP, error) {
+	ip, exists := s.ipam.ips[id]
+	if !exists || ip.Region != region {
+		return nil, notFound("ip", id)
+	}
+	return ip, nil
+}
+
+// bookIP books an IP in a private network, at the given address or at the first free address of its subnet.
+func (s *Server) bookIP(region scw.Region, projectID string, privateNetworkID string, isIPv6 bool, address *net.IP) (*ipam.IP, error) {
+	pn, err := s.findPrivateNetwork(region, privateNetworkID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subnet := range pn.Subnets {
+		if (subnet.Subnet.IP.To4() == nil) != isIPv6 {
+			continue
+		}
+		prefix, _ := netip.ParsePrefix(subnet.Subnet.String())
+
+		var addr netip.Addr
+		if address != nil {
+			addr, _ = netip.AddrFromSlice(*address)
+			addr = addr.Unmap()
+			if !prefix.Contains(addr) {
+				return nil, invalidArgument("address", "address is not in the subnet of the private network")
+			}
+			if s.ipamAddressInUse(addr) {
+				return nil, preconditionFailed("address_in_use", "address is already booked")
+			}
+		} else {
+			// The first addresses of a subnet are reserved for the network and its gateway
+			addr = prefix.Masked().Addr().Next().Next()
+			for s.ipamAddressInUse(addr) {
+				addr = addr.Next()
+			}
+			if !prefix.Contains(addr) {
+				return nil, preconditionFailed("subnet_full", "no address is available in the subnet of the private network")
+			}
+		}
+
+		ip := &ipam.IP{
+			ID:        s.newID(),
+			Address:   scw.IPNet{IPNet: net.IPNet{IP: addr.AsSlice(), Mask: subnet.Subnet.Mask}},
+			ProjectID: projectID,
+			IsIPv6:    isIPv6,
+			CreatedAt: now(),
+			UpdatedAt: now(),
+			Source: &ipam.Source{
+				PrivateNetworkID: &pn.ID,
+				SubnetID:         &subnet.ID,
+			},
+			Tags:     []string{},
+			Reverses: []*ipam.Reverse{},
+			Region:   region,
+		}
+		s.ipam.ips[ip.ID] = ip
+		return ip, nil
+	}
+
+	return nil, invalidArgument("is_ipv6", "the private network has no subnet of this IP family")
+}
+
+func (s *Server) ipamAddressInUse(addr netip.Addr) bool {
+	for _, ip := range s.ipam.ips {
+		if used, _ := netip.AddrFromSlice(ip.Address.IP); used.Unmap() == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// bookResourceIP books an IP in a private network for a resource of another API, such as a private NIC.
+// The IP is released with the resource.
+func (s *Server) bookResourceIP(region scw.Region, projectID string, privateNetworkID string, resource *ipam.Resource) (*ipam.IP, error) {
+	ip, err := s.bookIP(region, projectID, privateNetworkID, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	ip.Resource = resource
+	s.ipam.managed[ip.ID] = true
+	return ip, nil
+}
+
+// attachResourceIP attaches an IP booked beforehand to a resource of another API.
+func (s *Server) attachResourceIP(region scw.Region, id string, privateNetworkID string, resource *ipam.Resource) (*ipam.IP, error) {
+	ip, err := s.findIPAMIP(region, id)
+	if err != nil {
+		return nil, err
+	}
+	if ip.Source.PrivateNetworkID == nil || *ip.Source.PrivateNetworkID != privateNetworkID {
+		return nil, invalidArgument("ipam_ip_ids", "IP "+id+" is not in private network "+privateNetworkID)
+	}
+	if ip.Resource != nil {
+		return nil, preconditionFailed("resource_already_attached", "IP "+id+" is already attached to a resource")
+	}
+	ip.Resource = resource
+	ip.UpdatedAt = now()
+	return ip, nil
+}
+
+// resourceIPs returns the IPs attached to a resource of another API.
+func (s *Server) resourceIPs(resourceID string) []*ipam.IP {
+	ips := []*ipam.IP(nil)
+	for _, ip := range sorted(s.ipam.ips) {
+		if ip.Resource != nil && ip.Resource.ID == resourceID {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+// releaseResourceIPs releases the IPs booked for a deleted resource and detaches the others.
+func (s *Server) releaseResourceIPs(resourceID string) {
+	for _, ip := range s.resourceIPs(resourceID) {
+		if s.ipam.managed[ip.ID] {
+			delete(s.ipam.ips, ip.ID)
+			delete(s.ipam.managed, ip.ID)
+			continue
+		}
+		ip.Resource = nil
+		ip.UpdatedAt = now()
+	}
+}
+
+// releasePrivateNetworkIPs releases the IPs of a deleted private network.
+func (s *Server) releasePrivateNetworkIPs(privateNetworkID string) {
+	for id, ip := range s.ipam.ips {
+		if ip.Source.PrivateNetworkID != nil && *ip.Source.PrivateNetworkID == privateNetworkID {
+			delete(s.ipam.ips, id)
+			delete(s.ipam.managed, id)
+		}
+	}
+}
+
+func (s *Server) listIPAMIPs(r *request) (any, error) {
+	query := r.URL.Query()
+	resourceTypes := r.values("resource_types")
+	ips := []*ipam.IP(nil)
+	for _, ip := range sorted(s.ipam.ips) {
+		if ip.Region != r.region() || !r.matchesTags(ip.Tags) {
+			continue
+		}
+		filters := map[string]string{
+			"project_id":         ip.ProjectID,
+			"is_ipv6":            strconv.FormatBool(ip.IsIPv6),
+			"attached":           strconv.FormatBool(ip.Resource != nil),
+			"private_network_id": "",
+			"subnet_id":          "",
+			"vpc_id":             "",
+			"resource_id":        "",
+			"resource_type":      "",
+			"mac_address":        "",
+			"resource_name":      "",
+		}
+		if ip.Source.PrivateNetworkID != nil {
+			filters["private_network_id"] = *ip.Source.PrivateNetworkID
+			filters["subnet_id"] = *ip.Source.SubnetID
+			if pn, exists := s.vpc.privateNetworks[*ip.Source.PrivateNetworkID]; exists {
+				filters["vpc_id"] = pn.VpcID
+			}
+		}
+		if ip.Resource != nil {
+			filters["resource_id"] = ip.Resource.ID
+			filters["resource_type"] = string(ip.Resource.Type)
+			if ip.Resource.MacAddress != nil {
+				filters["mac_address"] = *ip.Resource.MacAddress
+			}
+			if ip.Resource.Name != nil {
+				filters["resource_name"] = *ip.Resource.Name
+			}
+		}
+		if !r.matches(filters) || query.Get("zonal") != "" {
+			continue
+		}
+		if len(resourceTypes) > 0 && (ip.Resource == nil || !slices.Contains(resourceTypes, string(ip.Resource.Type))) {
+			continue
+		}
+		ips = append(ips, ip)
+	}
+
+	return &ipam.ListIPsResponse{
+		IPs:        page(r, ips),
+		TotalCount: uint64(len(ips)),
+	}, nil
+}
+
+func (s *Server) bookIPAMIP(r *request) (any, error) {
+	req := &ipam.BookIPRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	if req.Source == nil || req.Source.PrivateNetworkID == nil {
+		return nil, invalidArgument("source", "only private network sources are supported")
+	}
+
+	ip, err := s.bookIP(r.region(), project(&req.ProjectID), *req.Source.PrivateNetworkID, req.IsIPv6, req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if req.Tags != nil {
+		ip.Tags = req.Tags
+	}
+	if req.Resource != nil {
+		ip.Resource = customResource(req.Resource)
+	}
+	return ip, nil
+}
+
+func (s *Server) getIPAMIP(r *request) (any, error) {
+	return s.findIPAMIP(r.region(), r.PathValue("id"))
+}
+
+func (s *Server) updateIPAMIP(r *request) (any, error) {
+	ip, err := s.findIPAMIP(r.region(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &ipam.UpdateIPRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	if req.Tags != nil {
+		ip.Tags = *req.Tags
+	}
+	if req.Reverses != nil {
+		ip.Reverses = req.Reverses
+	}
+	ip.UpdatedAt = now()
+	return ip, nil
+}
+
+func (s *Server) releaseIPAMIP(r *request) (any, error) {
+	ip, err := s.findIPAMIP(r.region(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	if ip.Resource != nil && ip.Resource.Type != ipam.ResourceTypeCustom {
+		return nil, preconditionFailed("resource_still_attached", "the IP is attached to a resource, detach it first")
+	}
+
+	delete(s.ipam.ips, ip.ID)
+	return nil, nil
+}
+
+func (s *Server) attachIPAMIP(r *request) (any, error) {
+	ip, err := s.findIPAMIP(r.region(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &ipam.AttachIPRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	if ip.Resource != nil {
+		return nil, preconditionFailed("resource_already_attached", "the IP is already attached to a resource")
+	}
+
+	ip.Resource = customResource(req.Resource)
+	ip.UpdatedAt = now()
+	return ip, nil
+}
+
+func (s *Server) detachIPAMIP(r *request) (any, error) {
+	ip, err := s.findIPAMIP(r.region(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	if ip.Resource == nil {
+		return nil, preconditionFailed("resource_not_attached", "the IP is not attached to a resource")
+	}
+
+	ip.Resource = nil
+	ip.UpdatedAt = now()
+	return ip, nil
+}
+
+func (s *Server) moveIPAMIP(r *request) (any, error) {
+	ip, err := s.findIPAMIP(r.region(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &ipam.MoveIPRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	if ip.Resource == nil {
+		return nil, preconditionFailed("resource_not_attached", "the IP is not attached to a resource")
+	}
+
+	ip.Resource = nil
+	if req.ToResource != nil {
+		ip.Resource = customResource(req.ToResource)
+	}
+	ip.UpdatedAt = now()
+	return ip, nil
+}
+
+func customResource(resource *ipam.CustomResource) *ipam.Resource {
+	if resource == nil {
+		return nil
+	}
+	return &ipam.Resource{
+		Type:       ipam.ResourceTypeCustom,
+		MacAddress: &resource.MacAddress,
+		Name:       resource.Name,
+	}
+}
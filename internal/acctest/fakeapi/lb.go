@@ -0,0 +1,866 @@
+package fakeapi
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// lbTypes are the Load Balancer types available in every zone.
+var lbTypes = []string{"LB-S", "LB-GP-M", "LB-GP-L", "LB-GP-XL"}
+
+type lbState struct {
+	lbs map[string]*lb.LB
+	ips map[string]*lb.IP
+	// privateNetworks are the private networks attached to each Load Balancer, by Load Balancer ID
+	privateNetworks map[string][]*lb.PrivateNetwork
+	backends        map[string]*lb.Backend
+	frontends       map[string]*lb.Frontend
+	acls            map[string]*lb.ACL
+}
+
+func (s *Server) registerLB() {
+	s.lb = lbState{
+		lbs:             map[string]*lb.LB{},
+		ips:             map[string]*lb.IP{},
+		privateNetworks: map[string][]*lb.PrivateNetwork{},
+		backends:        map[string]*lb.Backend{},
+		frontends:       map[string]*lb.Frontend{},
+		acls:            map[string]*lb.ACL{},
+	}
+
+	s.handle("GET /lb/v1/zones/{zone}/lbs", s.listLBs)
+	s.handle("POST /lb/v1/zones/{zone}/lbs", s.createLB)
+	s.handle("GET /lb/v1/zones/{zone}/lbs/{id}", s.getLB)
+	s.handle("PUT /lb/v1/zones/{zone}/lbs/{id}", s.updateLB)
+	s.handle("DELETE /lb/v1/zones/{zone}/lbs/{id}", s.deleteLB)
+
+	s.handle("GET /lb/v1/zones/{zone}/ips", s.listLBIPs)
+	s.handle("POST /lb/v1/zones/{zone}/ips", s.createLBIP)
+	s.handle("GET /lb/v1/zones/{zone}/ips/{id}", s.getLBIP)
+	s.handle("PATCH /lb/v1/zones/{zone}/ips/{id}", s.updateLBIP)
+	s.handle("DELETE /lb/v1/zones/{zone}/ips/{id}", s.releaseLBIP)
+
+	s.handle("GET /lb/v1/zones/{zone}/lbs/{id}/private-networks", s.listLBPrivateNetworks)
+	s.handle("POST /lb/v1/zones/{zone}/lbs/{id}/private-networks/{pn}/attach", s.attachLBPrivateNetwork)
+	s.handle("POST /lb/v1/zones/{zone}/lbs/{id}/private-networks/{pn}/detach", s.detachLBPrivateNetwork)
+
+	s.handle("GET /lb/v1/zones/{zone}/lbs/{id}/backends", s.listBackends)
+	s.handle("POST /lb/v1/zones/{zone}/lbs/{id}/backends", s.createBackend)
+	s.handle("GET /lb/v1/zones/{zone}/backends/{id}", s.getBackend)
+	s.handle("PUT /lb/v1/zones/{zone}/backends/{id}", s.updateBackend)
+	s.handle("DELETE /lb/v1/zones/{zone}/backends/{id}", s.deleteBackend)
+	s.handle("PUT /lb/v1/zones/{zone}/backends/{id}/servers", s.setBackendServers)
+	s.handle("PUT /lb/v1/zones/{zone}/backends/{id}/healthcheck", s.updateHealthCheck)
+
+	s.handle("GET /lb/v1/zones/{zone}/lbs/{id}/frontends", s.listFrontends)
+	s.handle("POST /lb/v1/zones/{zone}/lbs/{id}/frontends", s.createFrontend)
+	s.handle("GET /lb/v1/zones/{zone}/frontends/{id}", s.getFrontend)
+	s.handle("PUT /lb/v1/zones/{zone}/frontends/{id}", s.updateFrontend)
+	s.handle("DELETE /lb/v1/zones/{zone}/frontends/{id}", s.deleteFrontend)
+
+	s.handle("GET /lb/v1/zones/{zone}/frontends/{id}/acls", s.listACLs)
+	s.handle("POST /lb/v1/zones/{zone}/frontends/{id}/acls", s.createACL)
+	s.handle("GET /lb/v1/zones/{zone}/acls/{id}", s.getACL)
+	s.handle("PUT /lb/v1/zones/{zone}/acls/{id}", s.updateACL)
+	s.handle("DELETE /lb/v1/zones/{zone}/acls/{id}", s.deleteACL)
+}
+
+func (s *Server) findLB(zone scw.Zone, id string) (*lb.LB, error) {
+	loadBalancer, exists := s.lb.lbs[id]
+	if !exists || loadBalancer.Zone != zone {
+		return nil, notFound("lb", id)
+	}
+	return loadBalancer, nil
+}
+
+// findReadyLB returns a Load Balancer that can be changed, which is not the case while it is in a transitional status.
+func (s *Server) findReadyLB(zone scw.Zone, id string) (*lb.LB, error) {
+	loadBalancer, err := s.findLB(zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if loadBalancer.Status != lb.LBStatusReady {
+		return nil, transientState("lb", id, string(loadBalancer.Status))
+	}
+	return loadBalancer, nil
+}
+
+func (s *Server) listLBs(r *request) (any, error) {
+	lbs := []*lb.LB(nil)
+	for _, loadBalancer := range sorted(s.lb.lbs) {
+		if loadBalancer.Zone != r.zone() || !r.matchesName(loadBalancer.Name) || !r.matchesTags(loadBalancer.Tags) {
+			continue
+		}
+		if !r.matches(map[string]string{
+			"project_id":      loadBalancer.ProjectID,
+			"organization_id": loadBalancer.OrganizationID,
+		}) {
+			continue
+		}
+		lbs = append(lbs, loadBalancer)
+	}
+
+	return &lb.ListLBsResponse{
+		LBs:        page(r, lbs),
+		TotalCount: uint32(len(lbs)),
+	}, nil
+}
+
+// createLB creates a Load Balancer, which goes through the to_create and creating statuses
+// before being ready when it is read again.
+func (s *Server) createLB(r *request) (any, error) {
+	req := &lb.ZonedAPICreateLBRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(lbTypes, strings.ToUpper(req.Type)) {
+		return nil, invalidArgument("type", fmt.Sprintf("%q is not a Load Balancer type of zone %s", req.Type, r.zone()))
+	}
+
+	projectID := project(req.ProjectID)
+	region, err := r.zone().Region()
+	if err != nil {
+		return nil, invalidArgument("zone", err.Error())
+	}
+	loadBalancer := &lb.LB{
+		ID:                    s.newID(),
+		Name:                  req.Name,
+		Description:           req.Description,
+		Status:                lb.LBStatusToCreate,
+		OrganizationID:        OrganizationID,
+		ProjectID:             projectID,
+		IP:                    []*lb.IP{},
+		Tags:                  append([]string{}, req.Tags...),
+		Type:                  strings.ToLower(req.Type),
+		SslCompatibilityLevel: req.SslCompatibilityLevel,
+		CreatedAt:             now(),
+		UpdatedAt:             now(),
+		Region:                &region,
+		Zone:                  r.zone(),
+	}
+	if loadBalancer.SslCompatibilityLevel == "" {
+		loadBalancer.SslCompatibilityLevel = lb.SSLCompatibilityLevelSslCompatibilityLevelIntermediate
+	}
+	instance := &lb.Instance{
+		ID:        s.newID(),
+		Status:    lb.InstanceStatusPending,
+		IPAddress: fmt.Sprintf("10.64.%d.%d", byte(s.ids>>8), byte(s.ids)),
+		CreatedAt: now(),
+		UpdatedAt: now(),
+		Region:    &region,
+		Zone:      r.zone(),
+	}
+	loadBalancer.Instances = []*lb.Instance{instance}
+
+	ipIDs := slices.Clone(req.IPIDs)
+	if req.IPID != nil && *req.IPID != "" {
+		ipIDs = append(ipIDs, *req.IPID)
+	}
+	for _, ipID := range ipIDs {
+		ip, err := s.findLBIP(r.zone(), ipID)
+		if err != nil {
+			return nil, err
+		}
+		if ip.LBID != nil {
+			return nil, preconditionFailed("ip_in_use", "IP "+ipID+" is attached to Load Balancer "+*ip.LBID)
+		}
+	}
+	if len(ipIDs) == 0 {
+		if req.AssignFlexibleIP == nil || *req.AssignFlexibleIP {
+			ipIDs = append(ipIDs, s.newLBIP(r.zone(), projectID, false, nil).ID)
+		}
+		if req.AssignFlexibleIPv6 != nil && *req.AssignFlexibleIPv6 {
+			ipIDs = append(ipIDs, s.newLBIP(r.zone(), projectID, true, nil).ID)
+		}
+	}
+	for _, ipID := range ipIDs {
+		ip := s.lb.ips[ipID]
+		ip.LBID = &loadBalancer.ID
+		loadBalancer.IP = append(loadBalancer.IP, ip)
+	}
+
+	s.lb.lbs[loadBalancer.ID] = loadBalancer
+	s.then(loadBalancer.ID, func() {
+		loadBalancer.Status = lb.LBStatusCreating
+	}, func() {
+		loadBalancer.Status = lb.LBStatusReady
+		instance.Status = lb.InstanceStatusReady
+	})
+	return loadBalancer, nil
+}
+
+func (s *Server) getLB(r *request) (any, error) {
+	loadBalancer, err := s.findLB(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	s.advance(loadBalancer.ID)
+	if _, exists := s.lb.lbs[loadBalancer.ID]; !exists {
+		return nil, notFound("lb", loadBalancer.ID)
+	}
+	return loadBalancer, nil
+}
+
+func (s *Server) updateLB(r *request) (any, error) {
+	loadBalancer, err := s.findReadyLB(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPIUpdateLBRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	loadBalancer.Name = req.Name
+	loadBalancer.Description = req.Description
+	loadBalancer.Tags = req.Tags
+	if req.SslCompatibilityLevel != "" {
+		loadBalancer.SslCompatibilityLevel = req.SslCompatibilityLevel
+	}
+	loadBalancer.UpdatedAt = now()
+	return loadBalancer, nil
+}
+
+// deleteLB deletes a Load Balancer, which is in the to_delete status until it is read again.
+func (s *Server) deleteLB(r *request) (any, error) {
+	loadBalancer, err := s.findReadyLB(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	releaseIP := r.URL.Query().Get("release_ip") == "true"
+
+	loadBalancer.Status = lb.LBStatusToDelete
+	s.then(loadBalancer.ID, func() {
+		for _, ip := range loadBalancer.IP {
+			ip.LBID = nil
+			if releaseIP {
+				delete(s.lb.ips, ip.ID)
+			}
+		}
+		for _, pn := range s.lb.privateNetworks[loadBalancer.ID] {
+			s.forget(lbPrivateNetworkKey(loadBalancer.ID, pn.PrivateNetworkID))
+		}
+		for id, backend := range s.lb.backends {
+			if backend.LB.ID == loadBalancer.ID {
+				delete(s.lb.backends, id)
+			}
+		}
+		for id, frontend := range s.lb.frontends {
+			if frontend.LB.ID == loadBalancer.ID {
+				s.deleteFrontendACLs(id)
+				delete(s.lb.frontends, id)
+			}
+		}
+		s.releaseResourceIPs(loadBalancer.ID)
+		delete(s.lb.privateNetworks, loadBalancer.ID)
+		delete(s.lb.lbs, loadBalancer.ID)
+		s.forget(loadBalancer.ID)
+	})
+	return nil, nil
+}
+
+func (s *Server) findLBIP(zone scw.Zone, id string) (*lb.IP, error) {
+	ip, exists := s.lb.ips[id]
+	if !exists || ip.Zone != zone {
+		return nil, notFound("ip", id)
+	}
+	return ip, nil
+}
+
+func (s *Server) newLBIP(zone scw.Zone, projectID string, isIPv6 bool, reverse *string) *lb.IP {
+	region, _ := zone.Region()
+	ip := &lb.IP{
+		ID:             s.newID(),
+		OrganizationID: OrganizationID,
+		ProjectID:      projectID,
+		Tags:           []string{},
+		Region:         &region,
+		Zone:           zone,
+	}
+	if isIPv6 {
+		ip.IPAddress = s.newPublicIPv6().String()
+	} else {
+		ip.IPAddress = s.newPublicIP().String()
+	}
+	ip.Reverse = strings.ReplaceAll(ip.IPAddress, ".", "-") + ".lb." + region.String() + ".scw.cloud"
+	if isIPv6 {
+		ip.Reverse = strings.ReplaceAll(ip.IPAddress, ":", "-") + ".lb." + region.String() + ".scw.cloud"
+	}
+	if reverse != nil {
+		ip.Reverse = *reverse
+	}
+	s.lb.ips[ip.ID] = ip
+	return ip
+}
+
+func (s *Server) listLBIPs(r *request) (any, error) {
+	ips := []*lb.IP(nil)
+	for _, ip := range sorted(s.lb.ips) {
+		if ip.Zone != r.zone() || !r.matchesTags(ip.Tags) {
+			continue
+		}
+		ipType := "ipv4"
+		if strings.Contains(ip.IPAddress, ":") {
+			ipType = "ipv6"
+		}
+		if !r.matches(map[string]string{
+			"project_id":      ip.ProjectID,
+			"organization_id": ip.OrganizationID,
+			"ip_address":      ip.IPAddress,
+			"ip_type":         ipType,
+		}) {
+			continue
+		}
+		ips = append(ips, ip)
+	}
+
+	return &lb.ListIPsResponse{
+		IPs:        page(r, ips),
+		TotalCount: uint32(len(ips)),
+	}, nil
+}
+
+func (s *Server) createLBIP(r *request) (any, error) {
+	req := &lb.ZonedAPICreateIPRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	ip := s.newLBIP(r.zone(), project(req.ProjectID), req.IsIPv6, req.Reverse)
+	if req.Tags != nil {
+		ip.Tags = req.Tags
+	}
+	return ip, nil
+}
+
+func (s *Server) getLBIP(r *request) (any, error) {
+	return s.findLBIP(r.zone(), r.PathValue("id"))
+}
+
+func (s *Server) updateLBIP(r *request) (any, error) {
+	ip, err := s.findLBIP(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPIUpdateIPRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	if req.Reverse != nil {
+		ip.Reverse = *req.Reverse
+	}
+	if req.Tags != nil {
+		ip.Tags = *req.Tags
+	}
+	if req.LBID != nil {
+		if _, err := s.findLB(r.zone(), *req.LBID); err != nil {
+			return nil, err
+		}
+		ip.LBID = req.LBID
+	}
+	return ip, nil
+}
+
+func (s *Server) releaseLBIP(r *request) (any, error) {
+	ip, err := s.findLBIP(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	if ip.LBID != nil {
+		return nil, preconditionFailed("ip_in_use", "the IP is attached to Load Balancer "+*ip.LBID)
+	}
+
+	delete(s.lb.ips, ip.ID)
+	return nil, nil
+}
+
+func lbPrivateNetworkKey(lbID string, privateNetworkID string) string {
+	return lbID + "/" + privateNetworkID
+}
+
+func (s *Server) listLBPrivateNetworks(r *request) (any, error) {
+	loadBalancer, err := s.findLB(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	privateNetworks := s.lb.privateNetworks[loadBalancer.ID]
+	for _, pn := range privateNetworks {
+		s.advance(lbPrivateNetworkKey(loadBalancer.ID, pn.PrivateNetworkID))
+	}
+	return &lb.ListLBPrivateNetworksResponse{
+		PrivateNetwork: page(r, privateNetworks),
+		TotalCount:     uint32(len(privateNetworks)),
+	}, nil
+}
+
+// attachLBPrivateNetwork attaches a Load Balancer to a private network: the attachment is pending until it is listed again,
+// and an IP of the private network is booked for the Load Balancer unless IPs booked beforehand are given.
+func (s *Server) attachLBPrivateNetwork(r *request) (any, error) {
+	loadBalancer, err := s.findReadyLB(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPIAttachPrivateNetworkRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	privateNetworkID := r.PathValue("pn")
+	region, _ := r.zone().Region()
+	if _, err := s.findPrivateNetwork(region, privateNetworkID); err != nil {
+		return nil, err
+	}
+	for _, pn := range s.lb.privateNetworks[loadBalancer.ID] {
+		if pn.PrivateNetworkID == privateNetworkID {
+			return nil, preconditionFailed("private_network_already_attached", "the Load Balancer is already attached to private network "+privateNetworkID)
+		}
+	}
+
+	pn := &lb.PrivateNetwork{
+		LB:               loadBalancer,
+		IpamIDs:          []string{},
+		StaticConfig:     req.StaticConfig,
+		DHCPConfig:       req.DHCPConfig,
+		IpamConfig:       req.IpamConfig,
+		PrivateNetworkID: privateNetworkID,
+		Status:           lb.PrivateNetworkStatusPending,
+		CreatedAt:        now(),
+		UpdatedAt:        now(),
+	}
+	resource := &ipam.Resource{
+		Type: ipam.ResourceTypeLBServer,
+		ID:   loadBalancer.ID,
+		Name: &loadBalancer.Name,
+	}
+	if len(req.IpamIDs) > 0 {
+		for _, ipID := range req.IpamIDs {
+			if _, err := s.attachResourceIP(region, ipID, privateNetworkID, resource); err != nil {
+				return nil, err
+			}
+		}
+		pn.IpamIDs = req.IpamIDs
+	} else if req.StaticConfig == nil {
+		ip, err := s.bookResourceIP(region, loadBalancer.ProjectID, privateNetworkID, resource)
+		if err != nil {
+			return nil, err
+		}
+		pn.IpamIDs = []string{ip.ID}
+	}
+
+	s.lb.privateNetworks[loadBalancer.ID] = append(s.lb.privateNetworks[loadBalancer.ID], pn)
+	loadBalancer.PrivateNetworkCount++
+	s.then(lbPrivateNetworkKey(loadBalancer.ID, privateNetworkID), func() {
+		pn.Status = lb.PrivateNetworkStatusReady
+	})
+	return pn, nil
+}
+
+func (s *Server) detachLBPrivateNetwork(r *request) (any, error) {
+	loadBalancer, err := s.findLB(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	privateNetworkID := r.PathValue("pn")
+	privateNetworks := s.lb.privateNetworks[loadBalancer.ID]
+	index := slices.IndexFunc(privateNetworks, func(pn *lb.PrivateNetwork) bool { return pn.PrivateNetworkID == privateNetworkID })
+	if index < 0 {
+		return nil, notFound("lb_private_network", privateNetworkID)
+	}
+
+	for _, ip := range s.resourceIPs(loadBalancer.ID) {
+		if ip.Source.PrivateNetworkID != nil && *ip.Source.PrivateNetworkID == privateNetworkID {
+			if s.ipam.managed[ip.ID] {
+				delete(s.ipam.ips, ip.ID)
+				delete(s.ipam.managed, ip.ID)
+			} else {
+				ip.Resource = nil
+			}
+		}
+	}
+	s.lb.privateNetworks[loadBalancer.ID] = slices.Delete(privateNetworks, index, index+1)
+	loadBalancer.PrivateNetworkCount--
+	s.forget(lbPrivateNetworkKey(loadBalancer.ID, privateNetworkID))
+	return nil, nil
+}
+
+func (s *Server) findBackend(zone scw.Zone, id string) (*lb.Backend, error) {
+	backend, exists := s.lb.backends[id]
+	if !exists || backend.LB.Zone != zone {
+		return nil, notFound("backend", id)
+	}
+	return backend, nil
+}
+
+func (s *Server) listBackends(r *request) (any, error) {
+	loadBalancer, err := s.findLB(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	backends := []*lb.Backend(nil)
+	for _, backend := range sorted(s.lb.backends) {
+		if backend.LB.ID == loadBalancer.ID && r.matchesName(backend.Name) {
+			backends = append(backends, backend)
+		}
+	}
+	return &lb.ListBackendsResponse{
+		Backends:   page(r, backends),
+		TotalCount: uint32(len(backends)),
+	}, nil
+}
+
+func (s *Server) createBackend(r *request) (any, error) {
+	loadBalancer, err := s.findReadyLB(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPICreateBackendRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	backend := &lb.Backend{
+		ID:                       s.newID(),
+		Name:                     req.Name,
+		ForwardProtocol:          req.ForwardProtocol,
+		ForwardPort:              req.ForwardPort,
+		ForwardPortAlgorithm:     req.ForwardPortAlgorithm,
+		StickySessions:           req.StickySessions,
+		StickySessionsCookieName: req.StickySessionsCookieName,
+		HealthCheck:              req.HealthCheck,
+		Pool:                     append([]string{}, req.ServerIP...),
+		LB:                       loadBalancer,
+		SendProxyV2:              req.SendProxyV2,
+		TimeoutServer:            req.TimeoutServer,
+		TimeoutConnect:           req.TimeoutConnect,
+		TimeoutTunnel:            req.TimeoutTunnel,
+		OnMarkedDownAction:       req.OnMarkedDownAction,
+		ProxyProtocol:            req.ProxyProtocol,
+		CreatedAt:                now(),
+		UpdatedAt:                now(),
+		FailoverHost:             req.FailoverHost,
+		SslBridging:              req.SslBridging,
+		IgnoreSslServerVerify:    req.IgnoreSslServerVerify,
+		RedispatchAttemptCount:   req.RedispatchAttemptCount,
+		MaxRetries:               req.MaxRetries,
+		MaxConnections:           req.MaxConnections,
+		TimeoutQueue:             req.TimeoutQueue,
+	}
+	if backend.HealthCheck == nil {
+		backend.HealthCheck = &lb.HealthCheck{Port: req.ForwardPort, TCPConfig: &lb.HealthCheckTCPConfig{}}
+	}
+
+	s.lb.backends[backend.ID] = backend
+	loadBalancer.BackendCount++
+	return backend, nil
+}
+
+func (s *Server) getBackend(r *request) (any, error) {
+	return s.findBackend(r.zone(), r.PathValue("id"))
+}
+
+func (s *Server) updateBackend(r *request) (any, error) {
+	backend, err := s.findBackend(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPIUpdateBackendRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	backend.Name = req.Name
+	backend.ForwardProtocol = req.ForwardProtocol
+	backend.ForwardPort = req.ForwardPort
+	backend.ForwardPortAlgorithm = req.ForwardPortAlgorithm
+	backend.StickySessions = req.StickySessions
+	backend.StickySessionsCookieName = req.StickySessionsCookieName
+	backend.SendProxyV2 = req.SendProxyV2
+	backend.TimeoutServer = req.TimeoutServer
+	backend.TimeoutConnect = req.TimeoutConnect
+	backend.TimeoutTunnel = req.TimeoutTunnel
+	backend.OnMarkedDownAction = req.OnMarkedDownAction
+	backend.ProxyProtocol = req.ProxyProtocol
+	backend.FailoverHost = req.FailoverHost
+	backend.SslBridging = req.SslBridging
+	backend.IgnoreSslServerVerify = req.IgnoreSslServerVerify
+	backend.RedispatchAttemptCount = req.RedispatchAttemptCount
+	backend.MaxRetries = req.MaxRetries
+	backend.MaxConnections = req.MaxConnections
+	backend.TimeoutQueue = req.TimeoutQueue
+	backend.UpdatedAt = now()
+	return backend, nil
+}
+
+func (s *Server) deleteBackend(r *request) (any, error) {
+	backend, err := s.findBackend(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	for _, frontend := range s.lb.frontends {
+		if frontend.Backend.ID == backend.ID {
+			return nil, preconditionFailed("backend_in_use", "the backend is used by frontend "+frontend.ID)
+		}
+	}
+
+	delete(s.lb.backends, backend.ID)
+	backend.LB.BackendCount--
+	return nil, nil
+}
+
+func (s *Server) setBackendServers(r *request) (any, error) {
+	backend, err := s.findBackend(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPISetBackendServersRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	backend.Pool = append([]string{}, req.ServerIP...)
+	backend.UpdatedAt = now()
+	return backend, nil
+}
+
+func (s *Server) updateHealthCheck(r *request) (any, error) {
+	backend, err := s.findBackend(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPIUpdateHealthCheckRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	backend.HealthCheck = &lb.HealthCheck{
+		Port:                req.Port,
+		CheckDelay:          req.CheckDelay,
+		CheckTimeout:        req.CheckTimeout,
+		CheckMaxRetries:     req.CheckMaxRetries,
+		TCPConfig:           req.TCPConfig,
+		MysqlConfig:         req.MysqlConfig,
+		PgsqlConfig:         req.PgsqlConfig,
+		LdapConfig:          req.LdapConfig,
+		RedisConfig:         req.RedisConfig,
+		HTTPConfig:          req.HTTPConfig,
+		HTTPSConfig:         req.HTTPSConfig,
+		CheckSendProxy:      req.CheckSendProxy,
+		TransientCheckDelay: req.TransientCheckDelay,
+	}
+	backend.UpdatedAt = now()
+	return backend.HealthCheck, nil
+}
+
+func (s *Server) findFrontend(zone scw.Zone, id string) (*lb.Frontend, error) {
+	frontend, exists := s.lb.frontends[id]
+	if !exists || frontend.LB.Zone != zone {
+		return nil, notFound("frontend", id)
+	}
+	return frontend, nil
+}
+
+func (s *Server) listFrontends(r *request) (any, error) {
+	loadBalancer, err := s.findLB(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	frontends := []*lb.Frontend(nil)
+	for _, frontend := range sorted(s.lb.frontends) {
+		if frontend.LB.ID == loadBalancer.ID && r.matchesName(frontend.Name) {
+			frontends = append(frontends, frontend)
+		}
+	}
+	return &lb.ListFrontendsResponse{
+		Frontends:  page(r, frontends),
+		TotalCount: uint32(len(frontends)),
+	}, nil
+}
+
+// frontendBackend returns the backend of a frontend, which must belong to the same Load Balancer.
+func (s *Server) frontendBackend(loadBalancer *lb.LB, id string) (*lb.Backend, error) {
+	backend, exists := s.lb.backends[id]
+	if !exists || backend.LB.ID != loadBalancer.ID {
+		return nil, invalidArgument("backend_id", "backend "+id+" does not belong to Load Balancer "+loadBalancer.ID)
+	}
+	return backend, nil
+}
+
+func (s *Server) createFrontend(r *request) (any, error) {
+	loadBalancer, err := s.findReadyLB(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPICreateFrontendRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	backend, err := s.frontendBackend(loadBalancer, req.BackendID)
+	if err != nil {
+		return nil, err
+	}
+
+	frontend := &lb.Frontend{
+		ID:             s.newID(),
+		Name:           req.Name,
+		InboundPort:    req.InboundPort,
+		Backend:        backend,
+		LB:             loadBalancer,
+		TimeoutClient:  req.TimeoutClient,
+		CertificateIDs: []string{},
+		CreatedAt:      now(),
+		UpdatedAt:      now(),
+		EnableHTTP3:    req.EnableHTTP3,
+	}
+	if req.CertificateIDs != nil {
+		frontend.CertificateIDs = *req.CertificateIDs
+	}
+
+	s.lb.frontends[frontend.ID] = frontend
+	loadBalancer.FrontendCount++
+	return frontend, nil
+}
+
+func (s *Server) getFrontend(r *request) (any, error) {
+	return s.findFrontend(r.zone(), r.PathValue("id"))
+}
+
+func (s *Server) updateFrontend(r *request) (any, error) {
+	frontend, err := s.findFrontend(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPIUpdateFrontendRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	backend, err := s.frontendBackend(frontend.LB, req.BackendID)
+	if err != nil {
+		return nil, err
+	}
+
+	frontend.Name = req.Name
+	frontend.InboundPort = req.InboundPort
+	frontend.Backend = backend
+	frontend.TimeoutClient = req.TimeoutClient
+	frontend.EnableHTTP3 = req.EnableHTTP3
+	if req.CertificateIDs != nil {
+		frontend.CertificateIDs = *req.CertificateIDs
+	}
+	frontend.UpdatedAt = now()
+	return frontend, nil
+}
+
+func (s *Server) deleteFrontend(r *request) (any, error) {
+	frontend, err := s.findFrontend(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	s.deleteFrontendACLs(frontend.ID)
+	delete(s.lb.frontends, frontend.ID)
+	frontend.LB.FrontendCount--
+	return nil, nil
+}
+
+func (s *Server) findACL(zone scw.Zone, id string) (*lb.ACL, error) {
+	acl, exists := s.lb.acls[id]
+	if !exists || acl.Frontend.LB.Zone != zone {
+		return nil, notFound("acl", id)
+	}
+	return acl, nil
+}
+
+func (s *Server) deleteFrontendACLs(frontendID string) {
+	for id, acl := range s.lb.acls {
+		if acl.Frontend.ID == frontendID {
+			delete(s.lb.acls, id)
+		}
+	}
+}
+
+func (s *Server) listACLs(r *request) (any, error) {
+	frontend, err := s.findFrontend(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	acls := []*lb.ACL(nil)
+	for _, acl := range sorted(s.lb.acls) {
+		if acl.Frontend.ID == frontend.ID && r.matchesName(acl.Name) {
+			acls = append(acls, acl)
+		}
+	}
+	slices.SortStableFunc(acls, func(a, b *lb.ACL) int { return int(a.Index - b.Index) })
+	return &lb.ListACLResponse{
+		ACLs:       page(r, acls),
+		TotalCount: uint32(len(acls)),
+	}, nil
+}
+
+func (s *Server) createACL(r *request) (any, error) {
+	frontend, err := s.findFrontend(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPICreateACLRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	acl := &lb.ACL{
+		ID:          s.newID(),
+		Name:        req.Name,
+		Match:       req.Match,
+		Action:      req.Action,
+		Frontend:    frontend,
+		Index:       req.Index,
+		CreatedAt:   now(),
+		UpdatedAt:   now(),
+		Description: req.Description,
+	}
+	s.lb.acls[acl.ID] = acl
+	return acl, nil
+}
+
+func (s *Server) getACL(r *request) (any, error) {
+	return s.findACL(r.zone(), r.PathValue("id"))
+}
+
+func (s *Server) updateACL(r *request) (any, error) {
+	acl, err := s.findACL(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &lb.ZonedAPIUpdateACLRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	acl.Name = req.Name
+	acl.Action = req.Action
+	acl.Match = req.Match
+	acl.Index = req.Index
+	if req.Description != nil {
+		acl.Description = *req.Description
+	}
+	acl.UpdatedAt = now()
+	return acl, nil
+}
+
+func (s *Server) deleteACL(r *request) (any, error) {
+	acl, err := s.findACL(r.zone(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.lb.acls, acl.ID)
+	return nil, nil
+}
@@ -0,0 +1,103 @@
+package fakeapi
+
+import (
+	"slices"
+
+	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v2"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// imageLabels are the labels of the images available in every zone.
+var imageLabels = []string{"ubuntu_focal", "ubuntu_jammy", "ubuntu_noble", "debian_bookworm"}
+
+type marketplaceState struct {
+	// localImages are the local images of each zone, by zone and label
+	localImages map[string]map[string][]*marketplace.LocalImage
+}
+
+func (s *Server) registerMarketplace() {
+	s.marketplace = marketplaceState{
+		localImages: map[string]map[string][]*marketplace.LocalImage{},
+	}
+
+	s.handle("GET /marketplace/v2/local-images", s.listLocalImages)
+	s.handle("GET /marketplace/v2/local-images/{id}", s.getLocalImage)
+}
+
+// zoneLocalImages returns the local images of a zone, creating them on first use.
+func (s *Server) zoneLocalImages(zone string) map[string][]*marketplace.LocalImage {
+	if images, exists := s.marketplace.localImages[zone]; exists {
+		return images
+	}
+
+	images := map[string][]*marketplace.LocalImage{}
+	for _, label := range imageLabels {
+		for _, imageType := range []marketplace.LocalImageType{marketplace.LocalImageTypeInstanceLocal, marketplace.LocalImageTypeInstanceSbs} {
+			images[label] = append(images[label], &marketplace.LocalImage{
+				ID:                        s.newID(),
+				CompatibleCommercialTypes: serverTypeNames(),
+				Arch:                      "x86_64",
+				Zone:                      scw.Zone(zone),
+				Label:                     label,
+				Type:                      imageType,
+			})
+		}
+	}
+	s.marketplace.localImages[zone] = images
+
+	return images
+}
+
+// localImage returns the local image with the given ID.
+func (s *Server) localImage(id string) *marketplace.LocalImage {
+	for _, zoneImages := range s.marketplace.localImages {
+		for _, images := range zoneImages {
+			for _, image := range images {
+				if image.ID == id {
+					return image
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) listLocalImages(r *request) (any, error) {
+	zones := []string{r.filter("zone")}
+	if zones[0] == "" {
+		zones = zones[:0]
+		for zone := range s.marketplace.localImages {
+			zones = append(zones, zone)
+		}
+		slices.Sort(zones)
+	}
+
+	images := []*marketplace.LocalImage(nil)
+	for _, zone := range zones {
+		zoneImages := s.zoneLocalImages(zone)
+		for _, label := range imageLabels {
+			if imageLabel := r.filter("image_label"); imageLabel != "" && imageLabel != label {
+				continue
+			}
+			for _, image := range zoneImages[label] {
+				if imageType := r.filter("type"); imageType != "" && imageType != string(image.Type) {
+					continue
+				}
+				images = append(images, image)
+			}
+		}
+	}
+
+	return &marketplace.ListLocalImagesResponse{
+		LocalImages: page(r, images),
+		TotalCount:  uint32(len(images)),
+	}, nil
+}
+
+func (s *Server) getLocalImage(r *request) (any, error) {
+	image := s.localImage(r.PathValue("id"))
+	if image == nil {
+		return nil, notFound("local_image", r.PathValue("id"))
+	}
+	return image, nil
+}
@@ -0,0 +1,380 @@
+// Package fakeapi is an in-memory fake of the Instance, VPC, IPAM and Load Balancer APIs.
+//
+// It keeps the objects created by the provider in memory and reports the transitional statuses of the real APIs,
+// so that acceptance tests can run without credentials nor network access.
+package fakeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const (
+	// OrganizationID is the organization of the objects created without one
+	OrganizationID = "11111111-1111-1111-1111-111111111111"
+	// ProjectID is the project of the objects created without one
+	ProjectID = "22222222-2222-2222-2222-222222222222"
+	// AccessKey and SecretKey are credentials to use with the fake, which does not check them
+	AccessKey = "SCWXXXXXXXXXXXXXFAKE"
+	SecretKey = "33333333-3333-3333-3333-333333333333"
+)
+
+// Server is an in-memory fake of Scaleway APIs.
+type Server struct {
+	mux *http.ServeMux
+
+	mu sync.Mutex
+	// ids, addresses and macAddresses are the numbers of IDs, public IP addresses and MAC addresses generated
+	ids          int
+	addresses    int
+	macAddresses int
+	// transitions are the changes applied to objects when they are read, by object ID
+	transitions map[string][]func()
+
+	instance    instanceState
+	marketplace marketplaceState
+	vpc         vpcState
+	ipam        ipamState
+	lb          lbState
+}
+
+// New returns a fake with no objects.
+func New() *Server {
+	s := &Server{
+		mux:         http.NewServeMux(),
+		transitions: map[string][]func(){},
+	}
+	s.registerInstance()
+	s.registerMarketplace()
+	s.registerVPC()
+	s.registerIPAM()
+	s.registerLB()
+
+	return s
+}
+
+// ServeHTTP serves the requests sent to the Scaleway APIs.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Client returns an HTTP client sending the requests to the fake without going through the network.
+func (s *Server) Client() *http.Client {
+	return &http.Client{Transport: roundTripper{handler: s}}
+}
+
+type roundTripper struct {
+	handler http.Handler
+}
+
+func (t roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Err() != nil {
+		return nil, req.Context().Err()
+	}
+	recorder := httptest.NewRecorder()
+	t.handler.ServeHTTP(recorder, req)
+	res := recorder.Result()
+	res.Request = req
+
+	return res, nil
+}
+
+// handlerFunc handles a request and returns the object to write in the JSON body of the response.
+type handlerFunc func(r *request) (any, error)
+
+// handle registers a handler for a pattern of http.ServeMux, e.g. "GET /vpc/v2/regions/{region}/vpcs/{id}".
+// Handlers are run one at a time.
+func (s *Server) handle(pattern string, handler handlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, httpRequest *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		// The response is written while locked as it may reference the objects of the fake
+		res, err := handler(&request{Request: httpRequest, server: s})
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeResponse(w, res)
+	})
+}
+
+func writeResponse(w http.ResponseWriter, res any) {
+	switch res := res.(type) {
+	case nil:
+		w.WriteHeader(http.StatusNoContent)
+	case rawResponse:
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write(res)
+	case listResponse:
+		w.Header().Set("X-Total-Count", strconv.Itoa(res.totalCount))
+		writeJSON(w, http.StatusOK, res.body)
+	default:
+		writeJSON(w, http.StatusOK, res)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// rawResponse is a response that is not encoded in JSON, such as user data.
+type rawResponse []byte
+
+// listResponse is the response of a list request, with the X-Total-Count header of the Instance API.
+type listResponse struct {
+	body       any
+	totalCount int
+}
+
+// apiError is an error returned by the fake with the format of the Scaleway APIs.
+type apiError struct {
+	status int
+	body   map[string]any
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%d: %v", e.status, e.body["message"])
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, isAPIError := err.(*apiError)
+	if !isAPIError {
+		e = &apiError{
+			status: http.StatusInternalServerError,
+			body:   map[string]any{"message": err.Error()},
+		}
+	}
+	writeJSON(w, e.status, e.body)
+}
+
+func notFound(resource string, id string) error {
+	return &apiError{
+		status: http.StatusNotFound,
+		body: map[string]any{
+			"type":        "not_found",
+			"message":     "resource is not found",
+			"resource":    resource,
+			"resource_id": id,
+		},
+	}
+}
+
+func invalidArgument(argument string, helpMessage string) error {
+	return &apiError{
+		status: http.StatusBadRequest,
+		body: map[string]any{
+			"type":    "invalid_arguments",
+			"message": "invalid argument(s)",
+			"details": []map[string]any{{
+				"argument_name": argument,
+				"reason":        "constraint",
+				"help_message":  helpMessage,
+			}},
+		},
+	}
+}
+
+func preconditionFailed(precondition string, helpMessage string) error {
+	return &apiError{
+		status: http.StatusPreconditionFailed,
+		body: map[string]any{
+			"type":         "precondition_failed",
+			"message":      "precondition failed",
+			"precondition": precondition,
+			"help_message": helpMessage,
+		},
+	}
+}
+
+func transientState(resource string, id string, state string) error {
+	return &apiError{
+		status: http.StatusConflict,
+		body: map[string]any{
+			"type":          "transient_state",
+			"message":       "resource is in a transient state",
+			"resource":      resource,
+			"resource_id":   id,
+			"current_state": state,
+		},
+	}
+}
+
+// request is a request handled by the fake.
+type request struct {
+	*http.Request
+	server *Server
+}
+
+// decode decodes the JSON body of the request, usually in the request type of the SDK.
+func (r *request) decode(v any) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return invalidArgument("body", err.Error())
+	}
+	return nil
+}
+
+func (r *request) zone() scw.Zone {
+	return scw.Zone(r.PathValue("zone"))
+}
+
+func (r *request) region() scw.Region {
+	return scw.Region(r.PathValue("region"))
+}
+
+// project returns the given project, or the project of the fake.
+func project(projectID *string) string {
+	if projectID == nil || *projectID == "" {
+		return ProjectID
+	}
+	return *projectID
+}
+
+// matches returns true if an object matches the filters of the query of a list request.
+func (r *request) matches(filters map[string]string) bool {
+	for key, value := range filters {
+		if filter := r.filter(key); filter != "" && filter != value {
+			return false
+		}
+	}
+	return true
+}
+
+// filter returns a filter of the query of a list request.
+// The SDK sends the enums left unset as their unknown value, which does not filter anything.
+func (r *request) filter(key string) string {
+	filter := r.URL.Query().Get(key)
+	if strings.HasPrefix(filter, "unknown_") {
+		return ""
+	}
+	return filter
+}
+
+// matchesName returns true if a name contains the name filter of a list request.
+func (r *request) matchesName(name string) bool {
+	return strings.Contains(name, r.URL.Query().Get("name"))
+}
+
+// matchesTags returns true if the tags of an object contain the tags filter of a list request.
+func (r *request) matchesTags(tags []string) bool {
+	for _, tag := range r.values("tags") {
+		if !slices.Contains(tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// values returns the values of a list parameter of the query, which are either repeated or separated by commas.
+func (r *request) values(key string) []string {
+	values := []string(nil)
+	for _, value := range r.URL.Query()[key] {
+		values = append(values, strings.Split(value, ",")...)
+	}
+	return values
+}
+
+// page returns the page of items requested by the page and page_size, or per_page, query parameters of a list request.
+func page[T any](r *request, items []T) []T {
+	pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+	if perPage := r.URL.Query().Get("per_page"); perPage != "" {
+		pageSize, _ = strconv.Atoi(perPage)
+	}
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 50
+	}
+
+	start := min((pageNumber-1)*pageSize, len(items))
+	end := min(start+pageSize, len(items))
+	return items[start:end]
+}
+
+// newID returns a new UUID. IDs are sequential so that tests are reproducible.
+func (s *Server) newID() string {
+	s.ids++
+	return fmt.Sprintf("%08x-%04x-4%03x-8%03x-%012x", s.ids, 0, 0, 0, s.ids)
+}
+
+// newPublicIP returns a new public IPv4 address.
+func (s *Server) newPublicIP() net.IP {
+	s.addresses++
+	return net.IPv4(51, 15, byte(s.addresses>>8), byte(s.addresses)).To4()
+}
+
+// newPublicIPv6 returns a new public IPv6 address.
+func (s *Server) newPublicIPv6() net.IP {
+	s.addresses++
+	return net.ParseIP(fmt.Sprintf("2001:bc8:710:%x::1", s.addresses))
+}
+
+// newMACAddress returns a new MAC address for a private NIC.
+func (s *Server) newMACAddress() string {
+	s.macAddresses++
+	return fmt.Sprintf("02:00:00:%02x:%02x:%02x", byte(s.macAddresses>>16), byte(s.macAddresses>>8), byte(s.macAddresses))
+}
+
+// then schedules changes of an object, such as status changes.
+// Each read of the object applies the next change, as a real API eventually does.
+func (s *Server) then(id string, changes ...func()) {
+	s.transitions[id] = append(s.transitions[id], changes...)
+}
+
+// advance applies the next scheduled change of an object.
+func (s *Server) advance(id string) {
+	changes := s.transitions[id]
+	if len(changes) == 0 {
+		return
+	}
+	s.transitions[id] = changes[1:]
+	changes[0]()
+}
+
+// forget drops the scheduled changes of a deleted object.
+func (s *Server) forget(id string) {
+	delete(s.transitions, id)
+}
+
+func now() *time.Time {
+	t := time.Now().UTC().Truncate(time.Second)
+	return &t
+}
+
+// sorted returns the values of a map of objects in creation order.
+func sorted[T any](objects map[string]T) []T {
+	ids := make([]string, 0, len(objects))
+	for id := range objects {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	values := make([]T, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, objects[id])
+	}
+	return values
+}
@@ -0,0 +1,214 @@
+package fakeapi_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v2"
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest/fakeapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T) *scw.Client {
+	t.Helper()
+	client, err := scw.NewClient(
+		scw.WithHTTPClient(fakeapi.New().Client()),
+		scw.WithAuth(fakeapi.AccessKey, fakeapi.SecretKey),
+		scw.WithDefaultOrganizationID(fakeapi.OrganizationID),
+		scw.WithDefaultProjectID(fakeapi.ProjectID),
+		scw.WithDefaultZone(scw.ZoneFrPar1),
+		scw.WithDefaultRegion(scw.RegionFrPar),
+	)
+	require.NoError(t, err)
+	return client
+}
+
+var retryInterval = scw.TimeDurationPtr(time.Millisecond)
+
+func TestInstanceServerLifecycle(t *testing.T) {
+	client := newClient(t)
+	instanceAPI := instance.NewAPI(client)
+
+	image, err := marketplace.NewAPI(client).GetLocalImageByLabel(&marketplace.GetLocalImageByLabelRequest{
+		ImageLabel:     "ubuntu_jammy",
+		Zone:           scw.ZoneFrPar1,
+		CommercialType: "DEV1-S",
+		Type:           marketplace.LocalImageTypeInstanceLocal,
+	})
+	require.NoError(t, err)
+
+	res, err := instanceAPI.CreateServer(&instance.CreateServerRequest{
+		Name:           "tf-tests-server",
+		CommercialType: "DEV1-S",
+		Image:          &image.ID,
+		Tags:           []string{"fake"},
+	})
+	require.NoError(t, err)
+	server := res.Server
+	assert.Equal(t, instance.ServerStateStopped, server.State)
+	require.Len(t, server.Volumes, 1)
+	assert.Equal(t, scw.Size(20*scw.GB), *server.Volumes["0"].Size)
+
+	_, err = instanceAPI.ServerAction(&instance.ServerActionRequest{ServerID: server.ID, Action: instance.ServerActionPoweron})
+	require.NoError(t, err)
+	starting, err := instanceAPI.GetServer(&instance.GetServerRequest{ServerID: server.ID})
+	require.NoError(t, err)
+	assert.Equal(t, instance.ServerStateStarting, starting.Server.State)
+
+	// Actions are refused while the server is in a transitional state
+	_, err = instanceAPI.ServerAction(&instance.ServerActionRequest{ServerID: server.ID, Action: instance.ServerActionPoweroff})
+	assert.IsType(t, &scw.TransientStateError{}, err)
+
+	running, err := instanceAPI.WaitForServer(&instance.WaitForServerRequest{ServerID: server.ID, RetryInterval: retryInterval})
+	require.NoError(t, err)
+	assert.Equal(t, instance.ServerStateRunning, running.State)
+
+	// Only stopped servers can be deleted
+	err = instanceAPI.DeleteServer(&instance.DeleteServerRequest{ServerID: running.ID})
+	assert.Error(t, err)
+
+	err = instanceAPI.ServerActionAndWait(&instance.ServerActionAndWaitRequest{ServerID: running.ID, Action: instance.ServerActionPoweroff, RetryInterval: retryInterval})
+	require.NoError(t, err)
+	require.NoError(t, instanceAPI.DeleteServer(&instance.DeleteServerRequest{ServerID: running.ID}))
+	require.NoError(t, instanceAPI.DeleteVolume(&instance.DeleteVolumeRequest{VolumeID: running.Volumes["0"].ID}))
+
+	_, err = instanceAPI.GetServer(&instance.GetServerRequest{ServerID: running.ID})
+	assert.IsType(t, &scw.ResourceNotFoundError{}, err)
+}
+
+func TestInstanceServerUserData(t *testing.T) {
+	client := newClient(t)
+	instanceAPI := instance.NewAPI(client)
+
+	res, err := instanceAPI.CreateServer(&instance.CreateServerRequest{CommercialType: "DEV1-S"})
+	require.NoError(t, err)
+
+	err = instanceAPI.SetAllServerUserData(&instance.SetAllServerUserDataRequest{
+		ServerID: res.Server.ID,
+		UserData: map[string]io.Reader{"cloud-init": strings.NewReader("#cloud-config")},
+	})
+	require.NoError(t, err)
+
+	userData, err := instanceAPI.GetAllServerUserData(&instance.GetAllServerUserDataRequest{ServerID: res.Server.ID})
+	require.NoError(t, err)
+	require.Contains(t, userData.UserData, "cloud-init")
+	content, err := io.ReadAll(userData.UserData["cloud-init"])
+	require.NoError(t, err)
+	assert.Equal(t, "#cloud-config", string(content))
+}
+
+func TestPrivateNetworkAttachments(t *testing.T) {
+	client := newClient(t)
+	instanceAPI := instance.NewAPI(client)
+	vpcAPI := vpc.NewAPI(client)
+	ipamAPI := ipam.NewAPI(client)
+
+	pn, err := vpcAPI.CreatePrivateNetwork(&vpc.CreatePrivateNetworkRequest{Name: "tf-tests-pn"})
+	require.NoError(t, err)
+	require.Len(t, pn.Subnets, 2)
+
+	res, err := instanceAPI.CreateServer(&instance.CreateServerRequest{CommercialType: "DEV1-S"})
+	require.NoError(t, err)
+	nic, err := instanceAPI.CreatePrivateNIC(&instance.CreatePrivateNICRequest{ServerID: res.Server.ID, PrivateNetworkID: pn.ID})
+	require.NoError(t, err)
+	assert.Equal(t, instance.PrivateNICStateSyncing, nic.PrivateNic.State)
+
+	available, err := instanceAPI.WaitForPrivateNIC(&instance.WaitForPrivateNICRequest{ServerID: res.Server.ID, PrivateNicID: nic.PrivateNic.ID, RetryInterval: retryInterval})
+	require.NoError(t, err)
+	assert.Equal(t, instance.PrivateNICStateAvailable, available.State)
+
+	ips, err := ipamAPI.ListIPs(&ipam.ListIPsRequest{ResourceID: &available.ID}, scw.WithAllPages())
+	require.NoError(t, err)
+	require.Len(t, ips.IPs, 1)
+	assert.Equal(t, "172.16.4.2", ips.IPs[0].Address.IP.String())
+	assert.Equal(t, available.MacAddress, *ips.IPs[0].Resource.MacAddress)
+
+	// Private networks with attached resources cannot be deleted
+	err = vpcAPI.DeletePrivateNetwork(&vpc.DeletePrivateNetworkRequest{PrivateNetworkID: pn.ID})
+	assert.IsType(t, &scw.PreconditionFailedError{}, err)
+
+	require.NoError(t, instanceAPI.DeletePrivateNIC(&instance.DeletePrivateNICRequest{ServerID: res.Server.ID, PrivateNicID: available.ID}))
+	ips, err = ipamAPI.ListIPs(&ipam.ListIPsRequest{PrivateNetworkID: &pn.ID}, scw.WithAllPages())
+	require.NoError(t, err)
+	assert.Empty(t, ips.IPs)
+	require.NoError(t, vpcAPI.DeletePrivateNetwork(&vpc.DeletePrivateNetworkRequest{PrivateNetworkID: pn.ID}))
+}
+
+func TestLoadBalancerLifecycle(t *testing.T) {
+	client := newClient(t)
+	lbAPI := lb.NewZonedAPI(client)
+
+	loadBalancer, err := lbAPI.CreateLB(&lb.ZonedAPICreateLBRequest{Name: "tf-tests-lb", Type: "LB-S"})
+	require.NoError(t, err)
+	assert.Equal(t, lb.LBStatusToCreate, loadBalancer.Status)
+	require.Len(t, loadBalancer.IP, 1)
+
+	// Load Balancers cannot be changed until they are ready
+	_, err = lbAPI.CreateBackend(&lb.ZonedAPICreateBackendRequest{LBID: loadBalancer.ID, ForwardProtocol: lb.ProtocolTCP, ForwardPort: 80})
+	assert.IsType(t, &scw.TransientStateError{}, err)
+
+	loadBalancer, err = lbAPI.WaitForLbInstances(&lb.ZonedAPIWaitForLBInstancesRequest{LBID: loadBalancer.ID, RetryInterval: retryInterval})
+	require.NoError(t, err)
+	assert.Equal(t, lb.LBStatusReady, loadBalancer.Status)
+
+	backend, err := lbAPI.CreateBackend(&lb.ZonedAPICreateBackendRequest{
+		LBID:            loadBalancer.ID,
+		Name:            "backend",
+		ForwardProtocol: lb.ProtocolTCP,
+		ForwardPort:     80,
+		ServerIP:        []string{"10.0.0.1"},
+	})
+	require.NoError(t, err)
+	_, err = lbAPI.CreateFrontend(&lb.ZonedAPICreateFrontendRequest{LBID: loadBalancer.ID, Name: "frontend", InboundPort: 80, BackendID: backend.ID})
+	require.NoError(t, err)
+
+	// Backends used by frontends cannot be deleted
+	err = lbAPI.DeleteBackend(&lb.ZonedAPIDeleteBackendRequest{BackendID: backend.ID})
+	assert.IsType(t, &scw.PreconditionFailedError{}, err)
+
+	pn, err := vpc.NewAPI(client).CreatePrivateNetwork(&vpc.CreatePrivateNetworkRequest{Name: "tf-tests-pn"})
+	require.NoError(t, err)
+	_, err = lbAPI.AttachPrivateNetwork(&lb.ZonedAPIAttachPrivateNetworkRequest{
+		LBID:             loadBalancer.ID,
+		PrivateNetworkID: pn.ID,
+		IpamConfig:       &lb.PrivateNetworkIpamConfig{},
+	})
+	require.NoError(t, err)
+	pns, err := lbAPI.WaitForLBPN(&lb.ZonedAPIWaitForLBPNRequest{LBID: loadBalancer.ID, RetryInterval: retryInterval})
+	require.NoError(t, err)
+	require.Len(t, pns, 1)
+	assert.Equal(t, lb.PrivateNetworkStatusReady, pns[0].Status)
+	require.Len(t, pns[0].IpamIDs, 1)
+
+	require.NoError(t, lbAPI.DeleteLB(&lb.ZonedAPIDeleteLBRequest{LBID: loadBalancer.ID, ReleaseIP: true}))
+	_, err = lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{LBID: loadBalancer.ID, RetryInterval: retryInterval})
+	notFoundErr := &scw.ResourceNotFoundError{}
+	assert.ErrorAs(t, err, &notFoundErr)
+
+	ips, err := lbAPI.ListIPs(&lb.ZonedAPIListIPsRequest{}, scw.WithAllPages())
+	require.NoError(t, err)
+	assert.Empty(t, ips.IPs)
+	ipamIPs, err := ipam.NewAPI(client).ListIPs(&ipam.ListIPsRequest{PrivateNetworkID: &pn.ID}, scw.WithAllPages())
+	require.NoError(t, err)
+	assert.Empty(t, ipamIPs.IPs)
+}
+
+func TestServeHTTP(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://api.scaleway.com/vpc/v2/regions/fr-par/vpcs/unknown", nil)
+	require.NoError(t, err)
+
+	resp, err := fakeapi.New().Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+}
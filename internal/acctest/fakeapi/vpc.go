@@ -0,0 +1,318 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+type vpcState struct {
+	vpcs            map[string]*vpc.VPC
+	privateNetworks map[string]*vpc.PrivateNetwork
+	// subnets is the number of subnets generated for private networks created without one
+	subnets int
+}
+
+func (s *Server) registerVPC() {
+	s.vpc = vpcState{
+		vpcs:            map[string]*vpc.VPC{},
+		privateNetworks: map[string]*vpc.PrivateNetwork{},
+	}
+
+	s.handle("GET /vpc/v2/regions/{region}/vpcs", s.listVPCs)
+	s.handle("POST /vpc/v2/regions/{region}/vpcs", s.createVPC)
+	s.handle("GET /vpc/v2/regions/{region}/vpcs/{id}", s.getVPC)
+	s.handle("PATCH /vpc/v2/regions/{region}/vpcs/{id}", s.updateVPC)
+	s.handle("DELETE /vpc/v2/regions/{region}/vpcs/{id}", s.deleteVPC)
+	s.handle("POST /vpc/v2/regions/{region}/vpcs/{id}/enable-routing", s.enableVPCRouting)
+
+	s.handle("GET /vpc/v2/regions/{region}/private-networks", s.listPrivateNetworks)
+	s.handle("POST /vpc/v2/regions/{region}/private-networks", s.createPrivateNetwork)
+	s.handle("GET /vpc/v2/regions/{region}/private-networks/{id}", s.getPrivateNetwork)
+	s.handle("PATCH /vpc/v2/regions/{region}/private-networks/{id}", s.updatePrivateNetwork)
+	s.handle("DELETE /vpc/v2/regions/{region}/private-networks/{id}", s.deletePrivateNetwork)
+}
+
+func (s *Server) findVPC(region scw.Region, id string) (*vpc.VPC, error) {
+	v, exists := s.vpc.vpcs[id]
+	if !exists || v.Region != region {
+		return nil, notFound("vpc", id)
+	}
+	return v, nil
+}
+
+// findPrivateNetwork returns a private network, which is also used by the other APIs to attach resources to it.
+func (s *Server) findPrivateNetwork(region scw.Region, id string) (*vpc.PrivateNetwork, error) {
+	pn, exists := s.vpc.privateNetworks[id]
+	if !exists || pn.Region != region {
+		return nil, notFound("private_network", id)
+	}
+	return pn, nil
+}
+
+// defaultVPC returns the default VPC of a project, creating it on first use as the API does.
+func (s *Server) defaultVPC(region scw.Region, projectID string) *vpc.VPC {
+	for _, v := range s.vpc.vpcs {
+		if v.Region == region && v.ProjectID == projectID && v.IsDefault {
+			return v
+		}
+	}
+
+	v := s.newVPC(region, projectID, "default", nil)
+	v.IsDefault = true
+	return v
+}
+
+func (s *Server) newVPC(region scw.Region, projectID string, name string, tags []string) *vpc.VPC {
+	v := &vpc.VPC{
+		ID:             s.newID(),
+		Name:           name,
+		OrganizationID: OrganizationID,
+		ProjectID:      projectID,
+		Region:         region,
+		Tags:           append([]string{}, tags...),
+		CreatedAt:      now(),
+		UpdatedAt:      now(),
+	}
+	s.vpc.vpcs[v.ID] = v
+	return v
+}
+
+func (s *Server) listVPCs(r *request) (any, error) {
+	vpcs := []*vpc.VPC(nil)
+	for _, v := range sorted(s.vpc.vpcs) {
+		if v.Region != r.region() || !r.matchesName(v.Name) || !r.matchesTags(v.Tags) {
+			continue
+		}
+		if !r.matches(map[string]string{
+			"project_id":      v.ProjectID,
+			"organization_id": v.OrganizationID,
+			"is_default":      strconv.FormatBool(v.IsDefault),
+			"routing_enabled": strconv.FormatBool(v.RoutingEnabled),
+		}) {
+			continue
+		}
+		vpcs = append(vpcs, v)
+	}
+
+	return &vpc.ListVPCsResponse{
+		Vpcs:       page(r, vpcs),
+		TotalCount: uint32(len(vpcs)),
+	}, nil
+}
+
+func (s *Server) createVPC(r *request) (any, error) {
+	req := &vpc.CreateVPCRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	v := s.newVPC(r.region(), project(&req.ProjectID), req.Name, req.Tags)
+	v.RoutingEnabled = req.EnableRouting
+	return v, nil
+}
+
+func (s *Server) getVPC(r *request) (any, error) {
+	return s.findVPC(r.region(), r.PathValue("id"))
+}
+
+func (s *Server) updateVPC(r *request) (any, error) {
+	v, err := s.findVPC(r.region(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &vpc.UpdateVPCRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		v.Name = *req.Name
+	}
+	if req.Tags != nil {
+		v.Tags = *req.Tags
+	}
+	v.UpdatedAt = now()
+	return v, nil
+}
+
+func (s *Server) deleteVPC(r *request) (any, error) {
+	v, err := s.findVPC(r.region(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	if v.PrivateNetworkCount > 0 {
+		return nil, preconditionFailed("resource_still_in_use", "the VPC still has private networks, delete them first")
+	}
+
+	delete(s.vpc.vpcs, v.ID)
+	return nil, nil
+}
+
+func (s *Server) enableVPCRouting(r *request) (any, error) {
+	v, err := s.findVPC(r.region(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	v.RoutingEnabled = true
+	v.UpdatedAt = now()
+	return v, nil
+}
+
+func (s *Server) listPrivateNetworks(r *request) (any, error) {
+	ids := r.values("private_network_ids")
+	privateNetworks := []*vpc.PrivateNetwork(nil)
+	for _, pn := range sorted(s.vpc.privateNetworks) {
+		if pn.Region != r.region() || !r.matchesName(pn.Name) || !r.matchesTags(pn.Tags) {
+			continue
+		}
+		if len(ids) > 0 && !slices.Contains(ids, pn.ID) {
+			continue
+		}
+		if !r.matches(map[string]string{
+			"project_id":      pn.ProjectID,
+			"organization_id": pn.OrganizationID,
+			"vpc_id":          pn.VpcID,
+			"dhcp_enabled":    strconv.FormatBool(pn.DHCPEnabled),
+		}) {
+			continue
+		}
+		privateNetworks = append(privateNetworks, pn)
+	}
+
+	return &vpc.ListPrivateNetworksResponse{
+		PrivateNetworks: page(r, privateNetworks),
+		TotalCount:      uint32(len(privateNetworks)),
+	}, nil
+}
+
+func (s *Server) createPrivateNetwork(r *request) (any, error) {
+	req := &vpc.CreatePrivateNetworkRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	projectID := project(&req.ProjectID)
+
+	var v *vpc.VPC
+	if req.VpcID != nil {
+		var err error
+		v, err = s.findVPC(r.region(), *req.VpcID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		v = s.defaultVPC(r.region(), projectID)
+	}
+
+	pn := &vpc.PrivateNetwork{
+		ID:             s.newID(),
+		Name:           req.Name,
+		OrganizationID: OrganizationID,
+		ProjectID:      projectID,
+		Region:         r.region(),
+		Tags:           append([]string{}, req.Tags...),
+		CreatedAt:      now(),
+		UpdatedAt:      now(),
+		VpcID:          v.ID,
+		DHCPEnabled:    true,
+	}
+
+	subnets := req.Subnets
+	hasIPv4, hasIPv6 := false, false
+	for _, subnet := range subnets {
+		if subnet.IP.To4() != nil {
+			hasIPv4 = true
+		} else {
+			hasIPv6 = true
+		}
+	}
+	// The API generates the subnets that are not given
+	if !hasIPv4 || !hasIPv6 {
+		s.vpc.subnets++
+	}
+	if !hasIPv4 {
+		subnets = append(subnets, mustParseIPNet(fmt.Sprintf("172.16.%d.0/22", (4*s.vpc.subnets)%256)))
+	}
+	if !hasIPv6 {
+		subnets = append(subnets, mustParseIPNet(fmt.Sprintf("fd00:0:0:%x::/64", s.vpc.subnets)))
+	}
+	for _, subnet := range subnets {
+		pn.Subnets = append(pn.Subnets, &vpc.Subnet{
+			ID:               s.newID(),
+			CreatedAt:        now(),
+			UpdatedAt:        now(),
+			Subnet:           subnet,
+			ProjectID:        projectID,
+			PrivateNetworkID: pn.ID,
+			VpcID:            v.ID,
+		})
+	}
+
+	s.vpc.privateNetworks[pn.ID] = pn
+	v.PrivateNetworkCount++
+	return pn, nil
+}
+
+func (s *Server) getPrivateNetwork(r *request) (any, error) {
+	return s.findPrivateNetwork(r.region(), r.PathValue("id"))
+}
+
+func (s *Server) updatePrivateNetwork(r *request) (any, error) {
+	pn, err := s.findPrivateNetwork(r.region(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	req := &vpc.UpdatePrivateNetworkRequest{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		pn.Name = *req.Name
+	}
+	if req.Tags != nil {
+		pn.Tags = *req.Tags
+	}
+	pn.UpdatedAt = now()
+	return pn, nil
+}
+
+func (s *Server) deletePrivateNetwork(r *request) (any, error) {
+	pn, err := s.findPrivateNetwork(r.region(), r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+	if s.privateNetworkInUse(pn.ID) {
+		return nil, preconditionFailed("resource_still_in_use", "the private network still has attached resources, detach them first")
+	}
+
+	s.releasePrivateNetworkIPs(pn.ID)
+	delete(s.vpc.privateNetworks, pn.ID)
+	if v, exists := s.vpc.vpcs[pn.VpcID]; exists {
+		v.PrivateNetworkCount--
+	}
+	return nil, nil
+}
+
+// privateNetworkInUse returns true if a resource of another API is attached to a private network.
+func (s *Server) privateNetworkInUse(id string) bool {
+	for _, ip := range s.ipam.ips {
+		if ip.Source.PrivateNetworkID != nil && *ip.Source.PrivateNetworkID == id && ip.Resource != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func mustParseIPNet(cidr string) scw.IPNet {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return scw.IPNet{IPNet: *ipNet}
+}
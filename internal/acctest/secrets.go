@@ -0,0 +1,333 @@
+package acctest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/scaleway/scaleway-sdk-go/strcase"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// SensitivePattern matches values that should be anonymized wherever they appear in cassettes:
+// in JSON, XML, form and raw bodies, in headers, and in the base64 encoded kubeconfigs of JSON bodies.
+type SensitivePattern struct {
+	// Name describes the values in the findings of ScanCassette
+	Name string
+	// Regexp matches the values. If it has a "secret" group, only this group is anonymized.
+	Regexp *regexp.Regexp
+	// Replacement is set in place of the values, it must not match Regexp
+	Replacement string
+}
+
+// SensitivePatterns lists the patterns of values that should be anonymized
+var SensitivePatterns = []SensitivePattern{
+	{
+		Name:        "JSON web token",
+		Regexp:      regexp.MustCompile(`eyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`),
+		Replacement: "eyJhbGciOiJub25lIn0.eyJzdWIiOiJhbm9ueW1pemVkIn0.",
+	},
+	{
+		Name:        "PEM private key",
+		Regexp:      regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----(?P<secret>[^-]+)-----END [A-Z ]*PRIVATE KEY-----`),
+		Replacement: "ANONYMIZED",
+	},
+	{
+		Name:        "NATS seed",
+		Regexp:      regexp.MustCompile(`(?:^|[^A-Z2-7])(?P<secret>S[UACONPX][A-Z2-7]{56})(?:$|[^A-Z2-7])`),
+		Replacement: "SUANONYMIZED",
+	},
+	{
+		Name:        "kubeconfig token",
+		Regexp:      regexp.MustCompile(`(?m)^\s*token:\s*(?P<secret>[A-Za-z0-9._~+/=-]{16,})\s*$`),
+		Replacement: "anonymized",
+	},
+}
+
+// SensitiveHeaders lists the headers that are removed from recorded requests and responses
+var SensitiveHeaders = []string{
+	"Authorization",
+	"X-Auth-Token",
+	"X-Amz-Security-Token",
+	"Set-Cookie",
+}
+
+// SecretFinding is a value of a cassette that looks like a credential.
+type SecretFinding struct {
+	// Interaction is the index of the interaction in the cassette
+	Interaction int
+	// Location is where the value is, e.g. "response body" or "request header Authorization"
+	Location string
+	// Rule is the name of the pattern, the path of the field or the header that matched the value
+	Rule string
+}
+
+func (f SecretFinding) String() string {
+	return fmt.Sprintf("interaction %d: %s in %s", f.Interaction, f.Rule, f.Location)
+}
+
+// ScanCassette returns the values of a cassette that the anonymizer of recorded cassettes would replace.
+// It does not change the cassette.
+func ScanCassette(c *cassette.Cassette) []SecretFinding {
+	findings := []SecretFinding(nil)
+	for index, i := range c.Interactions {
+		copied := *i
+		copied.Request.Headers = i.Request.Headers.Clone()
+		copied.Request.Form = cloneValues(i.Request.Form)
+		copied.Response.Headers = i.Response.Headers.Clone()
+		anonymizer{found: func(location string, rule string) {
+			findings = append(findings, SecretFinding{Interaction: index, Location: location, Rule: rule})
+		}}.interaction(&copied)
+	}
+	return findings
+}
+
+// cassetteSensitiveFieldsAnonymizer replaces the sensitive headers, fields and values of an interaction before it is saved.
+func cassetteSensitiveFieldsAnonymizer(i *cassette.Interaction) error {
+	i.Request.Headers = i.Request.Headers.Clone()
+	i.Response.Headers = i.Response.Headers.Clone()
+	anonymizer{}.interaction(i)
+	return nil
+}
+
+// anonymizeRequestBody anonymizes the body of a request the same way as the recorded ones, so that they can be compared.
+func anonymizeRequestBody(body string) string {
+	return anonymizer{}.body("request body", body)
+}
+
+// anonymizer replaces sensitive values, and reports them to found when set.
+type anonymizer struct {
+	found func(location string, rule string)
+}
+
+func (a anonymizer) report(location string, rule string) {
+	if a.found != nil {
+		a.found(location, rule)
+	}
+}
+
+func (a anonymizer) interaction(i *cassette.Interaction) {
+	a.headers("request", i.Request.Headers)
+	a.headers("response", i.Response.Headers)
+	i.Request.Body = a.body("request body", i.Request.Body)
+	i.Response.Body = a.body("response body", i.Response.Body)
+	for key, values := range i.Request.Form {
+		for index, value := range values {
+			values[index] = a.field("request form", []string{key}, value)
+		}
+	}
+}
+
+func (a anonymizer) headers(location string, headers http.Header) {
+	for key, values := range headers {
+		if slices.ContainsFunc(SensitiveHeaders, func(header string) bool { return strings.EqualFold(header, key) }) {
+			a.report(location+" header "+key, key)
+			delete(headers, key)
+			continue
+		}
+		for index, value := range values {
+			values[index] = a.text(location+" header "+key, value)
+		}
+	}
+}
+
+// body anonymizes a JSON, XML, form or raw body.
+func (a anonymizer) body(location string, body string) string {
+	trimmed := strings.TrimSpace(body)
+	switch {
+	case trimmed == "":
+		return body
+	case (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(trimmed)):
+		return a.jsonBody(location, body)
+	case strings.HasPrefix(trimmed, "<"):
+		return a.text(location, a.xmlBody(location, body))
+	}
+	if values, err := url.ParseQuery(body); err == nil && strings.Contains(body, "=") && !strings.ContainsAny(body, " \n") {
+		return a.formBody(location, body, values)
+	}
+	return a.text(location, body)
+}
+
+func (a anonymizer) jsonBody(location string, body string) string {
+	var value interface{}
+	if err := json.Unmarshal([]byte(body), &value); err != nil {
+		return body
+	}
+
+	changed := false
+	value = a.json(location, nil, value, &changed)
+	if !changed {
+		return body
+	}
+	anonymized, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return string(anonymized)
+}
+
+func (a anonymizer) json(location string, path []string, value interface{}, changed *bool) interface{} {
+	if replacement, rule, isSensitive := sensitiveField(path); isSensitive {
+		if value != nil && !reflect.DeepEqual(value, replacement) {
+			a.report(location, rule)
+			*changed = true
+			return replacement
+		}
+		return value
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			value[key] = a.json(location, append(slices.Clip(path), key), item, changed)
+		}
+	case []interface{}:
+		for index, item := range value {
+			value[index] = a.json(location, append(slices.Clip(path), strconv.Itoa(index)), item, changed)
+		}
+	case string:
+		anonymized := a.text(location, value)
+		if decoded, isKubeconfig := decodeKubeconfig(value); isKubeconfig {
+			if anonymizedKubeconfig := a.text(location+" kubeconfig", decoded); anonymizedKubeconfig != decoded {
+				anonymized = base64.StdEncoding.EncodeToString([]byte(anonymizedKubeconfig))
+			}
+		}
+		if anonymized != value {
+			*changed = true
+		}
+		return anonymized
+	}
+	return value
+}
+
+// decodeKubeconfig returns the content of a base64 encoded kubeconfig, as returned by the Kubernetes API.
+func decodeKubeconfig(value string) (string, bool) {
+	if len(value) < 64 || strings.ContainsAny(value, " \n") {
+		return "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || !strings.Contains(string(decoded), "kind: Config") {
+		return "", false
+	}
+	return string(decoded), true
+}
+
+// xmlBody anonymizes the text of the XML elements named as the last key of a sensitive field, e.g. <SecretKey> for "**.secret_key".
+func (a anonymizer) xmlBody(location string, body string) string {
+	for path, replacement := range SensitiveFields {
+		keys := strings.Split(path, ".")
+		name := keys[len(keys)-1]
+		if name == "*" || name == "**" {
+			continue
+		}
+		element := regexp.MustCompile(`(<` + regexp.QuoteMeta(strcase.ToPascal(name)) + `>)([^<]*)(</` + regexp.QuoteMeta(strcase.ToPascal(name)) + `>)`)
+		body = element.ReplaceAllStringFunc(body, func(match string) string {
+			parts := element.FindStringSubmatch(match)
+			value := fmt.Sprint(replacement)
+			if parts[2] == value {
+				return match
+			}
+			a.report(location, path)
+			return parts[1] + value + parts[3]
+		})
+	}
+	return body
+}
+
+func (a anonymizer) formBody(location string, body string, values url.Values) string {
+	changed := false
+	for key, fieldValues := range values {
+		for index, value := range fieldValues {
+			if anonymized := a.field(location, []string{key}, value); anonymized != value {
+				fieldValues[index] = anonymized
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return body
+	}
+	return values.Encode()
+}
+
+// field anonymizes the value of a form field.
+func (a anonymizer) field(location string, path []string, value string) string {
+	if replacement, rule, isSensitive := sensitiveField(path); isSensitive {
+		if value != fmt.Sprint(replacement) {
+			a.report(location, rule)
+			return fmt.Sprint(replacement)
+		}
+		return value
+	}
+	return a.text(location, value)
+}
+
+// text replaces the values matching SensitivePatterns.
+func (a anonymizer) text(location string, text string) string {
+	for _, pattern := range SensitivePatterns {
+		secretGroup := pattern.Regexp.SubexpIndex("secret")
+		text = pattern.Regexp.ReplaceAllStringFunc(text, func(match string) string {
+			if secretGroup < 0 {
+				a.report(location, pattern.Name)
+				return pattern.Replacement
+			}
+			indexes := pattern.Regexp.FindStringSubmatchIndex(match)
+			if indexes[2*secretGroup] < 0 || match[indexes[2*secretGroup]:indexes[2*secretGroup+1]] == pattern.Replacement {
+				return match
+			}
+			a.report(location, pattern.Name)
+			return match[:indexes[2*secretGroup]] + pattern.Replacement + match[indexes[2*secretGroup+1]:]
+		})
+	}
+	return text
+}
+
+// sensitiveField returns the replacement of a field if its path matches one of SensitiveFields.
+func sensitiveField(path []string) (interface{}, string, bool) {
+	if len(path) == 0 {
+		return nil, "", false
+	}
+	for rule, replacement := range SensitiveFields {
+		if matchFieldPath(strings.Split(rule, "."), path) {
+			return replacement, rule, true
+		}
+	}
+	return nil, "", false
+}
+
+// matchFieldPath returns true if the path of a field matches the keys of a path of SensitiveFields.
+func matchFieldPath(keys []string, path []string) bool {
+	if len(keys) == 0 {
+		return len(path) == 0
+	}
+	if keys[0] == "**" {
+		for skipped := 0; skipped <= len(path); skipped++ {
+			if matchFieldPath(keys[1:], path[skipped:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 || (keys[0] != "*" && keys[0] != path[0]) {
+		return false
+	}
+	return matchFieldPath(keys[1:], path[1:])
+}
+
+func cloneValues(values url.Values) url.Values {
+	if values == nil {
+		return nil
+	}
+	cloned := make(url.Values, len(values))
+	for key, value := range values {
+		cloned[key] = slices.Clone(value)
+	}
+	return cloned
+}
@@ -1,11 +1,15 @@
 package acctest_test
 
 import (
+	"bytes"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMain(m *testing.M) {
@@ -15,3 +19,113 @@ func TestMain(m *testing.M) {
 func TestIsTestResource(t *testing.T) {
 	assert.True(t, acctest.IsTestResource("tf_tests_mnq_sqs_queue_default_project"))
 }
+
+func sweeperNames(sweepers []*resource.Sweeper) []string {
+	names := []string(nil)
+	for _, s := range sweepers {
+		names = append(names, s.Name)
+	}
+	return names
+}
+
+func TestSortSweepers(t *testing.T) {
+	sweepers := map[string]*resource.Sweeper{
+		"scaleway_vpc":                 {Name: "scaleway_vpc", Dependencies: []string{"scaleway_vpc_private_network"}},
+		"scaleway_vpc_private_network": {Name: "scaleway_vpc_private_network", Dependencies: []string{"scaleway_instance_server", "scaleway_lb"}},
+		"scaleway_instance_server":     {Name: "scaleway_instance_server"},
+		"scaleway_instance_ip":         {Name: "scaleway_instance_ip", Dependencies: []string{"scaleway_instance_server"}},
+	}
+
+	ordered, err := acctest.SortSweepers(sweepers, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"scaleway_instance_server", "scaleway_instance_ip", "scaleway_vpc_private_network", "scaleway_vpc"}, sweeperNames(ordered))
+
+	ordered, err = acctest.SortSweepers(sweepers, "private_network,instance_ip")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"scaleway_instance_server", "scaleway_instance_ip", "scaleway_vpc_private_network"}, sweeperNames(ordered))
+
+	sweepers["scaleway_instance_server"].Dependencies = []string{"scaleway_vpc"}
+	_, err = acctest.SortSweepers(sweepers, "")
+	require.ErrorContains(t, err, "scaleway_instance_server -> scaleway_vpc -> scaleway_vpc_private_network -> scaleway_instance_server")
+}
+
+func TestRunSweepers(t *testing.T) {
+	ran := []string(nil)
+	sweeper := func(name string, err error, dependencies ...string) {
+		acctest.AddTestSweepers(name, &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(region string) error {
+				ran = append(ran, name+" "+region)
+				return err
+			},
+		})
+	}
+	sweeper("scaleway_run_sweepers_server", errors.New("server is locked"))
+	sweeper("scaleway_run_sweepers_ip", nil)
+	sweeper("scaleway_run_sweepers_private_network", nil, "scaleway_run_sweepers_server")
+
+	err := acctest.RunSweepers([]string{"fr-par", "nl-ams"}, "run_sweepers", false)
+	require.ErrorContains(t, err, "sweeper scaleway_run_sweepers_server for region (fr-par) failed: server is locked")
+	require.ErrorContains(t, err, "sweeper scaleway_run_sweepers_server for region (nl-ams) failed: server is locked")
+	assert.Equal(t, []string{
+		"scaleway_run_sweepers_ip fr-par",
+		"scaleway_run_sweepers_server fr-par",
+		"scaleway_run_sweepers_ip nl-ams",
+		"scaleway_run_sweepers_server nl-ams",
+	}, ran)
+
+	ran = nil
+	require.Error(t, acctest.RunSweepers([]string{"fr-par"}, "run_sweepers", true))
+	assert.Equal(t, []string{
+		"scaleway_run_sweepers_ip fr-par",
+		"scaleway_run_sweepers_server fr-par",
+		"scaleway_run_sweepers_private_network fr-par",
+	}, ran)
+}
+
+func TestSweepResource(t *testing.T) {
+	dryRun, minAge := *acctest.SweepDryRun, *acctest.SweepMinAge
+	defer func() { *acctest.SweepDryRun, *acctest.SweepMinAge = dryRun, minAge }()
+	*acctest.SweepMinAge = time.Hour
+
+	deleted := []string(nil)
+	sweepResource := func(r acctest.SweptResource, age time.Duration) error {
+		createdAt := time.Now().Add(-age)
+		r.Type = "scaleway_test_resource"
+		r.Locality = "fr-par-1"
+		r.CreatedAt = &createdAt
+		return acctest.SweepResource(r, func() error {
+			if r.ID == "tf-tests-failing" {
+				return errors.New("resource is in use")
+			}
+			deleted = append(deleted, r.ID)
+			return nil
+		})
+	}
+	sweep := func(name string, age time.Duration) error {
+		return sweepResource(acctest.SweptResource{ID: name, Name: name}, age)
+	}
+
+	require.NoError(t, sweep("tf-tests-old", 2*time.Hour))
+	require.NoError(t, sweep("tf_tests_old", 2*time.Hour))
+	require.NoError(t, sweep("production", 2*time.Hour))
+	require.NoError(t, sweep("tf-state-bucket", 2*time.Hour))
+	require.NoError(t, sweep("tf-prod-db-main", 2*time.Hour))
+	require.NoError(t, sweep("tf-tests-recent", time.Minute))
+	require.ErrorContains(t, sweep("tf-tests-failing", 2*time.Hour), "resource is in use")
+	// Resources without name are only swept if their type opts in
+	require.NoError(t, sweepResource(acctest.SweptResource{ID: "unnamed"}, 2*time.Hour))
+	require.NoError(t, sweepResource(acctest.SweptResource{ID: "unnamed-ip", SweepUnnamed: true}, 2*time.Hour))
+	assert.Equal(t, []string{"tf-tests-old", "tf_tests_old", "unnamed-ip"}, deleted)
+
+	*acctest.SweepDryRun = true
+	require.NoError(t, sweep("tf-tests-dry-run", 2*time.Hour))
+	assert.Len(t, deleted, 3)
+
+	summary := &bytes.Buffer{}
+	acctest.WriteSweepSummary(summary)
+	assert.Equal(t, `TYPE                    LOCALITY  FOUND  DELETED  FAILED  RECENT  LEAKED
+scaleway_test_resource  fr-par-1  5      3        1       1       2
+`, summary.String())
+}
@@ -2,12 +2,245 @@ package acctest
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"text/tabwriter"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 )
 
+var (
+	// SweepDryRun lists the resources that the sweepers would delete without deleting them
+	SweepDryRun = flag.Bool("sweep-dry-run", false, "List the resources the sweepers would delete without deleting them")
+	// SweepMinAge keeps the resources created more recently, as they may belong to running tests
+	SweepMinAge = flag.Duration("sweep-min-age", 0, "Only sweep the resources older than this duration")
+)
+
+// sweepers are the sweepers registered with AddTestSweepers
+var sweepers = map[string]*resource.Sweeper{}
+
+// AddTestSweepers registers a sweeper run by TestMain.
+// Its dependencies are the sweepers run before it, e.g. the servers before the private networks they are attached to.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, exists := sweepers[name]; exists {
+		panic(fmt.Sprintf("sweeper %s is already registered", name))
+	}
+	sweepers[name] = s
+}
+
+// TestMain runs the sweepers registered with AddTestSweepers when the -sweep flag is set, and the tests otherwise.
+// It supports the -sweep, -sweep-run and -sweep-allow-failures flags of the SDK, as well as -sweep-dry-run and -sweep-min-age.
+func TestMain(m *testing.M) {
+	flag.Parse()
+	regions := flag.Lookup("sweep").Value.String()
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	allowFailures := flag.Lookup("sweep-allow-failures").Value.String() == "true"
+	err := RunSweepers(strings.Split(regions, ","), flag.Lookup("sweep-run").Value.String(), allowFailures)
+	WriteSweepSummary(os.Stdout)
+	if err != nil {
+		logging.L.Errorf("sweepers failed: %s", err)
+		os.Exit(1)
+	}
+}
+
+// RunSweepers runs the registered sweepers whose name contains one of the comma separated filters, all of them if empty,
+// after their dependencies. A failure does not stop the other sweepers and regions, the errors are all returned.
+// Without allowFailures, the sweepers that depend on a failed one are skipped in its region, as the resources they
+// sweep are likely still in use.
+func RunSweepers(regions []string, filters string, allowFailures bool) error {
+	ordered, err := SortSweepers(sweepers, filters)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		// failed are the sweepers that failed or were skipped in the region
+		failed := map[string]bool{}
+		for _, s := range ordered {
+			if !allowFailures {
+				if i := slices.IndexFunc(s.Dependencies, func(dependency string) bool { return failed[dependency] }); i >= 0 {
+					logging.L.Warningf("sweeper: skipping %s for region (%s) as %s failed", s.Name, region, s.Dependencies[i])
+					failed[s.Name] = true
+					continue
+				}
+			}
+
+			logging.L.Infof("sweeper: running %s for region (%s)", s.Name, region)
+			if err := s.F(region); err != nil {
+				err = fmt.Errorf("sweeper %s for region (%s) failed: %w", s.Name, region, err)
+				logging.L.Errorf("%s", err)
+				errs = append(errs, err)
+				failed[s.Name] = true
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SortSweepers returns the sweepers whose name contains one of the comma separated filters, all of them if empty,
+// and their dependencies, each one after its dependencies.
+// Dependencies that are not registered are ignored, they are swept when running the sweepers of all services.
+func SortSweepers(registered map[string]*resource.Sweeper, filters string) ([]*resource.Sweeper, error) {
+	names := make([]string, 0, len(registered))
+	for name := range registered {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var ordered []*resource.Sweeper
+	// visited is false while the dependencies of a sweeper are being visited, and true once it is ordered
+	visited := make(map[string]bool, len(registered))
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		done, seen := visited[name]
+		if done {
+			return nil
+		}
+		if seen {
+			return fmt.Errorf("sweepers have a dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		}
+		s, exists := registered[name]
+		if !exists {
+			logging.L.Warningf("sweeper: %s is not registered, ignoring it as a dependency of %s", name, path[len(path)-1])
+			return nil
+		}
+
+		visited[name] = false
+		for _, dependency := range s.Dependencies {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+		visited[name] = true
+		ordered = append(ordered, s)
+		return nil
+	}
+
+	for _, name := range names {
+		if filters != "" && !slices.ContainsFunc(strings.Split(strings.ToLower(filters), ","), func(filter string) bool {
+			return strings.Contains(strings.ToLower(name), filter)
+		}) {
+			continue
+		}
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+// SweptResource is a resource listed by a sweeper
+type SweptResource struct {
+	// Type is the terraform type of the resource, e.g. scaleway_instance_server
+	Type string
+	// Locality is the zone or region of the resource
+	Locality string
+	ID       string
+	// Name identifies the resources created by the tests, see IsTestResource.
+	Name      string
+	CreatedAt *time.Time
+	// SweepUnnamed sweeps the resource without checking its name, for the types of resources that have no name
+	// or are only created by the tests, such as the resources of the test projects.
+	SweepUnnamed bool
+}
+
+// SweepResource deletes a resource with del if it was created by the tests and is older than SweepMinAge,
+// or only lists it with SweepDryRun. The outcome is counted in the summary of the sweepers.
+func SweepResource(r SweptResource, del func() error) error {
+	switch {
+	case !r.SweepUnnamed && !IsTestResource(r.Name):
+		logging.L.Debugf("sweeper: keeping %s %s (%s) in (%s), it was not created by the tests", r.Type, r.ID, r.Name, r.Locality)
+		return nil
+	case r.CreatedAt != nil && time.Since(*r.CreatedAt) < *SweepMinAge:
+		logging.L.Debugf("sweeper: keeping %s %s (%s) in (%s), it was created less than %s ago", r.Type, r.ID, r.Name, r.Locality, *SweepMinAge)
+		summary.add(r, func(c *sweepCounts) { c.Recent++ })
+		return nil
+	case *SweepDryRun:
+		logging.L.Infof("sweeper: would delete %s %s (%s) in (%s)", r.Type, r.ID, r.Name, r.Locality)
+		summary.add(r, func(c *sweepCounts) { c.Found++ })
+		return nil
+	}
+
+	if err := del(); err != nil {
+		summary.add(r, func(c *sweepCounts) { c.Found++; c.Failed++ })
+		return fmt.Errorf("deleting %s %s in (%s): %w", r.Type, r.ID, r.Locality, err)
+	}
+	summary.add(r, func(c *sweepCounts) { c.Found++; c.Deleted++ })
+	return nil
+}
+
+type sweepCounts struct {
+	Found   int
+	Deleted int
+	Failed  int
+	Recent  int
+}
+
+type sweepSummaryKey struct {
+	Type     string
+	Locality string
+}
+
+type sweepSummary struct {
+	mu     sync.Mutex
+	counts map[sweepSummaryKey]*sweepCounts
+}
+
+var summary = sweepSummary{counts: map[sweepSummaryKey]*sweepCounts{}}
+
+func (s *sweepSummary) add(r SweptResource, update func(c *sweepCounts)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := sweepSummaryKey{Type: r.Type, Locality: r.Locality}
+	if s.counts[key] == nil {
+		s.counts[key] = &sweepCounts{}
+	}
+	update(s.counts[key])
+}
+
+// WriteSweepSummary writes the resources created by the tests that the sweepers found, per type and locality:
+// the ones deleted, the ones that failed to be deleted and the recent ones that were kept.
+// The resources found but not deleted are leaked, all of them in a dry run.
+func WriteSweepSummary(out io.Writer) {
+	summary.mu.Lock()
+	defer summary.mu.Unlock()
+
+	keys := make([]sweepSummaryKey, 0, len(summary.counts))
+	for key := range summary.counts {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b sweepSummaryKey) int {
+		if a.Type != b.Type {
+			return strings.Compare(a.Type, b.Type)
+		}
+		return strings.Compare(a.Locality, b.Locality)
+	})
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "TYPE\tLOCALITY\tFOUND\tDELETED\tFAILED\tRECENT\tLEAKED")
+	for _, key := range keys {
+		c := summary.counts[key]
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\n", key.Type, key.Locality, c.Found, c.Deleted, c.Failed, c.Recent, c.Found-c.Deleted)
+	}
+	_ = w.Flush()
+}
+
 func Sweep(f func(scwClient *scw.Client) error) error {
 	ctx := context.Background()
 	m, err := meta.NewMeta(ctx, &meta.Config{
@@ -19,7 +252,9 @@ func Sweep(f func(scwClient *scw.Client) error) error {
 	return f(m.ScwClient())
 }
 
+// SweepZones runs f in every zone, and returns the errors of all zones.
 func SweepZones(zones []scw.Zone, f func(scwClient *scw.Client, zone scw.Zone) error) error {
+	var errs []error
 	for _, zone := range zones {
 		client, err := sharedClientForZone(zone)
 		if err != nil {
@@ -27,10 +262,10 @@ func SweepZones(zones []scw.Zone, f func(scwClient *scw.Client, zone scw.Zone) e
 		}
 		err = f(client, zone)
 		if err != nil {
-			logging.L.Warningf("error running sweepZones, ignoring: %s", err)
+			errs = append(errs, fmt.Errorf("zone %s: %w", zone, err))
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func SweepRegions(regions []scw.Region, f func(scwClient *scw.Client, region scw.Region) error) error {
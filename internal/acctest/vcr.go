@@ -26,10 +26,13 @@ import (
 // UpdateCassettes will update all cassettes of a given test
 var UpdateCassettes = flag.Bool("cassettes", os.Getenv("TF_UPDATE_CASSETTES") == "true", "Record Cassettes")
 
-// SensitiveFields is a map with keys listing fields that should be anonymized
+// SensitiveFields is a map with keys listing paths of fields that should be anonymized
 // value will be set in place of its old value
+// A path lists keys separated by dots, "*" matches any key or array index and "**" any number of nested keys,
+// e.g. "**.secret_key" matches secret_key at any depth and "credentials.*.token" the tokens of a list of credentials.
+// In XML bodies, the last key matches the elements of the same name in PascalCase, and in form bodies the fields of the same name.
 var SensitiveFields = map[string]interface{}{
-	"secret_key": "00000000-0000-0000-0000-000000000000",
+	"**.secret_key": "00000000-0000-0000-0000-000000000000",
 }
 
 // QueryMatcherIgnore contains the list of query value that should be ignored when matching requests with cassettes
@@ -128,6 +131,9 @@ func cassetteBodyMatcher(actualRequest *http.Request, cassetteRequest cassette.R
 		panic(fmt.Errorf("cassette body matcher: failed to read actualRequest body: %w", err)) // lintignore: R009
 	}
 
+	// Recorded bodies are anonymized
+	actualRawBody = []byte(anonymizeRequestBody(string(actualRawBody)))
+
 	// Try to match raw bodies if they are not JSON (ex: cloud-init config)
 	if string(actualRawBody) == cassetteRequest.Body {
 		return true
@@ -226,26 +232,6 @@ func cassetteMatcher(actual *http.Request, expected cassette.Request) bool {
 		cassetteBodyMatcher(actual, expected)
 }
 
-func cassetteSensitiveFieldsAnonymizer(i *cassette.Interaction) error {
-	var jsonBody map[string]interface{}
-	err := json.Unmarshal([]byte(i.Response.Body), &jsonBody)
-	if err != nil {
-		//nolint:nilerr
-		return nil
-	}
-	for key, value := range SensitiveFields {
-		if _, ok := jsonBody[key]; ok {
-			jsonBody[key] = value
-		}
-	}
-	anonymizedBody, err := json.Marshal(jsonBody)
-	if err != nil {
-		return fmt.Errorf("failed to marshal anonymized body: %w", err)
-	}
-	i.Response.Body = string(anonymizedBody)
-	return nil
-}
-
 // getHTTPRecoder creates a new httpClient that records all HTTP requests in a cassette.
 // This cassette is then replayed whenever tests are executed again. This means that once the
 // requests are recorded in the cassette, no more real HTTP requests must be made to run the tests.
@@ -284,16 +270,20 @@ func getHTTPRecoder(t *testing.T, pkgFolder string, update bool) (client *http.C
 	// Add custom matcher for requests and cassettes
 	r.SetMatcher(cassetteMatcher)
 
-	// Add a filter which removes Authorization headers from all requests:
-	r.AddHook(func(i *cassette.Interaction) error {
-		i.Request.Headers = i.Request.Headers.Clone()
-		delete(i.Request.Headers, "x-auth-token")
-		delete(i.Request.Headers, "X-Auth-Token")
-		delete(i.Request.Headers, "Authorization")
-		return nil
-	}, recorder.BeforeSaveHook)
+	// Replay interactions in order and track the ones used by the test
+	var usage *cassetteUsage
+	if recorderMode == recorder.ModeReplayOnly {
+		if err := validateUnusedInteractionsMode(*UnusedInteractions); err != nil {
+			return nil, nil, err
+		}
+		usage, err = newCassetteUsage(cassetteFilePath, *UnusedInteractions == UnusedInteractionsFail)
+		if err != nil {
+			return nil, nil, err
+		}
+		r.SetMatcher(usage.matcher)
+	}
 
-	// Add a filter that will replace sensitive values with fixed values
+	// Add a filter that will remove sensitive headers and replace sensitive values with fixed values
 	r.AddHook(cassetteSensitiveFieldsAnonymizer, recorder.BeforeSaveHook)
 
 	retryOptions := transport.RetryableTransportOptions{}
@@ -303,5 +293,8 @@ func getHTTPRecoder(t *testing.T, pkgFolder string, update bool) (client *http.C
 
 	return &http.Client{Transport: transport.NewRetryableTransportWithOptions(r, retryOptions)}, func() {
 		require.NoError(t, r.Stop()) // Make sure recorder is stopped once done with it
+		if usage != nil && !t.Failed() && !t.Skipped() {
+			usage.report(t, *UnusedInteractions)
+		}
 	}, nil
 }
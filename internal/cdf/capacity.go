@@ -0,0 +1,164 @@
+package cdf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	account "github.com/scaleway/scaleway-sdk-go/api/account/v3"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+// CapacityCheckFunc checks that the resources requested by a plan are available.
+// It returns a *CapacityError if they are not.
+type CapacityCheckFunc func(ctx context.Context, diff *schema.ResourceDiff, client *scw.Client) error
+
+// CapacityError is returned when a plan requests more resources than available.
+type CapacityError struct {
+	// Resource describes what is requested, e.g. "commercial type DEV1-S in fr-par-2"
+	Resource  string
+	Reason    string
+	Requested uint64
+	Available uint64
+}
+
+func (e *CapacityError) Error() string {
+	return fmt.Sprintf("%s %s: requested %d, available %d", e.Resource, e.Reason, e.Requested, e.Available)
+}
+
+// OutOfStockError returns the error of a server type that cannot be ordered.
+func OutOfStockError(resource string, requested uint64) error {
+	return &CapacityError{
+		Resource:  resource,
+		Reason:    "is out of stock",
+		Requested: requested,
+		Available: 0,
+	}
+}
+
+// CapacityCheck runs a capacity check when the provider capacity_checks flag is enabled,
+// on creation or when one of the given keys changes.
+// Errors of the APIs used by the check are logged and ignored, so that a check never prevents a plan on its own.
+func CapacityCheck(check CapacityCheckFunc, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		scwMeta, ok := m.(*meta.Meta)
+		if !ok || scwMeta == nil || !scwMeta.CapacityChecks() {
+			return nil
+		}
+		if diff.Id() != "" && !diff.HasChanges(keys...) {
+			return nil
+		}
+		for _, key := range keys {
+			if !diff.NewValueKnown(key) {
+				return nil
+			}
+		}
+
+		err := check(ctx, diff, scwMeta.ScwClient())
+		capacityErr := (*CapacityError)(nil)
+		if err != nil && !errors.As(err, &capacityErr) {
+			tflog.Warn(ctx, "capacity could not be checked", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil
+		}
+		return err
+	}
+}
+
+// Quota is an organization quota that may limit a resource.
+type Quota struct {
+	// Name is the name of the quotum in the IAM API
+	Name string
+	// Used returns the current usage of the quotum
+	Used func() (uint64, error)
+}
+
+// CheckQuota checks that each of the given quota defined for the organization allows the requested amount,
+// and returns the error of the first one that does not. Quota that are not defined or unlimited are ignored.
+// The organization is the default one of the client, or the one of its default project.
+func CheckQuota(ctx context.Context, client *scw.Client, requested uint64, quotas ...Quota) error {
+	if requested == 0 {
+		return nil
+	}
+	organizationID, err := quotaOrganizationID(ctx, client)
+	if err != nil {
+		return err
+	}
+	if organizationID == "" {
+		tflog.Warn(ctx, "organization quotas could not be checked, no default organization nor project is configured")
+		return nil
+	}
+
+	names := make([]string, 0, len(quotas))
+	for _, quota := range quotas {
+		names = append(names, quota.Name)
+	}
+
+	res, err := iam.NewAPI(client).ListQuota(&iam.ListQuotaRequest{
+		OrganizationID: organizationID,
+		QuotumNames:    names,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return err
+	}
+
+	limits := make(map[string]*iam.Quotum, len(res.Quota))
+	for _, quotum := range res.Quota {
+		limits[quotum.Name] = quotum
+	}
+
+	for _, quota := range quotas {
+		quotum, exists := limits[quota.Name]
+		if !exists {
+			continue
+		}
+		if quotum.Limit == nil || quotum.Unlimited != nil && *quotum.Unlimited {
+			continue
+		}
+
+		used, err := quota.Used()
+		if err != nil {
+			return err
+		}
+
+		available := uint64(0)
+		if *quotum.Limit > used {
+			available = *quotum.Limit - used
+		}
+		if requested > available {
+			return &CapacityError{
+				Resource:  fmt.Sprintf("organization quota %s (%d used out of %d)", quota.Name, used, *quotum.Limit),
+				Reason:    "would be exceeded",
+				Requested: requested,
+				Available: available,
+			}
+		}
+	}
+
+	return nil
+}
+
+// quotaOrganizationID returns the organization whose quotas apply to the client: its default organization,
+// or the organization of its default project as many configurations only set the project.
+func quotaOrganizationID(ctx context.Context, client *scw.Client) (string, error) {
+	if organizationID, exists := client.GetDefaultOrganizationID(); exists {
+		return organizationID, nil
+	}
+	projectID, exists := client.GetDefaultProjectID()
+	if !exists {
+		return "", nil
+	}
+
+	project, err := account.NewProjectAPI(client).GetProject(&account.ProjectAPIGetProjectRequest{
+		ProjectID: projectID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	return project.OrganizationID, nil
+}
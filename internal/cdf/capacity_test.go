@@ -0,0 +1,108 @@
+package cdf_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	account "github.com/scaleway/scaleway-sdk-go/api/account/v3"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapacityErrorMessage(t *testing.T) {
+	err := cdf.OutOfStockError("commercial type DEV1-S in fr-par-2", 3)
+	assert.EqualError(t, err, "commercial type DEV1-S in fr-par-2 is out of stock: requested 3, available 0")
+
+	capacityErr := &cdf.CapacityError{}
+	assert.True(t, errors.As(err, &capacityErr))
+	assert.Equal(t, uint64(3), capacityErr.Requested)
+}
+
+func TestCapacityCheckDisabledWithoutMeta(t *testing.T) {
+	called := false
+	check := cdf.CapacityCheck(func(_ context.Context, _ *schema.ResourceDiff, _ *scw.Client) error {
+		called = true
+		return cdf.OutOfStockError("offer", 1)
+	}, "type")
+
+	assert.NoError(t, check(context.Background(), nil, nil))
+	assert.False(t, called)
+}
+
+// quotaClient returns a client of an IAM API that only lists the given quota of the organization,
+// and of an Account API that only gets a project of this organization.
+// The client has the default organization, or the default project if withProject is set.
+func quotaClient(t *testing.T, withProject bool, quota ...*iam.Quotum) *scw.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/iam/v1alpha1/quota" && r.URL.Query().Get("organization_id") == "11111111-1111-1111-1111-111111111111":
+			_ = json.NewEncoder(w).Encode(&iam.ListQuotaResponse{
+				Quota:      quota,
+				TotalCount: uint64(len(quota)),
+			})
+		case r.URL.Path == "/account/v3/projects/22222222-2222-2222-2222-222222222222":
+			_ = json.NewEncoder(w).Encode(&account.Project{
+				ID:             "22222222-2222-2222-2222-222222222222",
+				OrganizationID: "11111111-1111-1111-1111-111111111111",
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	defaultOwner := scw.WithDefaultOrganizationID("11111111-1111-1111-1111-111111111111")
+	if withProject {
+		defaultOwner = scw.WithDefaultProjectID("22222222-2222-2222-2222-222222222222")
+	}
+	client, err := scw.NewClient(
+		scw.WithAPIURL(server.URL),
+		scw.WithAuth("SCWXXXXXXXXXXXXXXXXX", "11111111-1111-1111-1111-111111111111"),
+		defaultOwner,
+	)
+	require.NoError(t, err)
+	return client
+}
+
+func TestCheckQuota(t *testing.T) {
+	ctx := context.Background()
+	used := func(n uint64) func() (uint64, error) {
+		return func() (uint64, error) { return n, nil }
+	}
+	typeQuota := cdf.Quota{Name: "instances_dev1_s_servers", Used: used(2)}
+	globalQuota := cdf.Quota{Name: "instances_servers", Used: used(9)}
+
+	client := quotaClient(t, false,
+		&iam.Quotum{Name: "instances_dev1_s_servers", Limit: scw.Uint64Ptr(10)},
+		&iam.Quotum{Name: "instances_servers", Limit: scw.Uint64Ptr(10)},
+	)
+	require.NoError(t, cdf.CheckQuota(ctx, client, 1, typeQuota, globalQuota))
+
+	// The quota of the commercial type allows the servers, but not the global one
+	err := cdf.CheckQuota(ctx, client, 2, typeQuota, globalQuota)
+	capacityErr := (*cdf.CapacityError)(nil)
+	require.ErrorAs(t, err, &capacityErr)
+	assert.EqualError(t, err, "organization quota instances_servers (9 used out of 10) would be exceeded: requested 2, available 1")
+
+	// Unlimited and undefined quota are ignored
+	client = quotaClient(t, false,
+		&iam.Quotum{Name: "instances_dev1_s_servers", Unlimited: scw.BoolPtr(true)},
+	)
+	require.NoError(t, cdf.CheckQuota(ctx, client, 100, typeQuota, globalQuota))
+
+	// Without default organization, the quotas of the organization of the default project are checked
+	client = quotaClient(t, true,
+		&iam.Quotum{Name: "instances_servers", Limit: scw.Uint64Ptr(10)},
+	)
+	assert.EqualError(t, cdf.CheckQuota(ctx, client, 2, typeQuota, globalQuota), "organization quota instances_servers (9 used out of 10) would be exceeded: requested 2, available 1")
+}
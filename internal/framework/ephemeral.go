@@ -0,0 +1,21 @@
+package framework
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+)
+
+// RegionEphemeralAttribute returns the schema of the region of an ephemeral resource.
+// It defaults to the region of the regional ID the ephemeral resource reads, then to the region of the provider.
+func RegionEphemeralAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The region of the resource",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			ValidateDiagFunc(locality.ValidateStringInSliceWithWarning(regional.AllRegions(), "region")),
+		},
+	}
+}
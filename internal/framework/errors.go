@@ -0,0 +1,99 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+)
+
+// AddError adds the diagnostics of an error to diags, with summary describing the operation that failed.
+// Errors of the Scaleway APIs are translated with httperrors.Diagnostics, as for SDKv2 resources.
+// Their attribute paths are built from the argument names of the APIs: resources registered with WithOperations
+// have them trimmed to their schema with TrimAttributePaths.
+func AddError(diags *diag.Diagnostics, summary string, err error) {
+	for _, d := range httperrors.Diagnostics(err) {
+		if d.Summary == err.Error() && d.Detail == "" {
+			diags.AddError(summary, err.Error())
+			continue
+		}
+
+		if attributePath, ok := frameworkPath(d.AttributePath); ok {
+			diags.AddAttributeError(attributePath, summary+": "+d.Summary, d.Detail)
+		} else {
+			diags.AddError(summary+": "+d.Summary, d.Detail)
+		}
+	}
+}
+
+// frameworkPath converts the attribute path of an SDKv2 diagnostic.
+func frameworkPath(p cty.Path) (path.Path, bool) {
+	if len(p) == 0 {
+		return path.Empty(), false
+	}
+
+	attr, isAttr := p[0].(cty.GetAttrStep)
+	if !isAttr {
+		return path.Empty(), false
+	}
+	result := path.Root(attr.Name)
+
+	for _, step := range p[1:] {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			result = result.AtName(s.Name)
+		case cty.IndexStep:
+			if s.Key.Type() != cty.Number {
+				return result, true
+			}
+			index, _ := s.Key.AsBigFloat().Int64()
+			result = result.AtListIndex(int(index))
+		}
+	}
+
+	return result, true
+}
+
+// Schema is implemented by the schemas of the resources and ephemeral resources.
+type Schema interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// TrimAttributePaths returns the diagnostics with their attribute path replaced by its longest prefix that exists
+// in the schema, as provider/operations.go does for SDKv2 resources: the paths built from the argument names
+// returned by the APIs may not match the schema of the resource.
+func TrimAttributePaths(ctx context.Context, diags diag.Diagnostics, s Schema) diag.Diagnostics {
+	trimmed := make(diag.Diagnostics, 0, len(diags))
+	for _, d := range diags {
+		withPath, hasPath := d.(diag.DiagnosticWithPath)
+		if !hasPath {
+			trimmed = append(trimmed, d)
+			continue
+		}
+
+		p := withPath.Path()
+		for len(p.Steps()) > 0 {
+			if _, typeDiags := s.TypeAtPath(ctx, p); !typeDiags.HasError() {
+				break
+			}
+			p = p.ParentPath()
+		}
+
+		switch {
+		case p.Equal(withPath.Path()):
+			trimmed = append(trimmed, d)
+		case len(p.Steps()) == 0 && d.Severity() == diag.SeverityError:
+			trimmed = append(trimmed, diag.NewErrorDiagnostic(d.Summary(), d.Detail()))
+		case len(p.Steps()) == 0:
+			trimmed = append(trimmed, diag.NewWarningDiagnostic(d.Summary(), d.Detail()))
+		case d.Severity() == diag.SeverityError:
+			trimmed = append(trimmed, diag.NewAttributeErrorDiagnostic(p, d.Summary(), d.Detail()))
+		default:
+			trimmed = append(trimmed, diag.NewAttributeWarningDiagnostic(p, d.Summary(), d.Detail()))
+		}
+	}
+	return trimmed
+}
@@ -0,0 +1,43 @@
+package framework_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/framework"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrimAttributePaths(t *testing.T) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Optional: true},
+		},
+		Blocks: map[string]schema.Block{
+			"private_network": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"pn_id": schema.StringAttribute{Required: true},
+					},
+				},
+			},
+		},
+	}
+
+	diags := framework.TrimAttributePaths(context.Background(), diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(path.Root("name"), "invalid name", ""),
+		diag.NewAttributeErrorDiagnostic(path.Root("private_network").AtListIndex(0).AtName("ipam_config"), "invalid private network", ""),
+		diag.NewAttributeWarningDiagnostic(path.Root("server_type"), "deprecated server type", ""),
+		diag.NewErrorDiagnostic("internal error", ""),
+	}, s)
+
+	assert.Equal(t, diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(path.Root("name"), "invalid name", ""),
+		diag.NewAttributeErrorDiagnostic(path.Root("private_network").AtListIndex(0), "invalid private network", ""),
+		diag.NewWarningDiagnostic("deprecated server type", ""),
+		diag.NewErrorDiagnostic("internal error", ""),
+	}, diags)
+}
@@ -0,0 +1,74 @@
+package framework
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+)
+
+// ExtractMeta returns the meta shared with the SDKv2 provider from the data given to Configure.
+// It returns nil until the provider is configured.
+func ExtractMeta(providerData any) (*meta.Meta, diag.Diagnostics) {
+	if providerData == nil {
+		return nil, nil
+	}
+
+	m, ok := providerData.(*meta.Meta)
+	if !ok {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic(
+			"Unexpected provider data",
+			fmt.Sprintf("expected *meta.Meta, got %T", providerData),
+		)}
+	}
+
+	return m, nil
+}
+
+// ExtractZone returns the zone of the resource, or the default zone of the provider if it is not known yet.
+func ExtractZone(zone types.String, m *meta.Meta) (scw.Zone, error) {
+	if zone.ValueString() != "" {
+		return scw.ParseZone(zone.ValueString())
+	}
+
+	defaultZone, exist := m.ScwClient().GetDefaultZone()
+	if exist {
+		return defaultZone, nil
+	}
+
+	return "", zonal.ErrZoneNotFound
+}
+
+// ExtractRegion returns the region of the resource, or the default region of the provider if it is not known yet.
+func ExtractRegion(region types.String, m *meta.Meta) (scw.Region, error) {
+	if region.ValueString() != "" {
+		return scw.ParseRegion(region.ValueString())
+	}
+
+	defaultRegion, exist := m.ScwClient().GetDefaultRegion()
+	if exist {
+		return defaultRegion, nil
+	}
+
+	return "", regional.ErrRegionNotFound
+}
+
+// ExtractRegionalID returns the region and the ID of a resource referenced by a regional or a plain ID.
+// The region of a plain ID is the given region, or the default region of the provider.
+func ExtractRegionalID(id types.String, region types.String, m *meta.Meta) (scw.Region, string, error) {
+	regionalID := regional.ExpandID(id.ValueString())
+	if regionalID.Region != "" {
+		return regionalID.Region, regionalID.ID, nil
+	}
+
+	r, err := ExtractRegion(region, m)
+	if err != nil {
+		return "", "", err
+	}
+
+	return r, regionalID.ID, nil
+}
@@ -0,0 +1,157 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// StartOperation adds the resource type, operation and polling options of the provider to the context,
+// as the SDKv2 provider does for its resources, and starts the span of the operation. The span must be ended with EndOperation.
+func StartOperation(ctx context.Context, m *meta.Meta, resourceType string, operation string) (context.Context, trace.Span) {
+	ctx = transport.ContextWithOperation(ctx, resourceType, operation)
+	if m != nil {
+		ctx = transport.ContextWithWaitOptions(ctx, m.WaitOptions())
+	}
+	return tracing.Start(ctx, operation+" "+resourceType,
+		tracing.AttributeResourceType.String(resourceType),
+		tracing.AttributeOperation.String(operation),
+	)
+}
+
+// EndOperation ends the span of an operation, with an error status if the operation failed.
+func EndOperation(span trace.Span, diags *diag.Diagnostics) {
+	for _, diagnostic := range diags.Errors() {
+		span.SetStatus(codes.Error, diagnostic.Summary())
+		break
+	}
+	span.End()
+}
+
+// WithOperations wraps a resource so that each of its CRUD operations is run as the ones of the SDKv2 resources
+// in provider/operations.go: with the resource type, operation and polling options in the context,
+// traced in a span with the ID, zone and region of the resource, and with the attribute paths of its errors
+// trimmed to its schema.
+// The resource must not call StartOperation itself.
+func WithOperations(newResource func() resource.Resource) func() resource.Resource {
+	return func() resource.Resource {
+		r := newResource()
+		metadata := &resource.MetadataResponse{}
+		r.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "scaleway"}, metadata)
+
+		return &operationResource{
+			Resource:     r,
+			resourceType: metadata.TypeName,
+		}
+	}
+}
+
+var (
+	_ resource.ResourceWithConfigure   = (*operationResource)(nil)
+	_ resource.ResourceWithImportState = (*operationResource)(nil)
+)
+
+type operationResource struct {
+	resource.Resource
+	resourceType string
+	meta         *meta.Meta
+}
+
+func (r *operationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	m, diags := ExtractMeta(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.meta = m
+
+	if configurable, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		configurable.Configure(ctx, req, resp)
+	}
+}
+
+func (r *operationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := StartOperation(ctx, r.meta, r.resourceType, "create")
+	defer func() {
+		resp.Diagnostics = r.trimAttributePaths(ctx, resp.Diagnostics)
+		setSpanAttributes(ctx, span, resp.State)
+		EndOperation(span, &resp.Diagnostics)
+	}()
+
+	r.Resource.Create(ctx, req, resp)
+}
+
+func (r *operationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := StartOperation(ctx, r.meta, r.resourceType, "read")
+	defer func() {
+		resp.Diagnostics = r.trimAttributePaths(ctx, resp.Diagnostics)
+		setSpanAttributes(ctx, span, req.State)
+		EndOperation(span, &resp.Diagnostics)
+	}()
+
+	r.Resource.Read(ctx, req, resp)
+}
+
+func (r *operationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := StartOperation(ctx, r.meta, r.resourceType, "update")
+	defer func() {
+		resp.Diagnostics = r.trimAttributePaths(ctx, resp.Diagnostics)
+		setSpanAttributes(ctx, span, resp.State)
+		EndOperation(span, &resp.Diagnostics)
+	}()
+
+	r.Resource.Update(ctx, req, resp)
+}
+
+func (r *operationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := StartOperation(ctx, r.meta, r.resourceType, "delete")
+	defer func() {
+		resp.Diagnostics = r.trimAttributePaths(ctx, resp.Diagnostics)
+		setSpanAttributes(ctx, span, req.State)
+		EndOperation(span, &resp.Diagnostics)
+	}()
+
+	r.Resource.Delete(ctx, req, resp)
+}
+
+func (r *operationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importable, ok := r.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError("Resource Import Not Implemented", r.resourceType+" cannot be imported")
+		return
+	}
+	importable.ImportState(ctx, req, resp)
+}
+
+func (r *operationResource) trimAttributePaths(ctx context.Context, diags diag.Diagnostics) diag.Diagnostics {
+	if len(diags) == 0 {
+		return diags
+	}
+	resp := &resource.SchemaResponse{}
+	r.Resource.Schema(ctx, resource.SchemaRequest{}, resp)
+	return TrimAttributePaths(ctx, diags, resp.Schema)
+}
+
+// setSpanAttributes sets the ID, zone and region of the resource found in its state on the span of an operation.
+func setSpanAttributes(ctx context.Context, span trace.Span, state tfsdk.State) {
+	if state.Schema == nil || state.Raw.IsNull() {
+		return
+	}
+	for name, key := range map[string]attribute.Key{
+		"id":     tracing.AttributeResourceID,
+		"zone":   tracing.AttributeZone,
+		"region": tracing.AttributeRegion,
+	} {
+		value := types.String{}
+		if diags := state.GetAttribute(ctx, path.Root(name), &value); !diags.HasError() && !value.IsNull() && !value.IsUnknown() {
+			span.SetAttributes(key.String(value.ValueString()))
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package framework_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/framework"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testResource records the operations it runs.
+type testResource struct {
+	operations *[]transport.Operation
+}
+
+func (r *testResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "scaleway_test_resource"
+}
+
+func (r *testResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{Attributes: map[string]schema.Attribute{"id": framework.IDAttribute()}}
+}
+
+func (r *testResource) record(ctx context.Context) {
+	operation, _ := transport.OperationFromContext(ctx)
+	*r.operations = append(*r.operations, operation)
+}
+
+func (r *testResource) Create(ctx context.Context, _ resource.CreateRequest, _ *resource.CreateResponse) {
+	r.record(ctx)
+}
+
+func (r *testResource) Read(ctx context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+	r.record(ctx)
+}
+
+func (r *testResource) Update(ctx context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	r.record(ctx)
+}
+
+func (r *testResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	r.record(ctx)
+}
+
+func TestWithOperations(t *testing.T) {
+	ctx := context.Background()
+	operations := []transport.Operation(nil)
+	r := framework.WithOperations(func() resource.Resource {
+		return &testResource{operations: &operations}
+	})()
+
+	r.Create(ctx, resource.CreateRequest{}, &resource.CreateResponse{})
+	r.Read(ctx, resource.ReadRequest{}, &resource.ReadResponse{})
+	r.Update(ctx, resource.UpdateRequest{}, &resource.UpdateResponse{})
+	r.Delete(ctx, resource.DeleteRequest{}, &resource.DeleteResponse{})
+	assert.Equal(t, []transport.Operation{
+		{ResourceType: "scaleway_test_resource", Name: "create"},
+		{ResourceType: "scaleway_test_resource", Name: "read"},
+		{ResourceType: "scaleway_test_resource", Name: "update"},
+		{ResourceType: "scaleway_test_resource", Name: "delete"},
+	}, operations)
+
+	// The resource does not implement ImportState
+	importable, ok := r.(resource.ResourceWithImportState)
+	require.True(t, ok)
+	resp := &resource.ImportStateResponse{}
+	importable.ImportState(ctx, resource.ImportStateRequest{ID: "fr-par-1/11111111-1111-1111-1111-111111111111"}, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
@@ -0,0 +1,143 @@
+package framework
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+// IDAttribute returns the schema of the id of a resource.
+func IDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:    true,
+		Description: "The ID of the resource",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// ZoneAttribute returns the schema of the zone of a resource, as zonal.Schema does for SDKv2 resources.
+func ZoneAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The zone you want to attach the resource to",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{
+			ValidateDiagFunc(locality.ValidateStringInSliceWithWarning(zonal.AllZones(), "zone")),
+		},
+	}
+}
+
+// ProjectIDAttribute returns the schema of the project_id of a resource, as account.ProjectIDSchema does for SDKv2 resources.
+func ProjectIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The project_id you want to attach the resource to",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.String{
+			ValidateDiagFunc(verify.IsUUID()),
+		},
+	}
+}
+
+// OrganizationIDAttribute returns the schema of the organization_id of a resource.
+func OrganizationIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The organization_id you want to attach the resource to",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// stringValidateDiagFunc runs a SDKv2 validation function on a framework string attribute.
+type stringValidateDiagFunc struct {
+	f sdkschema.SchemaValidateDiagFunc
+}
+
+// ValidateDiagFunc adapts a SDKv2 validation function, such as verify.IsUUID, to a framework validator.
+func ValidateDiagFunc(f sdkschema.SchemaValidateDiagFunc) validator.String { //nolint:ireturn
+	return stringValidateDiagFunc{f: f}
+}
+
+func (v stringValidateDiagFunc) Description(_ context.Context) string {
+	return "value must be valid"
+}
+
+func (v stringValidateDiagFunc) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringValidateDiagFunc) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, d := range v.f(req.ConfigValue.ValueString(), cty.Path{}) {
+		if d.Severity == sdkdiag.Error {
+			resp.Diagnostics.AddAttributeError(req.Path, d.Summary, d.Detail)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(req.Path, d.Summary, d.Detail)
+		}
+	}
+}
+
+// TimeoutsBlock returns a timeouts block compatible with the one of SDKv2 resources, with an attribute per operation.
+func TimeoutsBlock(operations ...string) schema.SingleNestedBlock {
+	attributes := make(map[string]schema.Attribute, len(operations))
+	for _, operation := range operations {
+		attributes[operation] = schema.StringAttribute{
+			Optional: true,
+		}
+	}
+
+	return schema.SingleNestedBlock{
+		Attributes: attributes,
+	}
+}
+
+// Timeout returns the timeout of an operation from the timeouts block,
+// falling back to its default attribute and then to the given default timeout.
+func Timeout(timeouts types.Object, operation string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	if timeouts.IsNull() || timeouts.IsUnknown() {
+		return defaultTimeout, nil
+	}
+
+	attributes := timeouts.Attributes()
+	for _, key := range []string{operation, "default"} {
+		value, ok := attributes[key].(types.String)
+		if !ok || value.ValueString() == "" {
+			continue
+		}
+		timeout, err := time.ParseDuration(value.ValueString())
+		if err != nil {
+			return 0, diag.Diagnostics{diag.NewAttributeErrorDiagnostic(path.Root("timeouts").AtName(key), "Invalid timeout", err.Error())}
+		}
+		return timeout, nil
+	}
+
+	return defaultTimeout, nil
+}
@@ -0,0 +1,89 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	definition := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definition)
+	require.False(t, definition.Diagnostics.HasError())
+
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseZonalID(t *testing.T) {
+	attributeTypes := map[string]attr.Type{"zone": types.StringType, "id": types.StringType, "parent_id": types.StringType}
+
+	result, err := runFunction(t, functions.NewParseZonalIDFunction(), types.ObjectUnknown(attributeTypes), types.StringValue("fr-par-2/11111111-1111-1111-1111-111111111111"))
+	require.Nil(t, err)
+	assert.Equal(t, types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"zone":      types.StringValue("fr-par-2"),
+		"id":        types.StringValue("11111111-1111-1111-1111-111111111111"),
+		"parent_id": types.StringNull(),
+	}), result)
+
+	result, err = runFunction(t, functions.NewParseZonalIDFunction(), types.ObjectUnknown(attributeTypes), types.StringValue("fr-par-1/server-id/nic-id"))
+	require.Nil(t, err)
+	assert.Equal(t, types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"zone":      types.StringValue("fr-par-1"),
+		"id":        types.StringValue("nic-id"),
+		"parent_id": types.StringValue("server-id"),
+	}), result)
+
+	_, err = runFunction(t, functions.NewParseZonalIDFunction(), types.ObjectUnknown(attributeTypes), types.StringValue("11111111-1111-1111-1111-111111111111"))
+	require.NotNil(t, err)
+}
+
+func TestParseRegionalID(t *testing.T) {
+	attributeTypes := map[string]attr.Type{"region": types.StringType, "id": types.StringType, "parent_id": types.StringType}
+
+	result, err := runFunction(t, functions.NewParseRegionalIDFunction(), types.ObjectUnknown(attributeTypes), types.StringValue("nl-ams/11111111-1111-1111-1111-111111111111"))
+	require.Nil(t, err)
+	assert.Equal(t, types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"region":    types.StringValue("nl-ams"),
+		"id":        types.StringValue("11111111-1111-1111-1111-111111111111"),
+		"parent_id": types.StringNull(),
+	}), result)
+
+	_, err = runFunction(t, functions.NewParseRegionalIDFunction(), types.ObjectUnknown(attributeTypes), types.StringValue("fr-par-1/11111111-1111-1111-1111-111111111111"))
+	require.NotNil(t, err)
+}
+
+func TestZoneToRegion(t *testing.T) {
+	result, err := runFunction(t, functions.NewZoneToRegionFunction(), types.StringUnknown(), types.StringValue("pl-waw-3"))
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("pl-waw"), result)
+
+	_, err = runFunction(t, functions.NewZoneToRegionFunction(), types.StringUnknown(), types.StringValue("fr-par"))
+	require.NotNil(t, err)
+}
+
+func TestComposeID(t *testing.T) {
+	noNestedIDs := types.TupleValueMust([]attr.Type{}, []attr.Value{})
+	result, err := runFunction(t, functions.NewComposeIDFunction(), types.StringUnknown(), types.StringValue("fr-par-1"), types.StringValue("server-id"), noNestedIDs)
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("fr-par-1/server-id"), result)
+
+	nestedIDs := types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("database")})
+	result, err = runFunction(t, functions.NewComposeIDFunction(), types.StringUnknown(), types.StringValue("fr-par"), types.StringValue("instance-id"), nestedIDs)
+	require.Nil(t, err)
+	assert.Equal(t, types.StringValue("fr-par/instance-id/database"), result)
+
+	_, err = runFunction(t, functions.NewComposeIDFunction(), types.StringUnknown(), types.StringValue("paris"), types.StringValue("server-id"), noNestedIDs)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,218 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+)
+
+var (
+	_ function.Function = (*ParseZonalIDFunction)(nil)
+	_ function.Function = (*ParseRegionalIDFunction)(nil)
+	_ function.Function = (*ComposeIDFunction)(nil)
+)
+
+// parsedIDAttributeTypes returns the attributes of the object returned by the parse functions.
+func parsedIDAttributeTypes(localityName string) map[string]attr.Type {
+	return map[string]attr.Type{
+		localityName: types.StringType,
+		"id":         types.StringType,
+		"parent_id":  types.StringType,
+	}
+}
+
+// ParseZonalIDFunction splits a zoned ID, such as fr-par-1/11111111-1111-1111-1111-111111111111,
+// into its zone and ID. The ID of a nested resource, such as fr-par-1/<server_id>/<private_nic_id>, also has a parent ID.
+type ParseZonalIDFunction struct{}
+
+func NewParseZonalIDFunction() function.Function { //nolint:ireturn
+	return &ParseZonalIDFunction{}
+}
+
+func (f *ParseZonalIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_zonal_id"
+}
+
+func (f *ParseZonalIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a zonal ID",
+		Description: "Splits a zonal ID such as `fr-par-1/<id>` or `fr-par-1/<parent_id>/<id>` into an object with zone, id and parent_id attributes. parent_id is null when the ID is not nested.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The zonal ID to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedIDAttributeTypes("zone"),
+		},
+	}
+}
+
+type parsedZonalID struct {
+	Zone     types.String `tfsdk:"zone"`
+	ID       types.String `tfsdk:"id"`
+	ParentID types.String `tfsdk:"parent_id"`
+}
+
+func (f *ParseZonalIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	result := parsedZonalID{ParentID: types.StringNull()}
+	if strings.Count(id, "/") > 1 {
+		zone, nestedID, parentID, err := zonal.ParseNestedID(id)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, err.Error())
+			return
+		}
+		result.Zone = types.StringValue(zone.String())
+		result.ID = types.StringValue(nestedID)
+		result.ParentID = types.StringValue(parentID)
+	} else {
+		zone, zonedID, err := zonal.ParseID(id)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, err.Error())
+			return
+		}
+		result.Zone = types.StringValue(zone.String())
+		result.ID = types.StringValue(zonedID)
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// ParseRegionalIDFunction splits a regional ID, such as fr-par/11111111-1111-1111-1111-111111111111,
+// into its region and ID. The ID of a nested resource, such as fr-par/<instance_id>/<database_name>, also has a parent ID.
+type ParseRegionalIDFunction struct{}
+
+func NewParseRegionalIDFunction() function.Function { //nolint:ireturn
+	return &ParseRegionalIDFunction{}
+}
+
+func (f *ParseRegionalIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_regional_id"
+}
+
+func (f *ParseRegionalIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a regional ID",
+		Description: "Splits a regional ID such as `fr-par/<id>` or `fr-par/<parent_id>/<id>` into an object with region, id and parent_id attributes. parent_id is null when the ID is not nested.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The regional ID to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parsedIDAttributeTypes("region"),
+		},
+	}
+}
+
+type parsedRegionalID struct {
+	Region   types.String `tfsdk:"region"`
+	ID       types.String `tfsdk:"id"`
+	ParentID types.String `tfsdk:"parent_id"`
+}
+
+func (f *ParseRegionalIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	result := parsedRegionalID{ParentID: types.StringNull()}
+	if strings.Count(id, "/") > 1 {
+		region, nestedID, parentID, err := regional.ParseNestedID(id)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, err.Error())
+			return
+		}
+		result.Region = types.StringValue(region.String())
+		result.ID = types.StringValue(nestedID)
+		result.ParentID = types.StringValue(parentID)
+	} else {
+		region, regionalID, err := regional.ParseID(id)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, err.Error())
+			return
+		}
+		result.Region = types.StringValue(region.String())
+		result.ID = types.StringValue(regionalID)
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// ComposeIDFunction builds a zonal or regional ID from a locality and IDs, the reverse of the parse functions.
+type ComposeIDFunction struct{}
+
+func NewComposeIDFunction() function.Function { //nolint:ireturn
+	return &ComposeIDFunction{}
+}
+
+func (f *ComposeIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "compose_id"
+}
+
+func (f *ComposeIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compose a zonal or regional ID",
+		Description: "Joins a zone or a region and one or more IDs, e.g. `compose_id(\"fr-par-1\", server_id)` returns `fr-par-1/<server_id>`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "locality",
+				Description: "The zone or region of the resource",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "The ID of the resource, or of its parent for a nested resource",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "nested_ids",
+			Description: "The IDs of the nested resources",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ComposeIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		localityName string
+		id           string
+		nestedIDs    []string
+	)
+	resp.Error = req.Arguments.Get(ctx, &localityName, &id, &nestedIDs)
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := scw.ParseZone(localityName); err != nil {
+		if _, err := scw.ParseRegion(localityName); err != nil {
+			resp.Error = function.NewArgumentFuncError(0, "invalid locality "+localityName+": expected a zone or a region")
+			return
+		}
+	}
+
+	parts := append([]string{localityName, id}, nestedIDs...)
+	for i, part := range parts[1:] {
+		if part == "" || strings.Contains(part, "/") {
+			resp.Error = function.NewArgumentFuncError(int64(i+1), "invalid ID \""+part+"\"")
+			return
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, strings.Join(parts, "/"))
+}
@@ -0,0 +1,51 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+var _ function.Function = (*ZoneToRegionFunction)(nil)
+
+// ZoneToRegionFunction returns the region of a zone, as the provider does to infer the default region.
+type ZoneToRegionFunction struct{}
+
+func NewZoneToRegionFunction() function.Function { //nolint:ireturn
+	return &ZoneToRegionFunction{}
+}
+
+func (f *ZoneToRegionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "zone_to_region"
+}
+
+func (f *ZoneToRegionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Get the region of a zone",
+		Description: "Returns the region of a zone, e.g. `fr-par` for `fr-par-1`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "zone",
+				Description: "The zone",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ZoneToRegionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var zone string
+	resp.Error = req.Arguments.Get(ctx, &zone)
+	if resp.Error != nil {
+		return
+	}
+
+	region, err := scw.Zone(zone).Region()
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, region.String())
+}
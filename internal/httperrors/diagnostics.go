@@ -0,0 +1,186 @@
+package httperrors
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const (
+	quotasURL      = "https://console.scaleway.com/organization/quotas"
+	permissionsURL = "https://www.scaleway.com/en/docs/identity-and-access-management/iam/reference-content/permission-sets/"
+)
+
+// Diagnostics returns the diagnostics of an error.
+// Errors of the Scaleway APIs are translated to readable diagnostics with hints to fix them,
+// pointing at the invalid attribute when the API returns its name.
+// Other errors are returned as diag.FromErr does.
+func Diagnostics(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	invalidArgumentsError := &scw.InvalidArgumentsError{}
+	quotasExceededError := &scw.QuotasExceededError{}
+	permissionsDeniedError := &scw.PermissionsDeniedError{}
+	resourceLockedError := &scw.ResourceLockedError{}
+	preconditionFailedError := &scw.PreconditionFailedError{}
+
+	switch {
+	case errors.As(err, &invalidArgumentsError):
+		diags = invalidArgumentsDiagnostics(invalidArgumentsError)
+	case errors.As(err, &quotasExceededError):
+		diags = quotasExceededDiagnostics(quotasExceededError)
+	case errors.As(err, &permissionsDeniedError):
+		diags = permissionsDeniedDiagnostics(permissionsDeniedError)
+	case errors.As(err, &resourceLockedError):
+		diags = diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s %s is locked", resourceLockedError.Resource, resourceLockedError.ResourceID),
+			Detail: "The resource is locked by another product that uses it, or is protected against this operation. " +
+				"Remove the lock or the resources using it, then run the operation again.",
+		}}
+	case errors.As(err, &preconditionFailedError):
+		diags = preconditionFailedDiagnostics(preconditionFailedError)
+	default:
+		return diag.FromErr(err)
+	}
+
+	// Keep the context added by the callers to the error of the API
+	for i := range diags {
+		diags[i].Detail = strings.TrimSpace(diags[i].Detail + "\n\n" + err.Error())
+	}
+
+	return diags
+}
+
+func invalidArgumentsDiagnostics(err *scw.InvalidArgumentsError) diag.Diagnostics {
+	if len(err.Details) == 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Invalid arguments",
+		}}
+	}
+
+	diags := make(diag.Diagnostics, 0, len(err.Details))
+	for _, detail := range err.Details {
+		var reason string
+		switch detail.Reason {
+		case "required":
+			reason = "is required"
+		case "format":
+			reason = "is wrongly formatted"
+		case "constraint":
+			reason = "does not respect a constraint"
+		default:
+			reason = "is invalid"
+		}
+
+		hint := detail.HelpMessage
+		if hint != "" && !strings.HasSuffix(hint, ".") {
+			hint += "."
+		}
+		hint = strings.TrimSpace(hint + " Check the value of " + detail.ArgumentName + " in the configuration.")
+
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Invalid argument %s: %s", detail.ArgumentName, reason),
+			Detail:        hint,
+			AttributePath: ArgumentPath(detail.ArgumentName),
+		})
+	}
+
+	return diags
+}
+
+func quotasExceededDiagnostics(err *scw.QuotasExceededError) diag.Diagnostics {
+	diags := make(diag.Diagnostics, 0, len(err.Details))
+	for _, detail := range err.Details {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Quota exceeded for " + detail.Resource,
+			Detail: fmt.Sprintf("The organization uses %d %s out of a quota of %d. "+
+				"Delete unused resources or request a quota increase at %s.", detail.Current, detail.Resource, detail.Quota, quotasURL),
+		})
+	}
+	if len(diags) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Quota exceeded",
+			Detail:   "Delete unused resources or request a quota increase at " + quotasURL + ".",
+		})
+	}
+
+	return diags
+}
+
+func permissionsDeniedDiagnostics(err *scw.PermissionsDeniedError) diag.Diagnostics {
+	denied := make([]string, 0, len(err.Details))
+	for _, detail := range err.Details {
+		denied = append(denied, "- "+detail.Action+" "+detail.Resource)
+	}
+
+	detail := "The credentials used by the provider are not allowed to perform this operation. " +
+		"Check the policies of the API key in the project of the resource, see the permission sets at " + permissionsURL + "."
+	if len(denied) > 0 {
+		detail = "Denied actions:\n" + strings.Join(denied, "\n") + "\n\n" + detail
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Insufficient permissions",
+		Detail:   detail,
+	}}
+}
+
+func preconditionFailedDiagnostics(err *scw.PreconditionFailedError) diag.Diagnostics {
+	var summary, hint string
+	switch err.Precondition {
+	case "resource_still_in_use":
+		summary = "Resource is still in use"
+		hint = "Delete or detach the resources using it first, or reference it from them so that Terraform orders the operations."
+	case "attribute_must_be_set":
+		summary = "Attribute must be set"
+		hint = "Set the missing attribute in the configuration."
+	default:
+		summary = "Precondition failed"
+	}
+	if err.HelpMessage != "" {
+		hint = strings.TrimSpace(err.HelpMessage + ". " + hint)
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   hint,
+	}}
+}
+
+// ArgumentPath returns the attribute path of an argument name returned by the API, such as "volumes.0.size" or "volumes[0].size".
+func ArgumentPath(argumentName string) cty.Path {
+	argumentName = strings.NewReplacer("[", ".", "]", "").Replace(argumentName)
+	if argumentName == "" {
+		return nil
+	}
+
+	path := cty.Path{}
+	for _, step := range strings.Split(argumentName, ".") {
+		if step == "" {
+			continue
+		}
+		if index, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+
+	return path
+}
@@ -0,0 +1,60 @@
+package httperrors_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnosticsInvalidArguments(t *testing.T) {
+	err := fmt.Errorf("failed to create server: %w", &scw.InvalidArgumentsError{
+		Details: []scw.InvalidArgumentsErrorDetail{
+			{ArgumentName: "name", Reason: "required"},
+			{ArgumentName: "volumes.0.size", Reason: "constraint", HelpMessage: "size must be at least 10GB"},
+		},
+	})
+
+	diags := httperrors.Diagnostics(err)
+	require.Len(t, diags, 2)
+	assert.Equal(t, "Invalid argument name: is required", diags[0].Summary)
+	assert.Equal(t, cty.GetAttrPath("name"), diags[0].AttributePath)
+	assert.Equal(t, cty.GetAttrPath("volumes").IndexInt(0).GetAttr("size"), diags[1].AttributePath)
+	assert.Contains(t, diags[1].Detail, "size must be at least 10GB.")
+	assert.Contains(t, diags[1].Detail, "failed to create server")
+}
+
+func TestDiagnosticsQuotasExceeded(t *testing.T) {
+	diags := httperrors.Diagnostics(&scw.QuotasExceededError{
+		Details: []scw.QuotasExceededErrorDetail{{Resource: "instances_servers", Quota: 10, Current: 10}},
+	})
+
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, "Quota exceeded for instances_servers", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "10 instances_servers out of a quota of 10")
+	assert.Nil(t, diags[0].AttributePath)
+}
+
+func TestDiagnosticsPreconditionFailed(t *testing.T) {
+	diags := httperrors.Diagnostics(&scw.PreconditionFailedError{Precondition: "resource_still_in_use"})
+
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Resource is still in use", diags[0].Summary)
+}
+
+func TestDiagnosticsOtherErrors(t *testing.T) {
+	assert.Nil(t, httperrors.Diagnostics(nil))
+	assert.Equal(t, diag.FromErr(errors.New("not an api error")), httperrors.Diagnostics(errors.New("not an api error")))
+}
+
+func TestArgumentPath(t *testing.T) {
+	assert.Equal(t, cty.GetAttrPath("private_network").IndexInt(1).GetAttr("ip"), httperrors.ArgumentPath("private_network[1].ip"))
+	assert.Nil(t, httperrors.ArgumentPath(""))
+}
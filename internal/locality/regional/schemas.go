@@ -15,7 +15,7 @@ func ComputedSchema() *schema.Schema {
 	}
 }
 
-func allRegions() []string {
+func AllRegions() []string {
 	regions := make([]string, 0, len(scw.AllRegions))
 	for _, z := range scw.AllRegions {
 		regions = append(regions, z.String())
@@ -32,6 +32,6 @@ func Schema() *schema.Schema {
 		Optional:         true,
 		ForceNew:         true,
 		Computed:         true,
-		ValidateDiagFunc: locality.ValidateStringInSliceWithWarning(allRegions(), "region"),
+		ValidateDiagFunc: locality.ValidateStringInSliceWithWarning(AllRegions(), "region"),
 	}
 }
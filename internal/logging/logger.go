@@ -1,16 +1,37 @@
 package logging
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"strings"
+	"sync/atomic"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/scaleway/scaleway-sdk-go/logger"
 )
 
+// sdkDumpPrefix starts the format of the request and response dumps of the SDK.
+const sdkDumpPrefix = "\n---------- Scaleway SDK "
+
+// LoggerSubsystem is the subsystem of the messages of L, which are logged by the SDK or without the context of a request.
+const LoggerSubsystem = "sdk"
+
 func init() {
 	logger.SetLogger(L)
 }
 
+// loggerContext holds the context of the provider logger that L logs to, once the provider is configured.
+var loggerContext atomic.Pointer[context.Context]
+
+// SetContext makes L log to the sdk subsystem of the provider logger of ctx, with secrets masked.
+// It is called when the provider is configured: before that, as when the sweepers run, L logs with the standard logger.
+func SetContext(ctx context.Context) {
+	ctx = NewSubsystem(context.WithoutCancel(ctx), LoggerSubsystem)
+	loggerContext.Store(&ctx)
+}
+
 // Logger is the implementation of the SDK Logger interface for this terraform plugin.
 //
 // cf. https://godoc.org/github.com/scaleway/scaleway-sdk-go/logger#Logger
@@ -19,24 +40,38 @@ type Logger struct{}
 // L is the global Logger singleton
 var L = Logger{}
 
+func (l Logger) log(level string, logf func(ctx context.Context, subsystem string, msg string, fields ...map[string]interface{}), format string, args ...interface{}) {
+	ctx := loggerContext.Load()
+	if ctx == nil {
+		log.Printf("["+level+"] "+format, args...)
+		return
+	}
+	logf(*ctx, LoggerSubsystem, fmt.Sprintf(format, args...))
+}
+
 // Debugf logs to the DEBUG log. Arguments are handled in the manner of fmt.Printf.
+// The SDK request and response dumps are dropped as they are not redacted,
+// requests are logged by the provider transport in the subsystem of their service instead.
 func (l Logger) Debugf(format string, args ...interface{}) {
-	log.Printf("[DEBUG] "+format, args...)
+	if strings.HasPrefix(format, sdkDumpPrefix) {
+		return
+	}
+	l.log("DEBUG", tflog.SubsystemDebug, format, args...)
 }
 
 // Infof logs to the INFO log. Arguments are handled in the manner of fmt.Printf.
 func (l Logger) Infof(format string, args ...interface{}) {
-	log.Printf("[INFO] "+format, args...)
+	l.log("INFO", tflog.SubsystemInfo, format, args...)
 }
 
 // Warningf logs to the WARNING log. Arguments are handled in the manner of fmt.Printf.
 func (l Logger) Warningf(format string, args ...interface{}) {
-	log.Printf("[WARN] "+format, args...)
+	l.log("WARN", tflog.SubsystemWarn, format, args...)
 }
 
 // Errorf logs to the ERROR log. Arguments are handled in the manner of fmt.Printf.
 func (l Logger) Errorf(format string, args ...interface{}) {
-	log.Printf("[ERROR] "+format, args...)
+	l.log("ERROR", tflog.SubsystemError, format, args...)
 }
 
 // Printf logs to the DEBUG log. Arguments are handled in the manner of fmt.Printf.
@@ -0,0 +1,40 @@
+package logging_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogger_SetContext(t *testing.T) {
+	var output bytes.Buffer
+	logging.SetContext(tflogtest.RootLogger(context.Background(), &output))
+
+	logging.L.Warningf("waiting for server %s", "11111111-1111-1111-1111-111111111111")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "provider.sdk", entries[0]["@module"])
+	assert.Equal(t, "warn", entries[0]["@level"])
+	assert.Equal(t, "waiting for server 11111111-1111-1111-1111-111111111111", entries[0]["@message"])
+}
+
+func TestLogger_SDKDumps(t *testing.T) {
+	var output bytes.Buffer
+	logging.SetContext(tflogtest.RootLogger(context.Background(), &output))
+
+	logging.L.Debugf("\n---------- Scaleway SDK REQUEST %d (%x) : ----------\n%s\n", 1, 2, "X-Auth-Token: secret")
+	logging.L.Debugf("creating %s request on %s\n", "GET", "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "only the request dump should be dropped")
+	assert.Equal(t, "debug", entries[0]["@level"])
+	assert.Equal(t, "creating GET request on https://api.scaleway.com/instance/v1/zones/fr-par-1/servers\n", entries[0]["@message"])
+}
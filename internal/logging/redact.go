@@ -0,0 +1,147 @@
+package logging
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// RedactedValue replaces the value of secrets in logs.
+const RedactedValue = "<redacted>"
+
+// SensitiveKeys are the body fields and headers whose values are never logged, wherever they are.
+// They are compared case-insensitively, ignoring "-" and "_".
+var SensitiveKeys = []string{
+	"secret_key",
+	"password",
+	"kubeconfig",
+	"x-auth-token",
+	"authorization",
+	"x-amz-security-token",
+	"private_key",
+	"certificate_key",
+}
+
+// SensitiveField is a body field whose name is too common to be masked everywhere, such as content or data,
+// but whose value is secret in the requests and responses of some API paths.
+type SensitiveField struct {
+	// Path matches the path of the API requests
+	Path *regexp.Regexp
+	// Field is the JSON path of the field in the bodies, with its keys separated by dots. Arrays are traversed.
+	Field string
+}
+
+// SensitiveFields are the secrets that the APIs return in fields with a common name.
+var SensitiveFields = []SensitiveField{
+	{Path: regexp.MustCompile(`^/k8s/v1/regions/[^/]+/clusters/[^/]+/kubeconfig$`), Field: "content"},
+	{Path: regexp.MustCompile(`^/mnq/v1beta1/regions/[^/]+/nats-credentials(/[^/]+)?$`), Field: "credentials.content"},
+	{Path: regexp.MustCompile(`^/secret-manager/v1beta1/regions/[^/]+/secrets(-by-path|/[^/]+)/versions(/[^/]+/access)?$`), Field: "data"},
+	{Path: regexp.MustCompile(`^/(functions|containers)/v1beta1/regions/[^/]+/tokens(/[^/]+)?$`), Field: "token"},
+	{Path: regexp.MustCompile(`^/(functions|containers)/v1beta1/regions/[^/]+/tokens$`), Field: "tokens.token"},
+	{Path: regexp.MustCompile(`^/iot/v1/regions/[^/]+/networks$`), Field: "secret"},
+}
+
+var sensitiveKeys = func() map[string]bool {
+	keys := make(map[string]bool, len(SensitiveKeys))
+	for _, key := range SensitiveKeys {
+		keys[normalizeKey(key)] = true
+	}
+	return keys
+}()
+
+// sensitiveValuePattern matches the value of sensitive keys in bodies that could not be parsed,
+// such as truncated JSON or XML.
+var sensitiveValuePattern = regexp.MustCompile(`(?i)("?(?:secret_?key|password|kubeconfig|x-auth-token|private_?key)"?\s*[:=>]\s*"?)([^"&<,}\s]+)`)
+
+func normalizeKey(key string) string {
+	return strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(key))
+}
+
+// IsSensitiveKey returns true if the value of the given body field or header must not be logged.
+func IsSensitiveKey(key string) bool {
+	return sensitiveKeys[normalizeKey(key)]
+}
+
+// RedactHeaders returns a copy of the headers with the value of sensitive headers replaced.
+func RedactHeaders(headers http.Header) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for key, values := range headers {
+		if IsSensitiveKey(key) {
+			redacted[key] = RedactedValue
+			continue
+		}
+		redacted[key] = strings.Join(values, ", ")
+	}
+	return redacted
+}
+
+// RedactBody returns the body of a request to the given API path, or of its response,
+// with the value of the sensitive keys and of the sensitive fields of the path replaced.
+// JSON and form bodies are parsed, other bodies are redacted using a pattern.
+func RedactBody(path string, contentType string, body []byte) string {
+	switch {
+	case strings.Contains(contentType, "json"):
+		var value interface{}
+		if err := json.Unmarshal(body, &value); err == nil {
+			redacted, err := json.Marshal(redactJSON(value, sensitiveFields(path)))
+			if err == nil {
+				return string(redacted)
+			}
+		}
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			for key := range values {
+				if IsSensitiveKey(key) {
+					values.Set(key, RedactedValue)
+				}
+			}
+			return values.Encode()
+		}
+	}
+
+	return sensitiveValuePattern.ReplaceAllString(string(body), "${1}"+RedactedValue)
+}
+
+// sensitiveFields returns the JSON paths of the sensitive fields of an API path.
+func sensitiveFields(path string) []string {
+	fields := []string(nil)
+	for _, f := range SensitiveFields {
+		if f.Path.MatchString(path) {
+			fields = append(fields, f.Field)
+		}
+	}
+	return fields
+}
+
+// redactJSON replaces the sensitive keys of a JSON value and the given fields, by JSON path relative to the value.
+func redactJSON(value interface{}, fields []string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if (IsSensitiveKey(key) || slices.Contains(fields, key)) && field != nil {
+				v[key] = RedactedValue
+				continue
+			}
+			v[key] = redactJSON(field, nestedFields(fields, key))
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSON(item, fields)
+		}
+	}
+	return value
+}
+
+// nestedFields returns the JSON paths of the fields nested in the given key, relative to it.
+func nestedFields(fields []string, key string) []string {
+	nested := []string(nil)
+	for _, field := range fields {
+		if rest, found := strings.CutPrefix(field, key+"."); found {
+			nested = append(nested, rest)
+		}
+	}
+	return nested
+}
@@ -0,0 +1,66 @@
+package logging_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	assert.JSONEq(t,
+		`{"name":"user","password":"<redacted>","keys":[{"secret_key":"<redacted>","access_key":"SCWXXX"}],"kubeconfig":null}`,
+		logging.RedactBody("/iam/v1alpha1/users", "application/json", []byte(`{"name":"user","password":"hunter2","keys":[{"secret_key":"secret","access_key":"SCWXXX"}],"kubeconfig":null}`)),
+	)
+	assert.Equal(t,
+		"Action=CreateQueue&Password=%3Credacted%3E",
+		logging.RedactBody("/iam/v1alpha1/users", "application/x-www-form-urlencoded", []byte("Action=CreateQueue&Password=hunter2")),
+	)
+	assert.Equal(t,
+		`<User><Password><redacted></Password></User>`,
+		logging.RedactBody("/iam/v1alpha1/users", "application/xml", []byte(`<User><Password>hunter2</Password></User>`)),
+	)
+	assert.Equal(t,
+		`{"password":"<redacted>", "name":`,
+		logging.RedactBody("/iam/v1alpha1/users", "application/json", []byte(`{"password":"hunter2", "name":`)),
+	)
+}
+
+func TestRedactBody_SensitiveFields(t *testing.T) {
+	assert.JSONEq(t,
+		`{"id":"11111111-1111-1111-1111-111111111111","credentials":{"name":"creds","content":"<redacted>"}}`,
+		logging.RedactBody("/mnq/v1beta1/regions/fr-par/nats-credentials", "application/json",
+			[]byte(`{"id":"11111111-1111-1111-1111-111111111111","credentials":{"name":"creds","content":"-----BEGIN NATS USER JWT-----"}}`)),
+	)
+	assert.JSONEq(t,
+		`{"tokens":[{"id":"token-1","token":"<redacted>"}],"total_count":1}`,
+		logging.RedactBody("/containers/v1beta1/regions/fr-par/tokens", "application/json",
+			[]byte(`{"tokens":[{"id":"token-1","token":"eyJhbGciOi"}],"total_count":1}`)),
+	)
+	assert.JSONEq(t,
+		`{"secret_id":"11111111-1111-1111-1111-111111111111","revision":1,"data":"<redacted>"}`,
+		logging.RedactBody("/secret-manager/v1beta1/regions/fr-par/secrets/11111111-1111-1111-1111-111111111111/versions/1/access", "application/json",
+			[]byte(`{"secret_id":"11111111-1111-1111-1111-111111111111","revision":1,"data":"aHVudGVyMg=="}`)),
+	)
+
+	// Common fields are logged in the other APIs
+	assert.JSONEq(t,
+		`{"records":[{"name":"www","type":"TXT","data":"v=spf1 -all"}],"token":"validation-token","content":"text"}`,
+		logging.RedactBody("/domain/v2beta1/dns-zones/example.com/records", "application/json",
+			[]byte(`{"records":[{"name":"www","type":"TXT","data":"v=spf1 -all"}],"token":"validation-token","content":"text"}`)),
+	)
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Auth-Token", "token")
+	headers.Set("Authorization", "AWS4-HMAC-SHA256 Credential=SCWXXX")
+	headers.Set("Content-Type", "application/json")
+
+	assert.Equal(t, map[string]string{
+		"X-Auth-Token":  "<redacted>",
+		"Authorization": "<redacted>",
+		"Content-Type":  "application/json",
+	}, logging.RedactHeaders(headers))
+}
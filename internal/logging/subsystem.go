@@ -0,0 +1,63 @@
+package logging
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SubsystemLevelEnvVar is the prefix of the environment variables setting the log level of a subsystem,
+// e.g. TF_LOG_PROVIDER_SCALEWAY_INSTANCE=TRACE.
+const SubsystemLevelEnvVar = "TF_LOG_PROVIDER_SCALEWAY"
+
+// products maps the API products whose name differs from the service name.
+var products = map[string]string{
+	"apple-silicon":       "applesilicon",
+	"containers":          "container",
+	"functions":           "function",
+	"secret-manager":      "secret",
+	"secrets-by-path":     "secret",
+	"serverless-jobs":     "jobs",
+	"serverless-sqldb":    "sdb",
+	"transactional-email": "tem",
+}
+
+// Subsystem returns the name of the service an API URL belongs to, such as instance or k8s.
+func Subsystem(u *url.URL) string {
+	host := u.Hostname()
+	switch {
+	case strings.HasPrefix(host, "s3."):
+		return "object"
+	case strings.HasPrefix(host, "sqs.") || strings.HasPrefix(host, "sns."):
+		return "mnq"
+	}
+
+	product, _, _ := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+	if service, ok := products[product]; ok {
+		return service
+	}
+	if product == "" {
+		return "http"
+	}
+	return strings.ReplaceAll(product, "-", "")
+}
+
+// NewSubsystem returns a context logging to the given subsystem with secrets masked.
+func NewSubsystem(ctx context.Context, subsystem string) context.Context {
+	ctx = tflog.NewSubsystem(ctx, subsystem, tflog.WithLevelFromEnv(SubsystemLevelEnvVar, subsystem))
+	return tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, SensitiveKeys...)
+}
+
+// IsTraceEnabled returns true if the subsystem may log at the TRACE level,
+// so that request and response bodies are only read when they can be logged.
+func IsTraceEnabled(subsystem string) bool {
+	for _, envVar := range []string{"TF_LOG", "TF_LOG_PROVIDER", SubsystemLevelEnvVar, SubsystemLevelEnvVar + "_" + strings.ToUpper(subsystem)} {
+		if strings.EqualFold(os.Getenv(envVar), "TRACE") {
+			return true
+		}
+	}
+	return false
+}
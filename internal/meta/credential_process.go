@@ -0,0 +1,223 @@
+package meta
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// credentialProcessRefreshWindow is the time before expiration at which credentials are refreshed.
+	credentialProcessRefreshWindow = 5 * time.Minute
+	// credentialProcessTimeout is the maximum duration of the credential process command.
+	credentialProcessTimeout = time.Minute
+	// credentialProcessRetryInterval is the minimum time between two runs of the command to refresh the credentials.
+	credentialProcessRetryInterval = 30 * time.Second
+)
+
+// ProcessCredentials are the credentials printed by a credential_process command.
+type ProcessCredentials struct {
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+	// Expiration is optional, credentials without expiration are never refreshed.
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+// expiresSoon returns true if the credentials must be refreshed.
+func (c *ProcessCredentials) expiresSoon() bool {
+	return c.Expiration != nil && time.Until(*c.Expiration) < credentialProcessRefreshWindow
+}
+
+// expired returns true if the credentials cannot authenticate requests anymore.
+func (c *ProcessCredentials) expired() bool {
+	return c.Expiration != nil && !time.Now().Before(*c.Expiration)
+}
+
+// RunCredentialProcess runs the command and parses the credentials it prints on its standard output.
+func RunCredentialProcess(ctx context.Context, command string) (*ProcessCredentials, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Env = os.Environ()
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential_process failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	credentials := &ProcessCredentials{}
+	if err := json.Unmarshal(stdout.Bytes(), credentials); err != nil {
+		return nil, fmt.Errorf("credential_process returned invalid JSON: %w", err)
+	}
+	if credentials.AccessKey == "" || credentials.SecretKey == "" {
+		return nil, errors.New("credential_process must return both access_key and secret_key")
+	}
+
+	return credentials, nil
+}
+
+// credentialProcess keeps a SDK client authenticated with the credentials of a credential_process command,
+// which are cached until they are about to expire.
+type credentialProcess struct {
+	command       string
+	clientOptions []scw.ClientOption
+
+	mu          sync.Mutex
+	credentials *ProcessCredentials
+	client      *scw.Client
+	// refreshed is closed once the command running in the background ends, it is nil when the command does not run.
+	// lastRefresh is when the command last started.
+	refreshed   chan struct{}
+	lastRefresh time.Time
+}
+
+// Client returns the client authenticated with the cached credentials.
+// When the credentials are about to expire, the command runs again in the background and the client is replaced once it
+// succeeded: until then, the last client is returned. Once the credentials expired, Client waits for the command.
+// If it fails, the last client is returned and requests may fail with an authentication error until the next retry.
+func (p *credentialProcess) Client() *scw.Client {
+	p.mu.Lock()
+	if p.refreshed == nil && p.credentials.expiresSoon() && time.Since(p.lastRefresh) >= credentialProcessRetryInterval {
+		p.refreshed = make(chan struct{})
+		p.lastRefresh = time.Now()
+		go p.refresh(p.refreshed)
+	}
+	client, refreshed, expired := p.client, p.refreshed, p.credentials.expired()
+	p.mu.Unlock()
+
+	if !expired || refreshed == nil {
+		return client
+	}
+
+	<-refreshed
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.client
+}
+
+// refresh runs the command and replaces the client with one authenticated with the new credentials, then closes done.
+func (p *credentialProcess) refresh(done chan struct{}) {
+	defer close(done)
+
+	credentials, err := RunCredentialProcess(context.Background(), p.command)
+	var client *scw.Client
+	if err == nil {
+		client, err = scw.NewClient(append(slices.Clone(p.clientOptions), scw.WithAuth(credentials.AccessKey, credentials.SecretKey))...)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.refreshed = nil
+	if err != nil {
+		logging.L.Warningf("cannot refresh the credentials of credential_process: %s", err)
+		return
+	}
+	p.credentials = credentials
+	p.client = client
+}
+
+// credentialProcessTransport refreshes the secret key of requests sent by clients created before the credentials expired,
+// such as the ones used by long-running waiters.
+type credentialProcessTransport struct {
+	process   *credentialProcess
+	transport http.RoundTripper
+}
+
+func (t *credentialProcessTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Header.Get("X-Auth-Token") == "" {
+		return t.transport.RoundTrip(r)
+	}
+
+	secretKey, _ := t.process.Client().GetSecretKey()
+	if secretKey != r.Header.Get("X-Auth-Token") {
+		r = r.Clone(r.Context())
+		r.Header.Set("X-Auth-Token", secretKey)
+	}
+
+	return t.transport.RoundTrip(r)
+}
+
+// expandCredentialProcess returns the credential_process command to use.
+// As for static credentials, the secret key of the environment and of the provider block take precedence,
+// then come the credential_process of the provider block and the one of the scw config file profile.
+func expandCredentialProcess(d *schema.ResourceData) (string, error) {
+	if scw.LoadEnvProfile().SecretKey != nil {
+		return "", nil
+	}
+	profileName := ""
+	if d != nil {
+		if _, exist := d.GetOk("secret_key"); exist {
+			return "", nil
+		}
+		if command, exist := d.GetOk("credential_process"); exist {
+			return command.(string), nil
+		}
+		profileName = d.Get("profile").(string)
+	}
+	return configFileCredentialProcess(profileName)
+}
+
+// configFileCredentialProcess returns the credential_process of a profile of the scw config file,
+// or of the active profile if profileName is empty.
+// As for other settings, a named profile inherits the credential_process of the root profile.
+func configFileCredentialProcess(profileName string) (string, error) {
+	configPath := scw.GetConfigPath()
+	content, err := os.ReadFile(configPath)
+	if errors.Is(err, os.ErrNotExist) && os.Getenv(scw.ScwConfigPathEnv) == "" && strings.HasSuffix(configPath, ".yaml") {
+		content, err = os.ReadFile(strings.TrimSuffix(configPath, ".yaml") + ".yml")
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	type profile struct {
+		CredentialProcess string `yaml:"credential_process"`
+	}
+	config := struct {
+		profile       `yaml:",inline"`
+		ActiveProfile string              `yaml:"active_profile"`
+		Profiles      map[string]*profile `yaml:"profiles"`
+	}{}
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return "", fmt.Errorf("content of config file %s is invalid: %w", configPath, err)
+	}
+
+	if profileName == "" {
+		profileName = os.Getenv(scw.ScwActiveProfileEnv)
+	}
+	if profileName == "" {
+		profileName = config.ActiveProfile
+	}
+	if p, exist := config.Profiles[profileName]; exist && p.CredentialProcess != "" {
+		return p.CredentialProcess, nil
+	}
+
+	return config.CredentialProcess, nil
+}
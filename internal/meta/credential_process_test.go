@@ -0,0 +1,102 @@
+package meta_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunCredentialProcess(t *testing.T) {
+	credentials, err := meta.RunCredentialProcess(context.Background(), `echo '{"access_key":"SCWXXXXXXXXXXXXXXXXX","secret_key":"11111111-1111-1111-1111-111111111111","expiration":"2030-01-01T00:00:00Z"}'`)
+	require.NoError(t, err)
+	assert.Equal(t, "SCWXXXXXXXXXXXXXXXXX", credentials.AccessKey)
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", credentials.SecretKey)
+	assert.Equal(t, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), *credentials.Expiration)
+
+	_, err = meta.RunCredentialProcess(context.Background(), `echo '{"access_key":"SCWXXXXXXXXXXXXXXXXX"}'`)
+	require.ErrorContains(t, err, "secret_key")
+
+	_, err = meta.RunCredentialProcess(context.Background(), `echo vault is sealed >&2; exit 1`)
+	require.ErrorContains(t, err, "vault is sealed")
+}
+
+// newCredentialProcessMeta returns a Meta using a credential_process printing credentials with the given expiration,
+// and the file in which the command writes a line each time it runs.
+// The secret key of the credentials ends with the number of times the command ran.
+func newCredentialProcessMeta(t *testing.T, expiration time.Time) (*meta.Meta, string) {
+	t.Helper()
+	for _, envVar := range []string{"SCW_ACCESS_KEY", "SCW_SECRET_KEY", "SCW_PROFILE"} {
+		t.Setenv(envVar, "")
+		os.Unsetenv(envVar)
+	}
+
+	dir := t.TempDir()
+	counter := filepath.Join(dir, "counter")
+	script := filepath.Join(dir, "credentials.sh")
+	require.NoError(t, os.WriteFile(script, []byte(fmt.Sprintf(`#!/bin/sh
+echo x >> %s
+printf '{"access_key":"SCWXXXXXXXXXXXXXXXXX","secret_key":"11111111-1111-1111-1111-11111111111%%d","expiration":"%s"}' "$(wc -l < %s | tr -d ' ')"
+`, counter, expiration.UTC().Format(time.RFC3339), counter)), 0o700))
+
+	configPath := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`active_profile: vault
+profiles:
+  vault:
+    default_project_id: 11111111-1111-1111-1111-111111111111
+    credential_process: `+script+`
+`), 0o600))
+	t.Setenv("SCW_CONFIG_PATH", configPath)
+
+	m, err := meta.NewMeta(context.Background(), &meta.Config{TerraformVersion: "terraform-tests"})
+	require.NoError(t, err)
+
+	return m, counter
+}
+
+func commandRuns(t *testing.T, counter string) int {
+	t.Helper()
+	content, err := os.ReadFile(counter)
+	require.NoError(t, err)
+	return strings.Count(string(content), "x")
+}
+
+func TestNewMetaWithConfigFileCredentialProcess(t *testing.T) {
+	// The credentials expire soon, so they are refreshed in the background on first use.
+	m, counter := newCredentialProcessMeta(t, time.Now().Add(time.Minute))
+
+	assert.Equal(t, meta.CredentialsSourceProcess, m.AccessKeySource())
+	assert.Equal(t, meta.CredentialsSourceProcess, m.SecretKeySource())
+	assert.Equal(t, meta.CredentialsSourceActiveProfile, m.ProjectIDSource())
+
+	// The client is returned without waiting for the command, the cached credentials are used until it succeeded
+	secretKey, _ := m.ScwClient().GetSecretKey()
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111", secretKey)
+	assert.Eventually(t, func() bool {
+		secretKey, _ := m.ScwClient().GetSecretKey()
+		return secretKey == "11111111-1111-1111-1111-111111111112"
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The command does not run again until the retry interval elapsed, even if the new credentials expire soon
+	assert.Equal(t, 2, commandRuns(t, counter))
+}
+
+func TestNewMetaWithExpiredCredentialProcess(t *testing.T) {
+	m, counter := newCredentialProcessMeta(t, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// The expired credentials are not returned, the client waits for the command
+	secretKey, _ := m.ScwClient().GetSecretKey()
+	assert.Equal(t, "11111111-1111-1111-1111-111111111112", secretKey)
+
+	// Until the retry interval elapsed, the command does not run again even if the new credentials expired
+	secretKey, _ = m.ScwClient().GetSecretKey()
+	assert.Equal(t, "11111111-1111-1111-1111-111111111112", secretKey)
+	assert.Equal(t, 2, commandRuns(t, counter))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
 // terraformResourceData is an interface for *schema.ResourceData. (used for mock)
@@ -101,6 +102,23 @@ func ExtractHTTPClient(m interface{}) *http.Client {
 	return m.(*Meta).HTTPClient()
 }
 
+func ExtractNetworkOptions(m interface{}) transport.NetworkOptions {
+	return m.(*Meta).NetworkOptions()
+}
+
+// ExtractSkipWait returns true if a resource must not wait to be ready.
+// The skip_wait argument of the resource takes precedence over the one of the provider when it is configured,
+// even to false. Without configuration, as on delete, only a skip_wait set in the state is used.
+func ExtractSkipWait(d *schema.ResourceData, m interface{}) bool {
+	if skipWait, ok := GetRawConfigForKey(d, "skip_wait", cty.Bool); ok {
+		return skipWait.(bool)
+	}
+	if d.GetRawConfig().IsNull() && d.Get("skip_wait").(bool) {
+		return true
+	}
+	return m.(*Meta).SkipWait()
+}
+
 func getKeyInRawConfigMap(rawConfig map[string]cty.Value, key string, ty cty.Type) (interface{}, bool) {
 	if key == "" {
 		return rawConfig, false
@@ -160,3 +178,12 @@ func GetRawConfigForKey(d *schema.ResourceData, key string, ty cty.Type) (interf
 	}
 	return getKeyInRawConfigMap(rawConfig.AsValueMap(), key, ty)
 }
+
+// GetWriteOnlyOrString returns the value of a write-only string attribute, which is only found in the user's raw configuration,
+// or the value of its persisted variant if the write-only attribute is not set.
+func GetWriteOnlyOrString(d *schema.ResourceData, writeOnlyKey string, key string) string {
+	if value, ok := GetRawConfigForKey(d, writeOnlyKey, cty.String); ok {
+		return value.(string)
+	}
+	return d.Get(key).(string)
+}
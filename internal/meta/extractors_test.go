@@ -0,0 +1,41 @@
+package meta_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractSkipWait(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		"skip_wait": {Type: schema.TypeBool, Optional: true},
+	}}
+	data := func(config cty.Value, skipWait string) *schema.ResourceData {
+		return r.Data(&terraform.InstanceState{
+			ID:         "11111111-1111-1111-1111-111111111111",
+			Attributes: map[string]string{"skip_wait": skipWait},
+			RawConfig:  config,
+		})
+	}
+
+	t.Setenv("SCW_SKIP_WAIT", "true")
+	m, err := meta.NewMeta(context.Background(), &meta.Config{
+		TerraformVersion: "terraform-tests",
+		ForceAccessKey:   "SCWXXXXXXXXXXXXXXXXX",
+		ForceSecretKey:   "11111111-1111-1111-1111-111111111111",
+	})
+	require.NoError(t, err)
+	require.True(t, m.SkipWait())
+
+	// The provider skip_wait applies to the resources that do not configure it
+	assert.True(t, meta.ExtractSkipWait(data(cty.ObjectVal(map[string]cty.Value{"skip_wait": cty.NullVal(cty.Bool)}), "false"), m))
+
+	// A resource configured with skip_wait = false waits anyway
+	assert.False(t, meta.ExtractSkipWait(data(cty.ObjectVal(map[string]cty.Value{"skip_wait": cty.False}), "false"), m))
+}
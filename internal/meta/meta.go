@@ -4,22 +4,30 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/version"
 )
 
 const (
 	appendUserAgentEnvVar            = "TF_APPEND_USER_AGENT"
+	readOnlyEnvVar                   = "SCW_READ_ONLY"
+	capacityChecksEnvVar             = "SCW_CAPACITY_CHECKS"
+	skipWaitEnvVar                   = "SCW_SKIP_WAIT"
 	CredentialsSourceEnvironment     = "Environment variable"
 	CredentialsSourceDefault         = "Default"
 	CredentialsSourceActiveProfile   = "Active Profile in config.yaml"
 	CredentialsSourceProviderProfile = "Profile defined in provider{} block"
 	CredentialsSourceInferred        = "CredentialsSourceInferred from default zone"
+	CredentialsSourceProcess         = "Credential process"
 )
 
 type CredentialsSource struct {
@@ -42,9 +50,26 @@ type Meta struct {
 	httpClient *http.Client
 	// credentialsSource stores information about the source (env, profile, etc.) of each credential
 	credentialsSource *CredentialsSource
+	// defaultTags are merged into the tags of every resource that supports tags
+	defaultTags []string
+	// networkOptions stores the proxy and TLS configuration shared by every client
+	networkOptions transport.NetworkOptions
+	// ignoreTags describes tags managed outside of terraform that must not show up in plans
+	ignoreTags *types.IgnoreTags
+	// credentialProcess refreshes the credentials of scwClient when they come from a credential_process command
+	credentialProcess *credentialProcess
+	// capacityChecks enables the plan-time checks of the availability of server types and of the organization quotas
+	capacityChecks bool
+	// skipWait makes resources return without waiting for them to be ready
+	skipWait bool
+	// waitOptions configures the polling of the waiters
+	waitOptions transport.WaitOptions
 }
 
 func (m Meta) ScwClient() *scw.Client {
+	if m.credentialProcess != nil {
+		return m.credentialProcess.Client()
+	}
 	return m.scwClient
 }
 
@@ -52,6 +77,36 @@ func (m Meta) HTTPClient() *http.Client {
 	return m.httpClient
 }
 
+// NetworkOptions returns the proxy and TLS configuration to use for clients that do not use HTTPClient.
+func (m Meta) NetworkOptions() transport.NetworkOptions {
+	return m.networkOptions
+}
+
+func (m Meta) DefaultTags() []string {
+	return m.defaultTags
+}
+
+func (m Meta) IgnoreTags() *types.IgnoreTags {
+	return m.ignoreTags
+}
+
+// CapacityChecks returns true if resources must check during plan that the requested servers are available
+// and that the organization quotas allow to create them.
+func (m Meta) CapacityChecks() bool {
+	return m.capacityChecks
+}
+
+// SkipWait returns true if resources must return right after their create and update calls
+// instead of waiting for them to be ready.
+func (m Meta) SkipWait() bool {
+	return m.skipWait
+}
+
+// WaitOptions returns the initial and maximum intervals between the polls of the waiters.
+func (m Meta) WaitOptions() transport.WaitOptions {
+	return m.waitOptions
+}
+
 func (m Meta) AccessKeySource() string {
 	return m.credentialsSource.AccessKey
 }
@@ -81,6 +136,7 @@ type Config struct {
 	ForceAccessKey      string
 	ForceSecretKey      string
 	HTTPClient          *http.Client
+	RetryOptions        transport.RetryableTransportOptions
 }
 
 // NewMeta creates the Meta object containing the SDK client.
@@ -113,6 +169,26 @@ func NewMeta(ctx context.Context, config *Config) (*Meta, error) {
 		profile.SecretKey = scw.StringPtr(config.ForceSecretKey)
 	}
 
+	var process *credentialProcess
+	credentialProcessCommand, err := expandCredentialProcess(config.ProviderSchema)
+	if err != nil {
+		return nil, err
+	}
+	if credentialProcessCommand != "" && config.ForceSecretKey == "" {
+		credentials, err := RunCredentialProcess(ctx, credentialProcessCommand)
+		if err != nil {
+			return nil, err
+		}
+		profile.AccessKey = scw.StringPtr(credentials.AccessKey)
+		profile.SecretKey = scw.StringPtr(credentials.SecretKey)
+		credentialsSource.AccessKey = CredentialsSourceProcess
+		credentialsSource.SecretKey = CredentialsSourceProcess
+		process = &credentialProcess{
+			command:     credentialProcessCommand,
+			credentials: credentials,
+		}
+	}
+
 	// TODO validated profile
 
 	////
@@ -123,7 +199,38 @@ func NewMeta(ctx context.Context, config *Config) (*Meta, error) {
 		scw.WithProfile(profile),
 	}
 
-	httpClient := &http.Client{Transport: transport.NewRetryableTransport(http.DefaultTransport)}
+	networkOptions, err := expandNetworkOptions(config.ProviderSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseTransport http.RoundTripper = transport.NewTracingTransport(transport.NewLoggingTransport(transport.NewHTTPTransport(networkOptions)))
+	if rateLimits := expandRateLimits(config.ProviderSchema); len(rateLimits) > 0 {
+		baseTransport = transport.NewRateLimitedTransport(baseTransport, rateLimits)
+	}
+
+	cacheTTL, err := expandRequestCacheTTL(config.ProviderSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	waitOptions, err := expandWaitOptions(config.ProviderSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	var httpTransport http.RoundTripper = transport.NewRetryableTransportWithOptions(baseTransport, config.RetryOptions)
+	if cacheTTL > 0 {
+		httpTransport = transport.NewCachedTransport(httpTransport, cacheTTL)
+	}
+	if expandReadOnly(config.ProviderSchema) {
+		httpTransport = transport.NewReadOnlyTransport(httpTransport)
+	}
+	if process != nil {
+		httpTransport = &credentialProcessTransport{process: process, transport: httpTransport}
+	}
+
+	httpClient := &http.Client{Transport: httpTransport}
 	if config.HTTPClient != nil {
 		httpClient = config.HTTPClient
 	}
@@ -133,14 +240,183 @@ func NewMeta(ctx context.Context, config *Config) (*Meta, error) {
 	if err != nil {
 		return nil, err
 	}
+	if process != nil {
+		process.clientOptions = opts
+		process.client = scwClient
+	}
 
 	return &Meta{
 		scwClient:         scwClient,
 		httpClient:        httpClient,
 		credentialsSource: credentialsSource,
+		networkOptions:    networkOptions,
+		defaultTags:       expandDefaultTags(config.ProviderSchema),
+		ignoreTags:        expandIgnoreTags(config.ProviderSchema),
+		credentialProcess: process,
+		capacityChecks:    expandCapacityChecks(config.ProviderSchema),
+		skipWait:          expandSkipWait(config.ProviderSchema),
+		waitOptions:       waitOptions,
 	}, nil
 }
 
+// expandDefaultTags returns the tags defined in the default_tags block of the provider.
+func expandDefaultTags(d *schema.ResourceData) []string {
+	if d == nil {
+		return nil
+	}
+	return expandNonEmptyStrings(d.Get("default_tags.0.tags"))
+}
+
+// expandIgnoreTags returns the tags defined in the ignore_tags block of the provider.
+func expandIgnoreTags(d *schema.ResourceData) *types.IgnoreTags {
+	if d == nil {
+		return nil
+	}
+	if _, exist := d.GetOk("ignore_tags.0"); !exist {
+		return nil
+	}
+	return &types.IgnoreTags{
+		Keys:        expandNonEmptyStrings(d.Get("ignore_tags.0.keys")),
+		KeyPrefixes: expandNonEmptyStrings(d.Get("ignore_tags.0.key_prefixes")),
+	}
+}
+
+// expandRateLimits returns the rate limits defined in the rate_limit blocks of the provider.
+func expandRateLimits(d *schema.ResourceData) []transport.RateLimit {
+	if d == nil {
+		return nil
+	}
+	rateLimits := []transport.RateLimit(nil)
+	for _, rawRateLimit := range d.Get("rate_limit").([]interface{}) {
+		rateLimit, ok := rawRateLimit.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rateLimits = append(rateLimits, transport.RateLimit{
+			Host:              rateLimit["host"].(string),
+			RequestsPerSecond: rateLimit["max_requests_per_second"].(float64),
+			Burst:             rateLimit["burst"].(int),
+		})
+	}
+	return rateLimits
+}
+
+// expandRequestCacheTTL returns the duration during which GET responses are cached.
+func expandRequestCacheTTL(d *schema.ResourceData) (time.Duration, error) {
+	if d == nil {
+		return 0, nil
+	}
+	ttl, err := types.ExpandDuration(d.Get("request_cache_ttl"))
+	if err != nil || ttl == nil {
+		return 0, err
+	}
+	return *ttl, nil
+}
+
+// expandNetworkOptions returns the proxy and TLS configuration defined in the provider.
+func expandNetworkOptions(d *schema.ResourceData) (transport.NetworkOptions, error) {
+	options := transport.NetworkOptions{}
+	if d == nil {
+		return options, nil
+	}
+
+	if rawProxyURL := d.Get("http_proxy").(string); rawProxyURL != "" {
+		proxyURL, err := url.Parse(rawProxyURL)
+		if err != nil {
+			return options, fmt.Errorf("invalid http_proxy: %w", err)
+		}
+		options.ProxyURL = proxyURL
+	}
+
+	tlsConfig, err := transport.LoadTLSConfig(
+		d.Get("ca_bundle_file").(string),
+		d.Get("client_certificate_file").(string),
+		d.Get("client_key_file").(string),
+	)
+	if err != nil {
+		return options, err
+	}
+	options.TLSConfig = tlsConfig
+
+	return options, nil
+}
+
+// expandReadOnly returns true if the provider must not send mutating requests,
+// either from the read_only argument or the SCW_READ_ONLY environment variable.
+func expandReadOnly(d *schema.ResourceData) bool {
+	if readOnly, err := strconv.ParseBool(os.Getenv(readOnlyEnvVar)); err == nil && readOnly {
+		return true
+	}
+	if d == nil {
+		return false
+	}
+	return d.Get("read_only").(bool)
+}
+
+// expandCapacityChecks returns true if the plan-time capacity checks are enabled,
+// either from the capacity_checks argument or the SCW_CAPACITY_CHECKS environment variable.
+func expandCapacityChecks(d *schema.ResourceData) bool {
+	if capacityChecks, err := strconv.ParseBool(os.Getenv(capacityChecksEnvVar)); err == nil {
+		return capacityChecks
+	}
+	if d == nil {
+		return false
+	}
+	return d.Get("capacity_checks").(bool)
+}
+
+// expandSkipWait returns true if the waiters of the resources are skipped,
+// either from the skip_wait argument or the SCW_SKIP_WAIT environment variable.
+func expandSkipWait(d *schema.ResourceData) bool {
+	if skipWait, err := strconv.ParseBool(os.Getenv(skipWaitEnvVar)); err == nil {
+		return skipWait
+	}
+	if d == nil {
+		return false
+	}
+	return d.Get("skip_wait").(bool)
+}
+
+// expandWaitOptions returns the polling intervals defined in the wait block of the provider.
+func expandWaitOptions(d *schema.ResourceData) (transport.WaitOptions, error) {
+	options := transport.WaitOptions{}
+	if d == nil {
+		return options, nil
+	}
+	if waitBlocks, _ := d.Get("wait").([]interface{}); len(waitBlocks) == 0 {
+		return options, nil
+	}
+	options.Backoff = true
+
+	initialInterval, err := types.ExpandDuration(d.Get("wait.0.initial_interval"))
+	if err != nil {
+		return options, fmt.Errorf("invalid wait initial_interval: %w", err)
+	}
+	options.InitialInterval = initialInterval
+
+	maxInterval, err := types.ExpandDuration(d.Get("wait.0.max_interval"))
+	if err != nil {
+		return options, fmt.Errorf("invalid wait max_interval: %w", err)
+	}
+	options.MaxInterval = maxInterval
+
+	return options, nil
+}
+
+func expandNonEmptyStrings(data interface{}) []string {
+	rawStrings, ok := data.([]interface{})
+	if !ok {
+		return nil
+	}
+	res := []string(nil)
+	for _, s := range rawStrings {
+		if s != nil && s.(string) != "" {
+			res = append(res, s.(string))
+		}
+	}
+	return res
+}
+
 func customizeUserAgent(providerVersion string, terraformVersion string) string {
 	userAgent := fmt.Sprintf("terraform-provider/%s terraform/%s", providerVersion, terraformVersion)
 
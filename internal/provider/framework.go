@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/framework"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/functions"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/applesilicon"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/k8s"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/rdb"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/secret"
+	"github.com/scaleway/terraform-provider-scaleway/v2/version"
+)
+
+var (
+	_ fwprovider.Provider                       = (*FrameworkProvider)(nil)
+	_ fwprovider.ProviderWithFunctions          = (*FrameworkProvider)(nil)
+	_ fwprovider.ProviderWithEphemeralResources = (*FrameworkProvider)(nil)
+)
+
+// FrameworkProvider serves the resources written with terraform-plugin-framework alongside the SDKv2 provider.
+// It shares the schema and the meta of the SDKv2 provider, which the mux server configures first.
+type FrameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+// NewFrameworkProvider returns the framework provider sharing the configuration of the given SDKv2 provider.
+func NewFrameworkProvider(sdkProvider *schema.Provider) func() fwprovider.Provider {
+	return func() fwprovider.Provider {
+		return &FrameworkProvider{
+			sdkProvider: sdkProvider,
+		}
+	}
+}
+
+// NewProviderServer returns a server muxing the SDKv2 and framework providers.
+func NewProviderServer(ctx context.Context, config *Config) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider(config)()
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		// Provider using terraform-plugin-sdk, configured first
+		sdkProvider.GRPCProvider,
+		// Provider using terraform-plugin-framework
+		func() tfprotov5.ProviderServer {
+			return frameworkProviderServer{providerserver.NewProtocol5(NewFrameworkProvider(sdkProvider)())()}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProviderServer leaves the provider schema to the SDKv2 provider.
+// The framework cannot declare the optional and computed attributes of the SDKv2 provider schema,
+// so the mux server would consider both schemas different although they describe the same configuration.
+type frameworkProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s frameworkProviderServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if resp != nil {
+		resp.Provider = nil
+	}
+	return resp, err
+}
+
+func (p *FrameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "scaleway"
+	resp.Version = version.Version
+}
+
+// Schema returns the schema of the SDKv2 provider, so that the framework can decode the same provider configuration.
+func (p *FrameworkProvider) Schema(ctx context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	sdkSchema, err := schema.NewGRPCProviderServer(p.sdkProvider).GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot get the SDKv2 provider schema", err.Error())
+		return
+	}
+
+	s, err := frameworkProviderSchema(sdkSchema.Provider)
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot convert the SDKv2 provider schema", err.Error())
+		return
+	}
+	resp.Schema = s
+}
+
+// Configure shares the meta created when the SDKv2 provider was configured.
+func (p *FrameworkProvider) Configure(_ context.Context, _ fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	m, diags := framework.ExtractMeta(p.sdkProvider.Meta())
+	resp.Diagnostics.Append(diags...)
+	if m == nil {
+		return
+	}
+
+	resp.ResourceData = m
+	resp.DataSourceData = m
+	resp.EphemeralResourceData = m
+}
+
+func (p *FrameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		framework.WithOperations(applesilicon.NewServerResource),
+	}
+}
+
+func (p *FrameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *FrameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		iam.NewAPIKeyEphemeralResource,
+		k8s.NewKubeconfigEphemeralResource,
+		rdb.NewCertificateEphemeralResource,
+		secret.NewVersionEphemeralResource,
+	}
+}
+
+func (p *FrameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewComposeIDFunction,
+		functions.NewParseRegionalIDFunction,
+		functions.NewParseZonalIDFunction,
+		functions.NewZoneToRegionFunction,
+	}
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// frameworkProviderSchema converts the protocol schema of the SDKv2 provider to a framework provider schema.
+func frameworkProviderSchema(s *tfprotov5.Schema) (schema.Schema, error) {
+	attributes, blocks, err := frameworkBlock(s.Block)
+	if err != nil {
+		return schema.Schema{}, err
+	}
+
+	return schema.Schema{
+		Description: s.Block.Description,
+		Attributes:  attributes,
+		Blocks:      blocks,
+	}, nil
+}
+
+func frameworkBlock(block *tfprotov5.SchemaBlock) (map[string]schema.Attribute, map[string]schema.Block, error) {
+	attributes := make(map[string]schema.Attribute, len(block.Attributes))
+	for _, attribute := range block.Attributes {
+		fwAttribute, err := frameworkAttribute(attribute)
+		if err != nil {
+			return nil, nil, fmt.Errorf("attribute %s: %w", attribute.Name, err)
+		}
+		attributes[attribute.Name] = fwAttribute
+	}
+
+	blocks := make(map[string]schema.Block, len(block.BlockTypes))
+	for _, nestedBlock := range block.BlockTypes {
+		nestedAttributes, nestedBlocks, err := frameworkBlock(nestedBlock.Block)
+		if err != nil {
+			return nil, nil, fmt.Errorf("block %s: %w", nestedBlock.TypeName, err)
+		}
+		nestedObject := schema.NestedBlockObject{
+			Attributes: nestedAttributes,
+			Blocks:     nestedBlocks,
+		}
+		deprecationMessage := ""
+		if nestedBlock.Block.Deprecated {
+			deprecationMessage = "Deprecated"
+		}
+
+		switch nestedBlock.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			blocks[nestedBlock.TypeName] = schema.ListNestedBlock{
+				NestedObject:       nestedObject,
+				Description:        nestedBlock.Block.Description,
+				DeprecationMessage: deprecationMessage,
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			blocks[nestedBlock.TypeName] = schema.SetNestedBlock{
+				NestedObject:       nestedObject,
+				Description:        nestedBlock.Block.Description,
+				DeprecationMessage: deprecationMessage,
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSingle:
+			blocks[nestedBlock.TypeName] = schema.SingleNestedBlock{
+				Attributes:         nestedAttributes,
+				Blocks:             nestedBlocks,
+				Description:        nestedBlock.Block.Description,
+				DeprecationMessage: deprecationMessage,
+			}
+		default:
+			return nil, nil, fmt.Errorf("block %s: unsupported nesting mode %s", nestedBlock.TypeName, nestedBlock.Nesting)
+		}
+	}
+
+	return attributes, blocks, nil
+}
+
+func frameworkAttribute(attribute *tfprotov5.SchemaAttribute) (schema.Attribute, error) { //nolint:ireturn
+	attributeType, err := frameworkType(attribute.Type)
+	if err != nil {
+		return nil, err
+	}
+	deprecationMessage := ""
+	if attribute.Deprecated {
+		deprecationMessage = "Deprecated"
+	}
+
+	switch attributeType := attributeType.(type) {
+	case types.ListType:
+		return schema.ListAttribute{
+			ElementType:        attributeType.ElemType,
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecationMessage,
+		}, nil
+	case types.SetType:
+		return schema.SetAttribute{
+			ElementType:        attributeType.ElemType,
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecationMessage,
+		}, nil
+	case types.MapType:
+		return schema.MapAttribute{
+			ElementType:        attributeType.ElemType,
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecationMessage,
+		}, nil
+	}
+
+	switch attributeType {
+	case types.StringType:
+		return schema.StringAttribute{
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecationMessage,
+		}, nil
+	case types.BoolType:
+		return schema.BoolAttribute{
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecationMessage,
+		}, nil
+	case types.NumberType:
+		return schema.NumberAttribute{
+			Required:           attribute.Required,
+			Optional:           attribute.Optional,
+			Sensitive:          attribute.Sensitive,
+			Description:        attribute.Description,
+			DeprecationMessage: deprecationMessage,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", attribute.Type)
+}
+
+func frameworkType(t tftypes.Type) (attr.Type, error) { //nolint:ireturn
+	switch {
+	case t.Is(tftypes.String):
+		return types.StringType, nil
+	case t.Is(tftypes.Bool):
+		return types.BoolType, nil
+	case t.Is(tftypes.Number):
+		return types.NumberType, nil
+	case t.Is(tftypes.List{}):
+		elemType, err := frameworkType(t.(tftypes.List).ElementType)
+		return types.ListType{ElemType: elemType}, err
+	case t.Is(tftypes.Set{}):
+		elemType, err := frameworkType(t.(tftypes.Set).ElementType)
+		return types.SetType{ElemType: elemType}, err
+	case t.Is(tftypes.Map{}):
+		elemType, err := frameworkType(t.(tftypes.Map).ElementType)
+		return types.MapType{ElemType: elemType}, err
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
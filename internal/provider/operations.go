@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// addOperationContext adds the resource type and CRUD operation to the context of every resource and data source
+// so that the requests they send can be related to them, traces each operation,
+// and adds the polling options of the provider used by the waiters.
+func addOperationContext(provider *schema.Provider) {
+	for resourceType, resource := range provider.ResourcesMap {
+		resource.CreateContext = withOperation(resourceType, "create", resource, resource.CreateContext)
+		resource.ReadContext = withOperation(resourceType, "read", resource, resource.ReadContext)
+		resource.UpdateContext = withOperation(resourceType, "update", resource, resource.UpdateContext)
+		resource.DeleteContext = withOperation(resourceType, "delete", resource, resource.DeleteContext)
+	}
+	for dataSourceType, dataSource := range provider.DataSourcesMap {
+		dataSource.ReadContext = withOperation("data."+dataSourceType, "read", dataSource, dataSource.ReadContext)
+	}
+}
+
+func withOperation(resourceType string, operation string, resource *schema.Resource, f crudFunc) crudFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ctx = transport.ContextWithOperation(ctx, resourceType, operation)
+		if scwMeta, ok := m.(*meta.Meta); ok && scwMeta != nil {
+			ctx = transport.ContextWithWaitOptions(ctx, scwMeta.WaitOptions())
+		}
+		ctx, span := tracing.Start(ctx, operation+" "+resourceType,
+			tracing.AttributeResourceType.String(resourceType),
+			tracing.AttributeOperation.String(operation),
+		)
+		defer span.End()
+
+		diags := f(ctx, d, m)
+		for i := range diags {
+			diags[i].AttributePath = schemaAttributePath(resource.SchemaMap(), diags[i].AttributePath)
+		}
+
+		span.SetAttributes(tracing.AttributeResourceID.String(d.Id()))
+		if _, hasZone := resource.Schema["zone"]; hasZone {
+			if zone, ok := d.Get("zone").(string); ok {
+				span.SetAttributes(tracing.AttributeZone.String(zone))
+			}
+		}
+		if _, hasRegion := resource.Schema["region"]; hasRegion {
+			if region, ok := d.Get("region").(string); ok {
+				span.SetAttributes(tracing.AttributeRegion.String(region))
+			}
+		}
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				span.SetStatus(codes.Error, diagnostic.Summary)
+				break
+			}
+		}
+
+		return diags
+	}
+}
+
+// schemaAttributePath returns the longest prefix of a diagnostic path that exists in a schema,
+// as the paths built from the argument names returned by the APIs may not match the schema of the resource.
+func schemaAttributePath(schemaMap map[string]*schema.Schema, path cty.Path) cty.Path {
+	for i := 0; i < len(path); i++ {
+		attr, isAttr := path[i].(cty.GetAttrStep)
+		if !isAttr || schemaMap == nil {
+			return trimPath(path, i)
+		}
+		s, exists := schemaMap[attr.Name]
+		if !exists {
+			return trimPath(path, i)
+		}
+
+		schemaMap = nil
+		if s.Type != schema.TypeList || i+1 == len(path) {
+			continue
+		}
+		if _, isIndex := path[i+1].(cty.IndexStep); !isIndex {
+			return trimPath(path, i+1)
+		}
+		i++
+		if elem, isResource := s.Elem.(*schema.Resource); isResource {
+			schemaMap = elem.SchemaMap()
+		}
+	}
+	return path
+}
+
+func trimPath(path cty.Path, length int) cty.Path {
+	if length == 0 {
+		return nil
+	}
+	return path[:length]
+}
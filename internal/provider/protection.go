@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deletionProtectedResources are the stateful resources that support the deletion_protection argument.
+var deletionProtectedResources = []string{
+	"scaleway_block_volume",
+	"scaleway_instance_volume",
+	"scaleway_k8s_cluster",
+	"scaleway_mongodb_instance",
+	"scaleway_object_bucket",
+	"scaleway_rdb_instance",
+	"scaleway_redis_cluster",
+}
+
+// addDeletionProtection adds a deletion_protection argument to the stateful resources.
+// It is only stored in the state: while it is true, deleting the resource or planning its replacement fails.
+func addDeletionProtection(provider *schema.Provider) {
+	for _, resourceType := range deletionProtectedResources {
+		resource, exists := provider.ResourcesMap[resourceType]
+		if !exists {
+			continue
+		}
+		p := deletionProtection{
+			resourceType:  resourceType,
+			schema:        resource.SchemaMap(),
+			forceNewPaths: forceNewPaths(resource.SchemaMap(), ""),
+		}
+
+		resource.Schema["deletion_protection"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Prevent the resource from being deleted or replaced by Terraform",
+		}
+
+		// The check runs last to see the arguments that the CustomizeDiff of the resource forces to replace it.
+		if resource.CustomizeDiff != nil {
+			resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, p.customizeDiff)
+		} else {
+			resource.CustomizeDiff = p.customizeDiff
+		}
+		resource.ReadContext = p.wrapRead(resource.ReadContext)
+		resource.DeleteContext = p.wrapDelete(resource.DeleteContext)
+	}
+}
+
+type deletionProtection struct {
+	resourceType string
+	schema       map[string]*schema.Schema
+	// forceNewPaths matches the keys of the arguments that force the replacement of the resource
+	forceNewPaths []*regexp.Regexp
+}
+
+// forceNewPaths returns the patterns of the keys of the arguments of a schema that force a new resource.
+func forceNewPaths(schemaMap map[string]*schema.Schema, prefix string) []*regexp.Regexp {
+	paths := []*regexp.Regexp(nil)
+	for key, s := range schemaMap {
+		if s.ForceNew {
+			paths = append(paths, regexp.MustCompile("^"+prefix+regexp.QuoteMeta(key)+`(\.|$)`))
+			continue
+		}
+		if elem, isResource := s.Elem.(*schema.Resource); isResource {
+			paths = append(paths, forceNewPaths(elem.SchemaMap(), prefix+regexp.QuoteMeta(key)+`\.[^.]+\.`)...)
+		}
+	}
+	return paths
+}
+
+func (p deletionProtection) customizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	protected, _ := diff.GetChange("deletion_protection")
+	if !protected.(bool) {
+		return nil
+	}
+
+	for _, key := range diff.GetChangedKeysPrefix("") {
+		for _, path := range p.forceNewPaths {
+			if path.MatchString(key) {
+				return p.replacementError(diff, key)
+			}
+		}
+	}
+	if keys := p.customForceNewKeys(diff); len(keys) > 0 {
+		return p.replacementError(diff, keys[0])
+	}
+	return nil
+}
+
+// customForceNewKeys returns the arguments that a CustomizeDiff forced to replace the resource with ResourceDiff.ForceNew.
+// The keys updated by a CustomizeDiff are either forced new or set with SetNew, which only applies to computed
+// attributes: as the provider only sets computed-only attributes, such as tags_all, the configurable ones were forced new.
+func (p deletionProtection) customForceNewKeys(diff *schema.ResourceDiff) []string {
+	keys := []string(nil)
+	for _, key := range diff.UpdatedKeys() {
+		s, exists := p.schema[key]
+		if exists && (s.Optional || s.Required) && diff.HasChange(key) && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (p deletionProtection) replacementError(diff *schema.ResourceDiff, key string) error {
+	return fmt.Errorf("cannot replace %s %s: deletion protection is enabled and %s forces a new resource, "+
+		"set deletion_protection to false and apply the configuration before replacing it", p.resourceType, diff.Id(), key)
+}
+
+// wrapRead keeps deletion_protection in the state, with its default value after an import.
+func (p deletionProtection) wrapRead(f crudFunc) crudFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := f(ctx, d, m)
+		if d.Id() != "" {
+			_ = d.Set("deletion_protection", d.Get("deletion_protection"))
+		}
+		return diags
+	}
+}
+
+func (p deletionProtection) wrapDelete(f crudFunc) crudFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if d.Get("deletion_protection").(bool) {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cannot delete %s %s: deletion protection is enabled", p.resourceType, d.Id()),
+				Detail: "The deletion_protection argument of the resource is true. " +
+					"Set it to false and apply the configuration before deleting the resource.",
+				AttributePath: cty.GetAttrPath("deletion_protection"),
+			}}
+		}
+		return f(ctx, d, m)
+	}
+}
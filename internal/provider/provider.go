@@ -6,13 +6,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
-	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/applesilicon"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/az"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/baremetal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/billing"
@@ -43,7 +44,10 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/tem"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpcgw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/wait"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/webhosting"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
 
@@ -96,6 +100,11 @@ func Provider(config *Config) plugin.ProviderFunc {
 					Optional:    true, // To allow user to use `access_key`, `secret_key`, `project_id`...
 					Description: "The Scaleway profile to use.",
 				},
+				"credential_process": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "A command printing the Scaleway credentials as JSON, invoked again when they are about to expire.",
+				},
 				"project_id": {
 					Type:             schema.TypeString,
 					Optional:         true, // To allow user to use organization instead of project
@@ -115,12 +124,150 @@ func Provider(config *Config) plugin.ProviderFunc {
 					Optional:    true,
 					Description: "The Scaleway API URL to use.",
 				},
+				"default_tags": defaultTagsSchema(),
+				"ignore_tags":  ignoreTagsSchema(),
+				"http_proxy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The URL of the proxy used for every request, e.g. `http://proxy.internal:3128`. Overrides the proxy environment variables.",
+				},
+				"ca_bundle_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The path of a PEM file with additional certificate authorities to trust, e.g. of a TLS-intercepting proxy.",
+				},
+				"client_certificate_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The path of a PEM client certificate used for mutual TLS.",
+					RequiredWith: []string{"client_key_file"},
+				},
+				"client_key_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The path of the PEM private key of the client certificate.",
+					RequiredWith: []string{"client_certificate_file"},
+				},
+				"read_only": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Reject every request that could mutate a resource. Can also be enabled with the SCW_READ_ONLY environment variable.",
+				},
+				"capacity_checks": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Check during plan that the requested server types are in stock and that the organization quotas allow to create them. Each resource is checked on its own: several new servers of a plan are not added up. Can also be enabled with the SCW_CAPACITY_CHECKS environment variable.",
+				},
+				"skip_wait": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Return right after the create and update calls of scaleway_instance_server, scaleway_k8s_cluster, scaleway_k8s_pool and scaleway_rdb_instance instead of waiting for them to be ready. The other resources always wait. Can also be enabled with the SCW_SKIP_WAIT environment variable.",
+				},
+				"request_cache_ttl": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "Duration during which the responses of GET requests to the Scaleway APIs are cached, e.g. `10s`. Identical concurrent requests are also coalesced. Disabled by default.",
+					ValidateDiagFunc: verify.IsDuration(),
+				},
+				"retry": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Retry policy of the requests sent to the Scaleway APIs.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      4,
+								Description:  "The maximum number of attempts of a request, including the first one.",
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"min_wait": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          "2s",
+								Description:      "The minimum duration to wait between two attempts.",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+							"max_wait": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          "2m",
+								Description:      "The maximum duration to wait between two attempts.",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+							"retry_on_status": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Additional HTTP status codes to retry on. 429 and 5xx (except 501) are always retried.",
+								Elem: &schema.Schema{
+									Type:         schema.TypeInt,
+									ValidateFunc: validation.IntBetween(400, 599),
+								},
+							},
+							"retry_non_idempotent": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Default:     false,
+								Description: "Retry non-idempotent requests (POST, PATCH) on errors. They are only retried on 429 by default.",
+							},
+						},
+					},
+				},
+				"wait": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Polling policy of the waiters used while resources become ready. Without this block, the waiters poll at a fixed interval.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"initial_interval": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The interval between the first polls. Defaults to the interval of each waiter, usually a few seconds.",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+							"max_interval": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Description:      "The maximum interval between two polls, 1m by default. The interval doubles every few polls up to this value.",
+								ValidateDiagFunc: verify.IsDuration(),
+							},
+						},
+					},
+				},
+				"rate_limit": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Client-side rate limit of the requests sent to the Scaleway APIs. Each API host has its own limit.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"host": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The API host to limit (e.g. `api.scaleway.com`). Applies to every host without a specific rate limit if empty.",
+							},
+							"max_requests_per_second": {
+								Type:         schema.TypeFloat,
+								Required:     true,
+								Description:  "The maximum number of requests per second sent to the API host.",
+								ValidateFunc: validation.FloatAtLeast(0.1),
+							},
+							"burst": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Description:  "The maximum number of requests sent at once. Defaults to max_requests_per_second.",
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
 				"scaleway_account_project":                     account.ResourceProject(),
 				"scaleway_account_ssh_key":                     iam.ResourceSSKKey(),
-				"scaleway_apple_silicon_server":                applesilicon.ResourceServer(),
 				"scaleway_baremetal_server":                    baremetal.ResourceServer(),
 				"scaleway_block_snapshot":                      block.ResourceSnapshot(),
 				"scaleway_block_volume":                        block.ResourceVolume(),
@@ -222,6 +369,7 @@ func Provider(config *Config) plugin.ProviderFunc {
 				"scaleway_vpc_public_gateway_ip_reverse_dns":   vpcgw.ResourceIPReverseDNS(),
 				"scaleway_vpc_public_gateway_pat_rule":         vpcgw.ResourcePATRule(),
 				"scaleway_vpc_route":                           vpc.ResourceRoute(),
+				"scaleway_wait":                                wait.ResourceWait(),
 				"scaleway_webhosting":                          webhosting.ResourceWebhosting(),
 			},
 
@@ -316,18 +464,28 @@ func Provider(config *Config) plugin.ProviderFunc {
 		}
 
 		addBetaResources(p)
+		addDefaultTags(p)
+		addDeletionProtection(p)
+		addOperationContext(p)
 
 		p.ConfigureContextFunc = func(ctx context.Context, data *schema.ResourceData) (interface{}, diag.Diagnostics) {
 			terraformVersion := p.TerraformVersion
+			logging.SetContext(ctx)
 
 			// If we provide meta in config use it. This is useful for tests
 			if config.Meta != nil {
 				return config.Meta, nil
 			}
 
+			retryOptions, err := expandRetryOptions(data.Get("retry"))
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+
 			m, err := meta.NewMeta(ctx, &meta.Config{
 				ProviderSchema:   data,
 				TerraformVersion: terraformVersion,
+				RetryOptions:     retryOptions,
 			})
 			if err != nil {
 				return nil, diag.FromErr(err)
@@ -339,4 +497,36 @@ func Provider(config *Config) plugin.ProviderFunc {
 	}
 }
 
+// expandRetryOptions converts the retry block of the provider to transport options.
+func expandRetryOptions(data interface{}) (transport.RetryableTransportOptions, error) {
+	options := transport.RetryableTransportOptions{}
+	rawRetry, ok := data.([]interface{})
+	if !ok || len(rawRetry) == 0 || rawRetry[0] == nil {
+		return options, nil
+	}
+	retry := rawRetry[0].(map[string]interface{})
+
+	retryMax := retry["max_attempts"].(int) - 1
+	options.RetryMax = &retryMax
+
+	minWait, err := types.ExpandDuration(retry["min_wait"])
+	if err != nil {
+		return options, err
+	}
+	options.RetryWaitMin = minWait
+
+	maxWait, err := types.ExpandDuration(retry["max_wait"])
+	if err != nil {
+		return options, err
+	}
+	options.RetryWaitMax = maxWait
+
+	for _, status := range retry["retry_on_status"].([]interface{}) {
+		options.RetryOnStatus = append(options.RetryOnStatus, status.(int))
+	}
+	options.RetryNonIdempotent = retry["retry_non_idempotent"].(bool)
+
+	return options, nil
+}
+
 //gocyclo:ignore
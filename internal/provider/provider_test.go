@@ -3,12 +3,18 @@ package provider_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	instanceSDK "github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest/fakeapi"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
 	iamchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam/testfuncs"
@@ -128,3 +134,146 @@ func TestAccProvider_SSHKeys(t *testing.T) {
 		},
 	})
 }
+
+func TestProvider_TagsAll(t *testing.T) {
+	p := provider.Provider(provider.DefaultConfig())()
+	require.NoError(t, p.InternalValidate())
+
+	for name, r := range p.ResourcesMap {
+		tags, hasTags := r.Schema["tags"]
+		if !hasTags {
+			continue
+		}
+		tagsAll, hasTagsAll := r.Schema["tags_all"]
+		require.True(t, hasTagsAll, "resource %s has tags but no tags_all", name)
+		require.True(t, tagsAll.Computed)
+		require.Equal(t, tags.Type, tagsAll.Type)
+	}
+}
+
+func TestProvider_DataSourcesWithoutWriteOnly(t *testing.T) {
+	p := provider.Provider(provider.DefaultConfig())()
+	for name, ds := range p.DataSourcesMap {
+		for key := range ds.Schema {
+			require.False(t, strings.HasSuffix(key, "_wo") || strings.HasSuffix(key, "_wo_version"),
+				"data source %s has the write-only attribute %s of its resource", name, key)
+		}
+	}
+}
+
+func TestProvider_DataSourceIgnoreTags(t *testing.T) {
+	ctx := context.Background()
+	p := provider.Provider(provider.DefaultConfig())()
+	m, err := meta.NewMeta(ctx, &meta.Config{
+		ProviderSchema: schema.TestResourceDataRaw(t, p.Schema, map[string]interface{}{
+			"ignore_tags": []interface{}{map[string]interface{}{
+				"keys": []interface{}{"cost-center"},
+			}},
+		}),
+		TerraformVersion: "terraform-tests",
+		ForceZone:        scw.ZoneFrPar1,
+		ForceProjectID:   fakeapi.ProjectID,
+		ForceAccessKey:   "SCWXXXXXXXXXXXXXXXXX",
+		ForceSecretKey:   "11111111-1111-1111-1111-111111111111",
+		HTTPClient:       fakeapi.New().Client(),
+	})
+	require.NoError(t, err)
+
+	ip, err := instanceSDK.NewAPI(m.ScwClient()).CreateIP(&instanceSDK.CreateIPRequest{
+		Zone: scw.ZoneFrPar1,
+		Tags: []string{"web", "cost-center=42"},
+	})
+	require.NoError(t, err)
+
+	ds := provider.Provider(&provider.Config{Meta: m})().DataSourcesMap["scaleway_instance_ip"]
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{"id": ip.IP.ID})
+	diags := ds.ReadContext(ctx, d, m)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, []interface{}{"web"}, d.Get("tags"))
+}
+
+func TestProvider_DeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	r := provider.Provider(provider.DefaultConfig())().ResourcesMap["scaleway_block_volume"]
+	require.Contains(t, r.Schema, "deletion_protection")
+
+	state := &terraform.InstanceState{
+		ID: "fr-par-1/11111111-1111-1111-1111-111111111111",
+		Attributes: map[string]string{
+			"id":                  "fr-par-1/11111111-1111-1111-1111-111111111111",
+			"name":                "data",
+			"iops":                "5000",
+			"size_in_gb":          "20",
+			"zone":                "fr-par-1",
+			"deletion_protection": "true",
+		},
+	}
+
+	diags := r.DeleteContext(ctx, r.Data(state), nil)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "deletion protection is enabled")
+
+	_, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "data",
+		"iops":                15000,
+		"size_in_gb":          20,
+		"zone":                "fr-par-1",
+		"deletion_protection": true,
+	}), nil)
+	require.ErrorContains(t, err, "iops forces a new resource")
+
+	// The CustomizeDiff of the resource, not its schema, forces the replacement of the volume for this size change
+	_, err = r.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "data",
+		"iops":                5000,
+		"size_in_gb":          30,
+		"zone":                "fr-par-1",
+		"deletion_protection": true,
+	}), nil)
+	require.ErrorContains(t, err, "size_in_gb forces a new resource")
+
+	_, err = r.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":                "renamed",
+		"iops":                5000,
+		"size_in_gb":          20,
+		"zone":                "fr-par-1",
+		"deletion_protection": true,
+	}), nil)
+	require.NoError(t, err)
+}
+
+func TestProvider_ProviderServer(t *testing.T) {
+	ctx := context.Background()
+
+	m, err := meta.NewMeta(ctx, &meta.Config{TerraformVersion: "terraform-tests"})
+	require.NoError(t, err)
+	providerServer, err := provider.NewProviderServer(ctx, &provider.Config{Meta: m})
+	require.NoError(t, err)
+	server := providerServer()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schemaResp.Diagnostics)
+	require.Contains(t, schemaResp.ResourceSchemas, "scaleway_apple_silicon_server")
+	require.Contains(t, schemaResp.ResourceSchemas, "scaleway_instance_server")
+	require.Contains(t, schemaResp.Functions, "parse_zonal_id")
+	require.Contains(t, schemaResp.EphemeralResourceSchemas, "scaleway_secret_version")
+	require.Contains(t, schemaResp.EphemeralResourceSchemas, "scaleway_k8s_kubeconfig")
+
+	// Both providers must be able to decode the provider configuration.
+	configType := schemaResp.Provider.ValueType().(tftypes.Object)
+	configValues := map[string]tftypes.Value{}
+	for name, attributeType := range configType.AttributeTypes {
+		configValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+	configValues["zone"] = tftypes.NewValue(tftypes.String, "fr-par-2")
+	config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, configValues))
+	require.NoError(t, err)
+
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion: "terraform-tests",
+		Config:           &config,
+	})
+	require.NoError(t, err)
+	require.Empty(t, configureResp.Diagnostics)
+}
@@ -0,0 +1,56 @@
+package provider_test
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/schemacompat"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	updateSchemaBaseline = flag.Bool("schema-baseline", os.Getenv("TF_UPDATE_SCHEMA_BASELINE") == "true", "Update the baseline of the provider schema")
+	schemaReport         = flag.String("schema-report", os.Getenv("TF_SCHEMA_REPORT"), "File to write the report of the changes of the provider schema to")
+)
+
+// schemaBaseline is the schema of the provider in the previous release.
+// Update it from the tag of each release rather than from a development branch, so that it never hides the changes of
+// the unreleased commits.
+const schemaBaseline = "testdata/schema-baseline.json"
+
+func TestProviderSchema_Compatibility(t *testing.T) {
+	ctx := context.Background()
+	server, err := provider.NewProviderServer(ctx, provider.DefaultConfig())
+	require.NoError(t, err)
+	sdkProvider := provider.Provider(provider.DefaultConfig())()
+	current, err := schemacompat.Dump(ctx, server(), sdkProvider, provider.NewFrameworkProvider(sdkProvider)())
+	require.NoError(t, err)
+	if *updateSchemaBaseline {
+		require.NoError(t, current.Save(schemaBaseline))
+		return
+	}
+
+	baseline, err := schemacompat.Load(schemaBaseline)
+	require.NoError(t, err)
+	changes := schemacompat.Diff(baseline, current)
+
+	report := &strings.Builder{}
+	require.NoError(t, schemacompat.WriteReport(report, changes))
+	if *schemaReport != "" {
+		require.NoError(t, os.WriteFile(*schemaReport, []byte(report.String()), 0o644))
+	}
+	if len(changes) > 0 {
+		t.Log("changes of the provider schema since the previous release:\n" + report.String())
+	}
+
+	for _, c := range schemacompat.Breaking(changes) {
+		t.Errorf("breaking change: %s", c)
+	}
+	if t.Failed() {
+		t.Log("avoid the breaking changes, or update the baseline with TF_UPDATE_SCHEMA_BASELINE=true for a major release")
+	}
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+)
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags applied to every resource that supports tags",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The list of tags merged into the tags of every resource. Object storage resources use `key=value` tags",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags managed outside of terraform, ignored by every resource that supports tags",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The keys of the tags to ignore. The key of a tag is the part before `=`, or the whole tag",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"key_prefixes": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The key prefixes of the tags to ignore",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// addDefaultTags adds a computed tags_all attribute to every resource that exposes tags,
+// merges the provider default_tags into the tags sent to the API
+// and hides the tags matching the provider ignore_tags, from data sources too.
+func addDefaultTags(provider *schema.Provider) {
+	for _, resource := range provider.ResourcesMap {
+		tagsSchema, hasTags := resource.Schema["tags"]
+		if !hasTags || tagsSchema.Computed && !tagsSchema.Optional {
+			continue
+		}
+		tags := resourceTags{isMap: tagsSchema.Type == schema.TypeMap}
+
+		resource.Schema["tags_all"] = tags.allSchema()
+
+		if resource.CustomizeDiff != nil {
+			resource.CustomizeDiff = customdiff.All(resource.CustomizeDiff, tags.customizeDiff)
+		} else {
+			resource.CustomizeDiff = tags.customizeDiff
+		}
+		if resource.CreateContext != nil {
+			resource.CreateContext = tags.wrapMutation(resource.CreateContext)
+		}
+		if resource.UpdateContext != nil {
+			resource.UpdateContext = tags.wrapMutation(resource.UpdateContext)
+		}
+		if resource.ReadContext != nil {
+			resource.ReadContext = tags.wrapRead(resource.ReadContext)
+		}
+	}
+
+	for _, dataSource := range provider.DataSourcesMap {
+		// Data sources listing resources only use tags as a filter
+		tagsSchema, hasTags := dataSource.Schema["tags"]
+		if !hasTags || !tagsSchema.Computed {
+			continue
+		}
+		tags := resourceTags{isMap: tagsSchema.Type == schema.TypeMap}
+
+		if dataSource.ReadContext != nil {
+			dataSource.ReadContext = tags.wrapDataSourceRead(dataSource.ReadContext)
+		}
+		if dataSource.ReadWithoutTimeout != nil {
+			dataSource.ReadWithoutTimeout = tags.wrapDataSourceRead(dataSource.ReadWithoutTimeout)
+		}
+	}
+}
+
+type crudFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// resourceTags handles the tags of a resource, whether they are a list of strings or a map.
+// Map tags are handled as a list of "key=value" strings.
+type resourceTags struct {
+	isMap bool
+}
+
+func (t resourceTags) allSchema() *schema.Schema {
+	s := &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The tags of the resource, including the ones inherited from the provider default_tags",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	if t.isMap {
+		s.Type = schema.TypeMap
+	}
+	return s
+}
+
+func (t resourceTags) expand(raw interface{}) []string {
+	switch rawTags := raw.(type) {
+	case []interface{}:
+		tags := make([]string, 0, len(rawTags))
+		for _, tag := range rawTags {
+			if tag != nil {
+				tags = append(tags, tag.(string))
+			}
+		}
+		return tags
+	case map[string]interface{}:
+		return types.FlattenTagsMap(types.ExpandMapStringString(rawTags))
+	}
+	return nil
+}
+
+func (t resourceTags) flatten(tags []string) interface{} {
+	if t.isMap {
+		return types.FlattenMap(types.ExpandTagsMap(tags))
+	}
+	return types.FlattenSliceString(tags)
+}
+
+func (t resourceTags) defaultTags(m interface{}) []string {
+	scwMeta, ok := m.(*meta.Meta)
+	if !ok || scwMeta == nil {
+		return nil
+	}
+	if t.isMap {
+		return types.FlattenTagsMap(types.ExpandTagsMap(scwMeta.DefaultTags()))
+	}
+	return scwMeta.DefaultTags()
+}
+
+func (t resourceTags) ignoreTags(m interface{}) *types.IgnoreTags {
+	scwMeta, ok := m.(*meta.Meta)
+	if !ok || scwMeta == nil {
+		return nil
+	}
+	return scwMeta.IgnoreTags()
+}
+
+func (t resourceTags) customizeDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	defaultTags := t.defaultTags(m)
+	if diff.Id() == "" && len(defaultTags) == 0 {
+		return nil
+	}
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	oldTags, newTags := diff.GetChange("tags")
+	oldTagsAll, _ := diff.GetChange("tags_all")
+	// Ignored tags are only stored in tags_all, they are kept so that updates do not remove them.
+	inheritedTags := types.MergeTags(defaultTags, t.ignoreTags(m).Ignored(t.expand(oldTagsAll)))
+
+	if diff.Id() != "" && !diff.HasChange("tags") {
+		// Tags added by the API are kept in tags, so the inherited tags are the ones only found in tags_all.
+		oldInheritedTags := subtractTags(t.expand(oldTagsAll), t.expand(oldTags))
+		newInheritedTags := subtractTags(inheritedTags, t.expand(newTags))
+		if types.CompareStringListsIgnoringOrder(oldInheritedTags, newInheritedTags) {
+			return nil
+		}
+	}
+
+	return diff.SetNew("tags_all", t.flatten(types.MergeTags(t.expand(newTags), inheritedTags)))
+}
+
+// subtractTags returns the tags that are not in the excluded list.
+func subtractTags(tags []string, excluded []string) []string {
+	res := []string(nil)
+	for _, tag := range tags {
+		if !types.SliceContainsString(excluded, tag) {
+			res = append(res, tag)
+		}
+	}
+	return res
+}
+
+// wrapMutation sends the tags merged with the default and ignored tags to the API on create and update.
+func (t resourceTags) wrapMutation(f crudFunc) crudFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		configuredTags := t.expand(d.Get("tags"))
+		oldTagsAll, _ := d.GetChange("tags_all")
+		inheritedTags := types.MergeTags(t.defaultTags(m), t.ignoreTags(m).Ignored(t.expand(oldTagsAll)))
+		if len(inheritedTags) > 0 {
+			if err := d.Set("tags", t.flatten(types.MergeTags(configuredTags, inheritedTags))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		diags := f(ctx, d, m)
+
+		return append(diags, t.flattenTagsAll(d, m, configuredTags)...)
+	}
+}
+
+// wrapRead stores the tags returned by the API in tags_all and keeps only the configured ones in tags.
+func (t resourceTags) wrapRead(f crudFunc) crudFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		configuredTags := t.expand(d.Get("tags"))
+
+		diags := f(ctx, d, m)
+
+		return append(diags, t.flattenTagsAll(d, m, configuredTags)...)
+	}
+}
+
+// wrapDataSourceRead hides the tags matching the provider ignore_tags from the tags read by a data source.
+// Unlike resources, data sources have no tags_all attribute and keep the default tags.
+func (t resourceTags) wrapDataSourceRead(f crudFunc) crudFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := f(ctx, d, m)
+
+		ignoreTags := t.ignoreTags(m)
+		if diags.HasError() || d.Id() == "" || ignoreTags.IsEmpty() {
+			return diags
+		}
+		if err := d.Set("tags", t.flatten(ignoreTags.Filter(t.expand(d.Get("tags"))))); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+func (t resourceTags) flattenTagsAll(d *schema.ResourceData, m interface{}, configuredTags []string) diag.Diagnostics {
+	if d.Id() == "" {
+		return nil
+	}
+
+	tags := t.expand(d.Get("tags"))
+	if err := d.Set("tags_all", t.flatten(tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	defaultTags := t.defaultTags(m)
+	ignoreTags := t.ignoreTags(m)
+	if len(defaultTags) > 0 || !ignoreTags.IsEmpty() {
+		tags = ignoreTags.Filter(types.RemoveDefaultTags(tags, defaultTags, configuredTags))
+		if err := d.Set("tags", t.flatten(tags)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
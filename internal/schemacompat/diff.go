@@ -0,0 +1,226 @@
+package schemacompat
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// Kinds of the changes
+const (
+	KindProvider   = "provider"
+	KindResource   = "resource"
+	KindDataSource = "data source"
+)
+
+// Change is a difference between two schemas of the provider.
+type Change struct {
+	// Kind is KindProvider, KindResource or KindDataSource
+	Kind string
+	// Name is the name of the resource or data source, empty for the provider
+	Name string
+	// Attribute is the path of the attribute, e.g. "private_network.pn_id", empty for changes of the resource itself
+	Attribute string
+	Message   string
+	// Breaking is true if configurations or states written with the previous schema may fail or be replaced
+	Breaking bool
+}
+
+func (c Change) String() string {
+	subject := c.Kind
+	if c.Name != "" {
+		subject += " " + c.Name
+	}
+	if c.Attribute != "" {
+		subject += fmt.Sprintf(": attribute %q", c.Attribute)
+	}
+	return subject + " " + c.Message
+}
+
+// Diff returns the changes from the baseline schema to the current one, sorted by resource and attribute.
+func Diff(baseline *ProviderSchema, current *ProviderSchema) []Change {
+	d := &differ{}
+	d.attributes(KindProvider, "", "", baseline.Provider, current.Provider)
+	d.resources(KindResource, baseline.Resources, current.Resources)
+	d.resources(KindDataSource, baseline.DataSources, current.DataSources)
+
+	slices.SortStableFunc(d.changes, func(a, b Change) int {
+		if a.Kind != b.Kind {
+			return strings.Compare(a.Kind, b.Kind)
+		}
+		if a.Name != b.Name {
+			return strings.Compare(a.Name, b.Name)
+		}
+		return strings.Compare(a.Attribute, b.Attribute)
+	})
+	return d.changes
+}
+
+// Breaking returns the breaking changes.
+func Breaking(changes []Change) []Change {
+	breaking := []Change(nil)
+	for _, c := range changes {
+		if c.Breaking {
+			breaking = append(breaking, c)
+		}
+	}
+	return breaking
+}
+
+// WriteReport writes the breaking changes, then the other ones.
+func WriteReport(out io.Writer, changes []Change) error {
+	breaking := Breaking(changes)
+	if _, err := fmt.Fprintf(out, "Breaking changes (%d):\n", len(breaking)); err != nil {
+		return err
+	}
+	for _, c := range breaking {
+		if _, err := fmt.Fprintf(out, "  - %s\n", c); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(out, "\nOther changes (%d):\n", len(changes)-len(breaking)); err != nil {
+		return err
+	}
+	for _, c := range changes {
+		if c.Breaking {
+			continue
+		}
+		if _, err := fmt.Fprintf(out, "  - %s\n", c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(kind string, name string, attribute string, breaking bool, format string, args ...interface{}) {
+	d.changes = append(d.changes, Change{
+		Kind:      kind,
+		Name:      name,
+		Attribute: attribute,
+		Message:   fmt.Sprintf(format, args...),
+		Breaking:  breaking,
+	})
+}
+
+func (d *differ) resources(kind string, baseline map[string]*Resource, current map[string]*Resource) {
+	for name, old := range baseline {
+		r, exists := current[name]
+		if !exists {
+			d.add(kind, name, "", true, "was removed")
+			continue
+		}
+		d.resource(kind, name, old, r)
+	}
+	for name := range current {
+		if _, exists := baseline[name]; !exists {
+			d.add(kind, name, "", false, "was added")
+		}
+	}
+}
+
+func (d *differ) resource(kind string, name string, old *Resource, r *Resource) {
+	switch {
+	case r.SchemaVersion < old.SchemaVersion:
+		d.add(kind, name, "", true, "schema version was lowered from %d to %d, the states of version %d cannot be read", old.SchemaVersion, r.SchemaVersion, old.SchemaVersion)
+	case r.SchemaVersion > old.SchemaVersion && !slices.Contains(r.StateUpgraders, old.SchemaVersion):
+		d.add(kind, name, "", true, "schema version was raised from %d to %d without state upgrader from version %d", old.SchemaVersion, r.SchemaVersion, old.SchemaVersion)
+	case r.SchemaVersion > old.SchemaVersion:
+		d.add(kind, name, "", false, "schema version was raised from %d to %d", old.SchemaVersion, r.SchemaVersion)
+	}
+	d.attributes(kind, name, "", old.Attributes, r.Attributes)
+}
+
+func (d *differ) attributes(kind string, name string, prefix string, baseline map[string]*Attribute, current map[string]*Attribute) {
+	for attributeName, old := range baseline {
+		a, exists := current[attributeName]
+		if !exists {
+			d.add(kind, name, prefix+attributeName, true, "was removed")
+			continue
+		}
+		d.attribute(kind, name, prefix+attributeName, old, a)
+	}
+	for attributeName, a := range current {
+		if _, exists := baseline[attributeName]; exists {
+			continue
+		}
+		if a.Required {
+			d.add(kind, name, prefix+attributeName, true, "was added as required")
+		} else {
+			d.add(kind, name, prefix+attributeName, false, "was added")
+		}
+	}
+}
+
+func (d *differ) attribute(kind string, name string, path string, old *Attribute, a *Attribute) {
+	if oldType, newType := old.typeDescription(), a.typeDescription(); oldType != newType {
+		d.add(kind, name, path, true, "type changed from %s to %s", oldType, newType)
+		return
+	}
+
+	oldSettable, settable := old.Required || old.Optional, a.Required || a.Optional
+	switch {
+	case oldSettable && !settable:
+		d.add(kind, name, path, true, "can no longer be set")
+	case !old.Required && a.Required:
+		d.add(kind, name, path, true, "is now required")
+	case old.Required && !a.Required:
+		d.add(kind, name, path, false, "is now optional")
+	case !oldSettable && settable:
+		d.add(kind, name, path, false, "can now be set")
+	}
+	if old.Computed && !a.Computed && settable {
+		d.add(kind, name, path, true, "is no longer computed, it must be set to keep its value")
+	}
+
+	switch {
+	case kind != KindResource:
+	case !old.ForceNew && a.ForceNew:
+		d.add(kind, name, path, true, "now forces the replacement of the resource")
+	case old.ForceNew && !a.ForceNew:
+		d.add(kind, name, path, false, "no longer forces the replacement of the resource")
+	}
+	if old.Default != a.Default {
+		d.add(kind, name, path, true, "default changed from %q to %q", old.Default, a.Default)
+	}
+	switch {
+	case a.MaxItems > 0 && old.MaxItems == 0:
+		d.add(kind, name, path, true, "is now limited to %d items", a.MaxItems)
+	case a.MaxItems > 0 && a.MaxItems < old.MaxItems:
+		d.add(kind, name, path, true, "maximum number of items was lowered from %d to %d", old.MaxItems, a.MaxItems)
+	}
+	if a.MinItems > old.MinItems {
+		d.add(kind, name, path, true, "minimum number of items was raised from %d to %d", old.MinItems, a.MinItems)
+	}
+	if !old.Sensitive && a.Sensitive {
+		d.add(kind, name, path, false, "is now sensitive")
+	}
+	if old.Deprecated == "" && a.Deprecated != "" {
+		d.add(kind, name, path, false, "is deprecated: %s", a.Deprecated)
+	}
+
+	if old.Elem != nil && a.Elem != nil {
+		d.attribute(kind, name, path+".*", old.Elem, a.Elem)
+	}
+	if old.Block != nil && a.Block != nil {
+		d.attributes(kind, name, path+".", old.Block.Attributes, a.Block.Attributes)
+	}
+}
+
+// typeDescription returns the type of the attribute with the type of its elements, e.g. "list of string" or "set of blocks".
+func (a *Attribute) typeDescription() string {
+	switch {
+	case a.Type == "object":
+		return a.Type
+	case a.Block != nil:
+		return a.Type + " of blocks"
+	case a.Elem != nil:
+		return a.Type + " of " + a.Elem.typeDescription()
+	}
+	return a.Type
+}
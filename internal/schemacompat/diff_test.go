@@ -0,0 +1,227 @@
+package schemacompat_test
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/schemacompat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testProvider(update func(server *schema.Resource)) *schema.Provider {
+	server := &schema.Resource{
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{Version: 0, Upgrade: func(_ context.Context, state map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+				return state, nil
+			}},
+		},
+		Schema: map[string]*schema.Schema{
+			"name":  {Type: schema.TypeString, Optional: true, Computed: true},
+			"type":  {Type: schema.TypeString, Required: true},
+			"image": {Type: schema.TypeString, Optional: true},
+			"tags":  {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"private_network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"pn_id": {Type: schema.TypeString, Required: true},
+				}},
+			},
+			"state": {Type: schema.TypeString, Optional: true, Default: "started"},
+		},
+	}
+	if update != nil {
+		update(server)
+	}
+	return &schema.Provider{
+		ResourcesMap:   map[string]*schema.Resource{"scaleway_instance_server": server},
+		DataSourcesMap: map[string]*schema.Resource{"scaleway_instance_server": {Schema: server.Schema}},
+	}
+}
+
+func dump(t *testing.T, p *schema.Provider) *schemacompat.ProviderSchema {
+	t.Helper()
+	dumped, err := schemacompat.Dump(context.Background(), schema.NewGRPCProviderServer(p), p, nil)
+	require.NoError(t, err)
+	return dumped
+}
+
+// frameworkServer serves an additional resource that the SDKv2 provider does not define, as the mux server does.
+type frameworkServer struct {
+	*schema.GRPCProviderServer
+}
+
+func (s frameworkServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.GRPCProviderServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.ResourceSchemas["scaleway_apple_silicon_server"] = &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{Name: "type", Type: tftypes.String, Required: true},
+			},
+		},
+	}
+	return resp, nil
+}
+
+func TestDump_SaveLoad(t *testing.T) {
+	dumped := dump(t, testProvider(nil))
+	path := filepath.Join(t.TempDir(), "schema.json")
+	require.NoError(t, dumped.Save(path))
+
+	loaded, err := schemacompat.Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, dumped, loaded)
+	assert.Empty(t, schemacompat.Diff(loaded, dumped))
+}
+
+func TestDiff(t *testing.T) {
+	baseline := dump(t, testProvider(nil))
+
+	tests := []struct {
+		name     string
+		update   func(server *schema.Resource)
+		breaking []string
+		other    []string
+	}{
+		{
+			name: "removed attribute",
+			update: func(server *schema.Resource) {
+				delete(server.Schema, "image")
+			},
+			breaking: []string{
+				`data source scaleway_instance_server: attribute "image" was removed`,
+				`resource scaleway_instance_server: attribute "image" was removed`,
+			},
+		},
+		{
+			name: "optional to required",
+			update: func(server *schema.Resource) {
+				server.Schema["image"] = &schema.Schema{Type: schema.TypeString, Required: true}
+				server.Schema["type"] = &schema.Schema{Type: schema.TypeString, Optional: true}
+			},
+			breaking: []string{
+				`data source scaleway_instance_server: attribute "image" is now required`,
+				`resource scaleway_instance_server: attribute "image" is now required`,
+			},
+			other: []string{
+				`data source scaleway_instance_server: attribute "type" is now optional`,
+				`resource scaleway_instance_server: attribute "type" is now optional`,
+			},
+		},
+		{
+			name: "new force new",
+			update: func(server *schema.Resource) {
+				server.Schema["image"] = &schema.Schema{Type: schema.TypeString, Optional: true, ForceNew: true}
+			},
+			breaking: []string{
+				`resource scaleway_instance_server: attribute "image" now forces the replacement of the resource`,
+			},
+		},
+		{
+			name: "type changes",
+			update: func(server *schema.Resource) {
+				server.Schema["tags"] = &schema.Schema{Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}}
+				server.Schema["private_network"].Elem.(*schema.Resource).Schema["pn_id"] = &schema.Schema{Type: schema.TypeInt, Required: true}
+			},
+			breaking: []string{
+				`data source scaleway_instance_server: attribute "private_network.pn_id" type changed from string to number`,
+				`data source scaleway_instance_server: attribute "tags" type changed from list of string to set of string`,
+				`resource scaleway_instance_server: attribute "private_network.pn_id" type changed from string to number`,
+				`resource scaleway_instance_server: attribute "tags" type changed from list of string to set of string`,
+			},
+		},
+		{
+			name: "lowered schema version",
+			update: func(server *schema.Resource) {
+				server.SchemaVersion = 0
+				server.StateUpgraders = nil
+			},
+			breaking: []string{
+				`resource scaleway_instance_server schema version was lowered from 1 to 0, the states of version 1 cannot be read`,
+			},
+		},
+		{
+			name: "raised schema version",
+			update: func(server *schema.Resource) {
+				server.SchemaVersion = 2
+			},
+			breaking: []string{
+				`resource scaleway_instance_server schema version was raised from 1 to 2 without state upgrader from version 1`,
+			},
+		},
+		{
+			name: "added attributes and changed default",
+			update: func(server *schema.Resource) {
+				server.Schema["zone"] = &schema.Schema{Type: schema.TypeString, Optional: true, Computed: true}
+				server.Schema["project_id"] = &schema.Schema{Type: schema.TypeString, Required: true}
+				server.Schema["state"] = &schema.Schema{Type: schema.TypeString, Optional: true, Default: "stopped"}
+			},
+			breaking: []string{
+				`data source scaleway_instance_server: attribute "project_id" was added as required`,
+				`data source scaleway_instance_server: attribute "state" default changed from "started" to "stopped"`,
+				`resource scaleway_instance_server: attribute "project_id" was added as required`,
+				`resource scaleway_instance_server: attribute "state" default changed from "started" to "stopped"`,
+			},
+			other: []string{
+				`data source scaleway_instance_server: attribute "zone" was added`,
+				`resource scaleway_instance_server: attribute "zone" was added`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := schemacompat.Diff(baseline, dump(t, testProvider(tt.update)))
+			breaking, other := []string(nil), []string(nil)
+			for _, c := range changes {
+				if c.Breaking {
+					breaking = append(breaking, c.String())
+				} else {
+					other = append(other, c.String())
+				}
+			}
+			assert.Equal(t, tt.breaking, breaking)
+			assert.Equal(t, tt.other, other)
+		})
+	}
+}
+
+func TestDump_ProtocolOnlyResource(t *testing.T) {
+	p := testProvider(nil)
+	baseline, err := schemacompat.Dump(context.Background(), frameworkServer{schema.NewGRPCProviderServer(p)}, p, nil)
+	require.NoError(t, err)
+	require.Contains(t, baseline.Resources, "scaleway_apple_silicon_server")
+	assert.True(t, baseline.Resources["scaleway_apple_silicon_server"].Attributes["type"].Required)
+
+	changes := schemacompat.Diff(baseline, dump(t, p))
+	require.Len(t, changes, 1)
+	assert.Equal(t, "resource scaleway_apple_silicon_server was removed", changes[0].String())
+	assert.True(t, changes[0].Breaking)
+}
+
+func TestWriteReport(t *testing.T) {
+	changes := schemacompat.Diff(dump(t, testProvider(nil)), dump(t, testProvider(func(server *schema.Resource) {
+		server.Schema["image"].ForceNew = true
+		server.Schema["zone"] = &schema.Schema{Type: schema.TypeString, Optional: true}
+	})))
+
+	report := &strings.Builder{}
+	require.NoError(t, schemacompat.WriteReport(report, changes))
+	assert.Equal(t, `Breaking changes (1):
+  - resource scaleway_instance_server: attribute "image" now forces the replacement of the resource
+
+Other changes (2):
+  - data source scaleway_instance_server: attribute "zone" was added
+  - resource scaleway_instance_server: attribute "zone" was added
+`, report.String())
+}
@@ -0,0 +1,152 @@
+package schemacompat
+
+import (
+	"context"
+	"strings"
+
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// requiresReplaceDescription ends the descriptions of the RequiresReplace plan modifiers of the framework.
+const requiresReplaceDescription = "Terraform will destroy and recreate the resource."
+
+// addFrameworkResources adds to the dumped resources the settings that only the schemas of the framework describe.
+func addFrameworkResources(ctx context.Context, resources map[string]*Resource, p fwprovider.Provider) {
+	metadata := &fwprovider.MetadataResponse{}
+	p.Metadata(ctx, fwprovider.MetadataRequest{}, metadata)
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		resourceMetadata := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: metadata.TypeName}, resourceMetadata)
+		dumped, exists := resources[resourceMetadata.TypeName]
+		if !exists {
+			continue
+		}
+
+		resp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, resp)
+		addFrameworkAttributes(ctx, dumped.Attributes, resp.Schema.Attributes)
+		addFrameworkBlocks(ctx, dumped.Attributes, resp.Schema.Blocks)
+	}
+}
+
+func addFrameworkAttributes(ctx context.Context, dumped map[string]*Attribute, attributes map[string]fwschema.Attribute) {
+	for name, attribute := range attributes {
+		a, exists := dumped[name]
+		if !exists {
+			continue
+		}
+		a.ForceNew = requiresReplace(ctx, attribute)
+		if attribute.GetDeprecationMessage() != "" {
+			a.Deprecated = attribute.GetDeprecationMessage()
+		}
+
+		// Nested attributes are objects, or collections of objects
+		object := a
+		if a.Elem != nil {
+			object = a.Elem
+		}
+		if object.Block == nil {
+			continue
+		}
+		switch attribute := attribute.(type) {
+		case fwschema.SingleNestedAttribute:
+			addFrameworkAttributes(ctx, object.Block.Attributes, attribute.Attributes)
+		case fwschema.ListNestedAttribute:
+			addFrameworkAttributes(ctx, object.Block.Attributes, attribute.NestedObject.Attributes)
+		case fwschema.SetNestedAttribute:
+			addFrameworkAttributes(ctx, object.Block.Attributes, attribute.NestedObject.Attributes)
+		case fwschema.MapNestedAttribute:
+			addFrameworkAttributes(ctx, object.Block.Attributes, attribute.NestedObject.Attributes)
+		}
+	}
+}
+
+func addFrameworkBlocks(ctx context.Context, dumped map[string]*Attribute, blocks map[string]fwschema.Block) {
+	for name, block := range blocks {
+		a, exists := dumped[name]
+		if !exists || a.Block == nil {
+			continue
+		}
+		if block.GetDeprecationMessage() != "" {
+			a.Deprecated = block.GetDeprecationMessage()
+		}
+		switch block := block.(type) {
+		case fwschema.SingleNestedBlock:
+			addFrameworkAttributes(ctx, a.Block.Attributes, block.Attributes)
+			addFrameworkBlocks(ctx, a.Block.Attributes, block.Blocks)
+		case fwschema.ListNestedBlock:
+			addFrameworkAttributes(ctx, a.Block.Attributes, block.NestedObject.Attributes)
+			addFrameworkBlocks(ctx, a.Block.Attributes, block.NestedObject.Blocks)
+		case fwschema.SetNestedBlock:
+			addFrameworkAttributes(ctx, a.Block.Attributes, block.NestedObject.Attributes)
+			addFrameworkBlocks(ctx, a.Block.Attributes, block.NestedObject.Blocks)
+		}
+	}
+}
+
+// requiresReplace returns true if a plan modifier of the attribute forces the replacement of the resource.
+// The framework only tells it in the description of its RequiresReplace plan modifiers.
+func requiresReplace(ctx context.Context, attribute fwschema.Attribute) bool {
+	for _, modifier := range planModifiers(attribute) {
+		if strings.HasSuffix(modifier.Description(ctx), requiresReplaceDescription) {
+			return true
+		}
+	}
+	return false
+}
+
+func planModifiers(attribute fwschema.Attribute) []planmodifier.Describer {
+	modifiers := []planmodifier.Describer(nil)
+	switch attribute := attribute.(type) {
+	case interface{ StringPlanModifiers() []planmodifier.String }:
+		for _, m := range attribute.StringPlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	case interface{ BoolPlanModifiers() []planmodifier.Bool }:
+		for _, m := range attribute.BoolPlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	case interface{ Int64PlanModifiers() []planmodifier.Int64 }:
+		for _, m := range attribute.Int64PlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	case interface{ Int32PlanModifiers() []planmodifier.Int32 }:
+		for _, m := range attribute.Int32PlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	case interface{ Float64PlanModifiers() []planmodifier.Float64 }:
+		for _, m := range attribute.Float64PlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	case interface{ Float32PlanModifiers() []planmodifier.Float32 }:
+		for _, m := range attribute.Float32PlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	case interface{ NumberPlanModifiers() []planmodifier.Number }:
+		for _, m := range attribute.NumberPlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	case interface{ ListPlanModifiers() []planmodifier.List }:
+		for _, m := range attribute.ListPlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	case interface{ SetPlanModifiers() []planmodifier.Set }:
+		for _, m := range attribute.SetPlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	case interface{ MapPlanModifiers() []planmodifier.Map }:
+		for _, m := range attribute.MapPlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+		for _, m := range attribute.ObjectPlanModifiers() {
+			modifiers = append(modifiers, m)
+		}
+	}
+	return modifiers
+}
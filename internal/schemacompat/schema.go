@@ -0,0 +1,238 @@
+// Package schemacompat dumps the schema of the provider and finds the changes breaking the configurations and states
+// written with a previous version.
+package schemacompat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderSchema is the part of the schema of a provider that its compatibility depends on.
+type ProviderSchema struct {
+	Provider    map[string]*Attribute `json:"provider"`
+	Resources   map[string]*Resource  `json:"resources"`
+	DataSources map[string]*Resource  `json:"data_sources"`
+}
+
+// Resource is the schema of a resource or a data source.
+type Resource struct {
+	SchemaVersion int `json:"schema_version,omitempty"`
+	// StateUpgraders are the versions of the states that can be upgraded
+	StateUpgraders []int                 `json:"state_upgraders,omitempty"`
+	Attributes     map[string]*Attribute `json:"attributes"`
+}
+
+// Attribute is the schema of an attribute or a block.
+type Attribute struct {
+	Type       string `json:"type"`
+	Required   bool   `json:"required,omitempty"`
+	Optional   bool   `json:"optional,omitempty"`
+	Computed   bool   `json:"computed,omitempty"`
+	ForceNew   bool   `json:"force_new,omitempty"`
+	Sensitive  bool   `json:"sensitive,omitempty"`
+	Deprecated string `json:"deprecated,omitempty"`
+	Default    string `json:"default,omitempty"`
+	MinItems   int    `json:"min_items,omitempty"`
+	MaxItems   int    `json:"max_items,omitempty"`
+	// Elem is the schema of the elements of a list, set or map of values
+	Elem *Attribute `json:"elem,omitempty"`
+	// Block is the schema of the elements of a block, or the attributes of an object
+	Block *Block `json:"block,omitempty"`
+}
+
+// Block is the schema of a nested block, it may have no attributes.
+type Block struct {
+	Attributes map[string]*Attribute `json:"attributes"`
+}
+
+// Dump returns the schema of the provider served by server, of its resources and of its data sources.
+// The server is the mux server of the provider, so that the resources of terraform-plugin-framework are dumped too.
+// The protocol schema does not tell which attributes force the replacement of the resource, nor their defaults
+// and state upgraders: they are read from sdkProvider and frameworkProvider, the providers served by server.
+// frameworkProvider may be nil.
+func Dump(ctx context.Context, server tfprotov5.ProviderServer, sdkProvider *schema.Provider, frameworkProvider fwprovider.Provider) (*ProviderSchema, error) {
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("getting provider schema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	s := &ProviderSchema{
+		Resources:   make(map[string]*Resource, len(resp.ResourceSchemas)),
+		DataSources: make(map[string]*Resource, len(resp.DataSourceSchemas)),
+	}
+	if resp.Provider != nil {
+		s.Provider = dumpBlock(resp.Provider.Block)
+		addSDKAttributes(s.Provider, sdkProvider.Schema)
+	}
+	for name, r := range resp.ResourceSchemas {
+		s.Resources[name] = dumpResource(r, sdkProvider.ResourcesMap[name])
+	}
+	for name, r := range resp.DataSourceSchemas {
+		s.DataSources[name] = dumpResource(r, sdkProvider.DataSourcesMap[name])
+	}
+	if frameworkProvider != nil {
+		addFrameworkResources(ctx, s.Resources, frameworkProvider)
+	}
+	return s, nil
+}
+
+func dumpResource(s *tfprotov5.Schema, sdkResource *schema.Resource) *Resource {
+	dumped := &Resource{
+		SchemaVersion: int(s.Version),
+		Attributes:    dumpBlock(s.Block),
+	}
+	// The SDKv2 declares the id as optional although it cannot be set, unlike the framework
+	if id, exists := dumped.Attributes["id"]; exists {
+		id.Optional = false
+	}
+	if sdkResource == nil {
+		return dumped
+	}
+	for _, upgrader := range sdkResource.StateUpgraders {
+		dumped.StateUpgraders = append(dumped.StateUpgraders, upgrader.Version)
+	}
+	addSDKAttributes(dumped.Attributes, sdkResource.SchemaMap())
+	return dumped
+}
+
+func dumpBlock(b *tfprotov5.SchemaBlock) map[string]*Attribute {
+	dumped := map[string]*Attribute{}
+	if b == nil {
+		return dumped
+	}
+	for _, a := range b.Attributes {
+		attribute := dumpType(a.Type)
+		attribute.Required = a.Required
+		attribute.Optional = a.Optional
+		attribute.Computed = a.Computed
+		attribute.Sensitive = a.Sensitive
+		if a.Deprecated {
+			attribute.Deprecated = "deprecated"
+		}
+		dumped[a.Name] = attribute
+	}
+	for _, nested := range b.BlockTypes {
+		attribute := &Attribute{
+			Type:     nestingName(nested.Nesting),
+			Optional: nested.MinItems == 0,
+			Required: nested.MinItems > 0,
+			MinItems: int(nested.MinItems),
+			MaxItems: int(nested.MaxItems),
+			Block:    &Block{Attributes: dumpBlock(nested.Block)},
+		}
+		if nested.Block != nil && nested.Block.Deprecated {
+			attribute.Deprecated = "deprecated"
+		}
+		dumped[nested.TypeName] = attribute
+	}
+	return dumped
+}
+
+// dumpType returns the attribute of a value of type t, with the attributes of its objects as a block.
+func dumpType(t tftypes.Type) *Attribute {
+	switch t := t.(type) {
+	case tftypes.List:
+		return &Attribute{Type: "list", Elem: dumpType(t.ElementType)}
+	case tftypes.Set:
+		return &Attribute{Type: "set", Elem: dumpType(t.ElementType)}
+	case tftypes.Map:
+		return &Attribute{Type: "map", Elem: dumpType(t.ElementType)}
+	case tftypes.Object:
+		attributes := make(map[string]*Attribute, len(t.AttributeTypes))
+		for name, attributeType := range t.AttributeTypes {
+			attributes[name] = dumpType(attributeType)
+			_, optional := t.OptionalAttributes[name]
+			attributes[name].Optional = optional
+		}
+		return &Attribute{Type: "object", Block: &Block{Attributes: attributes}}
+	}
+	switch {
+	case t.Is(tftypes.String):
+		return &Attribute{Type: "string"}
+	case t.Is(tftypes.Number):
+		return &Attribute{Type: "number"}
+	case t.Is(tftypes.Bool):
+		return &Attribute{Type: "bool"}
+	case t.Is(tftypes.DynamicPseudoType):
+		return &Attribute{Type: "dynamic"}
+	}
+	return &Attribute{Type: t.String()}
+}
+
+func nestingName(nesting tfprotov5.SchemaNestedBlockNestingMode) string {
+	switch nesting {
+	case tfprotov5.SchemaNestedBlockNestingModeSingle, tfprotov5.SchemaNestedBlockNestingModeGroup:
+		return "single"
+	case tfprotov5.SchemaNestedBlockNestingModeList:
+		return "list"
+	case tfprotov5.SchemaNestedBlockNestingModeSet:
+		return "set"
+	case tfprotov5.SchemaNestedBlockNestingModeMap:
+		return "map"
+	default:
+		return nesting.String()
+	}
+}
+
+// addSDKAttributes adds to the dumped attributes the settings that only the SDKv2 schema describes.
+func addSDKAttributes(dumped map[string]*Attribute, attributes map[string]*schema.Schema) {
+	for name, s := range attributes {
+		a, exists := dumped[name]
+		if !exists {
+			continue
+		}
+		a.ForceNew = s.ForceNew
+		a.MinItems = s.MinItems
+		a.MaxItems = s.MaxItems
+		if s.Deprecated != "" {
+			a.Deprecated = s.Deprecated
+		}
+		if s.Default != nil {
+			a.Default = fmt.Sprint(s.Default)
+		}
+
+		elem, isResource := s.Elem.(*schema.Resource)
+		switch {
+		case !isResource:
+		case a.Block != nil:
+			addSDKAttributes(a.Block.Attributes, elem.SchemaMap())
+		case a.Elem != nil && a.Elem.Block != nil:
+			// Blocks in attribute mode are lists of objects
+			addSDKAttributes(a.Elem.Block.Attributes, elem.SchemaMap())
+		}
+	}
+}
+
+// Load reads a schema written by Save.
+func Load(path string) (*ProviderSchema, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &ProviderSchema{}
+	if err := json.Unmarshal(content, s); err != nil {
+		return nil, fmt.Errorf("reading schema %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Save writes the schema as indented JSON, with sorted keys so that its changes can be reviewed.
+func (s *ProviderSchema) Save(path string) error {
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(content, '\n'), 0o644)
+}
@@ -71,7 +71,7 @@ func resourceAccountProjectCreate(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := accountAPI.CreateProject(request, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(res.ID)
@@ -89,7 +89,7 @@ func resourceAccountProjectRead(ctx context.Context, d *schema.ResourceData, m i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", res.Name)
@@ -122,7 +122,7 @@ func resourceAccountProjectUpdate(ctx context.Context, d *schema.ResourceData, m
 	if hasChanged {
 		_, err := accountAPI.UpdateProject(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -146,7 +146,7 @@ func resourceAccountProjectDelete(ctx context.Context, d *schema.ResourceData, m
 		return nil
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
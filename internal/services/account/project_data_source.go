@@ -8,6 +8,7 @@ import (
 	accountSDK "github.com/scaleway/scaleway-sdk-go/api/account/v3"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -48,7 +49,7 @@ func DataSourceAccountProjectRead(ctx context.Context, d *schema.ResourceData, m
 			Name:           types.ExpandStringPtr(name),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundProject, err := datasource.FindExact(
@@ -57,14 +58,14 @@ func DataSourceAccountProjectRead(ctx context.Context, d *schema.ResourceData, m
 			name.(string),
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		projectID = foundProject.ID
 	} else {
 		extractedProjectID, _, err := meta.ExtractProjectID(d, m)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		projectID = extractedProjectID
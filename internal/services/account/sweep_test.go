@@ -3,7 +3,7 @@ package account_test
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	accounttestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account/testfuncs"
 )
 
@@ -12,5 +12,5 @@ func init() {
 }
 
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	acctest.TestMain(m)
 }
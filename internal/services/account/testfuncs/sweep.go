@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_account_project", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_account_project", &resource.Sweeper{
 		Name: "scaleway_account_project",
 		F:    testSweepAccountProject,
 	})
@@ -30,11 +30,19 @@ func testSweepAccountProject(_ string) error {
 		}
 		for _, project := range listProjects.Projects {
 			// Do not delete default project
-			if project.ID == req.OrganizationID || !acctest.IsTestResource(project.Name) {
+			if project.ID == req.OrganizationID {
 				continue
 			}
-			err = accountAPI.DeleteProject(&accountSDK.ProjectAPIDeleteProjectRequest{
-				ProjectID: project.ID,
+			err = acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_account_project",
+				Locality:  "global",
+				ID:        project.ID,
+				Name:      project.Name,
+				CreatedAt: project.CreatedAt,
+			}, func() error {
+				return accountAPI.DeleteProject(&accountSDK.ProjectAPIDeleteProjectRequest{
+					ProjectID: project.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("failed to delete project: %w", err)
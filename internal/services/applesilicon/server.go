@@ -4,114 +4,184 @@ import (
 	"context"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	applesilicon "github.com/scaleway/scaleway-sdk-go/api/applesilicon/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/framework"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
-	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
-	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	scwtypes "github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
-func ResourceServer() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: ResourceAppleSiliconServerCreate,
-		ReadContext:   ResourceAppleSiliconServerRead,
-		UpdateContext: ResourceAppleSiliconServerUpdate,
-		DeleteContext: ResourceAppleSiliconServerDelete,
-		Timeouts: &schema.ResourceTimeout{
-			Create:  schema.DefaultTimeout(defaultAppleSiliconServerTimeout),
-			Default: schema.DefaultTimeout(defaultAppleSiliconServerTimeout),
-		},
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		SchemaVersion: 0,
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:        schema.TypeString,
-				Description: "Name of the server",
-				Computed:    true,
-				Optional:    true,
+const serverResourceType = "scaleway_apple_silicon_server"
+
+var (
+	_ resource.ResourceWithConfigure   = (*ServerResource)(nil)
+	_ resource.ResourceWithImportState = (*ServerResource)(nil)
+)
+
+// ServerResource manages an Apple silicon server.
+// It is the reference implementation of a resource written with terraform-plugin-framework,
+// registered with framework.WithOperations which starts the spans of its operations.
+type ServerResource struct {
+	meta *meta.Meta
+}
+
+func NewServerResource() resource.Resource { //nolint:ireturn
+	return &ServerResource{}
+}
+
+type serverResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	IP             types.String `tfsdk:"ip"`
+	VncURL         types.String `tfsdk:"vnc_url"`
+	State          types.String `tfsdk:"state"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	DeletableAt    types.String `tfsdk:"deletable_at"`
+	Zone           types.String `tfsdk:"zone"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	Timeouts       types.Object `tfsdk:"timeouts"`
+}
+
+func (r *ServerResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = serverResourceType
+}
+
+func (r *ServerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	unknownUntilRefresh := []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Description:   "Name of the server",
+				Computed:      true,
+				Optional:      true,
+				PlanModifiers: unknownUntilRefresh,
 			},
-			"type": {
-				Type:        schema.TypeString,
+			"type": schema.StringAttribute{
 				Description: "Type of the server",
 				Required:    true,
-				ForceNew:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			// Computed
-			"ip": {
-				Type:        schema.TypeString,
-				Description: "IPv4 address of the server",
-				Computed:    true,
+			"ip": schema.StringAttribute{
+				Description:   "IPv4 address of the server",
+				Computed:      true,
+				PlanModifiers: unknownUntilRefresh,
 			},
-			"vnc_url": {
-				Type:        schema.TypeString,
-				Description: "VNC url use to connect remotely to the desktop GUI",
-				Computed:    true,
+			"vnc_url": schema.StringAttribute{
+				Description:   "VNC url use to connect remotely to the desktop GUI",
+				Computed:      true,
+				PlanModifiers: unknownUntilRefresh,
 			},
-			"state": {
-				Type:        schema.TypeString,
-				Computed:    true,
+			"state": schema.StringAttribute{
 				Description: "The state of the server",
-			},
-			"created_at": {
-				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The date and time of the creation of the server",
 			},
-			"updated_at": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The date and time of the last update of the server",
+			"created_at": schema.StringAttribute{
+				Description:   "The date and time of the creation of the server",
+				Computed:      true,
+				PlanModifiers: unknownUntilRefresh,
 			},
-			"deletable_at": {
-				Type:        schema.TypeString,
+			"updated_at": schema.StringAttribute{
+				Description: "The date and time of the last update of the server",
 				Computed:    true,
-				Description: "The minimal date and time on which you can delete this server due to Apple licence",
 			},
-
+			"deletable_at": schema.StringAttribute{
+				Description:   "The minimal date and time on which you can delete this server due to Apple licence",
+				Computed:      true,
+				PlanModifiers: unknownUntilRefresh,
+			},
 			// Common
-			"zone":            zonal.Schema(),
-			"organization_id": account.OrganizationIDSchema(),
-			"project_id":      account.ProjectIDSchema(),
+			"zone":            framework.ZoneAttribute(),
+			"organization_id": framework.OrganizationIDAttribute(),
+			"project_id":      framework.ProjectIDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": framework.TimeoutsBlock("create", "default"),
 		},
 	}
 }
 
-func ResourceAppleSiliconServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	asAPI, zone, err := newAPIWithZone(d, m)
-	if err != nil {
-		return diag.FromErr(err)
+func (r *ServerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	m, diags := framework.ExtractMeta(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.meta = m
+}
+
+func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan serverResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	createReq := &applesilicon.CreateServerRequest{
-		Name:      types.ExpandOrGenerateString(d.Get("name"), "m1"),
-		Type:      d.Get("type").(string),
-		ProjectID: d.Get("project_id").(string),
+	zone, err := framework.ExtractZone(plan.Zone, r.meta)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("zone"), "Invalid zone", err.Error())
+		return
+	}
+	timeout, diags := framework.Timeout(plan.Timeouts, "create", defaultAppleSiliconServerTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	res, err := asAPI.CreateServer(createReq, scw.WithContext(ctx))
+	asAPI := applesilicon.NewAPI(r.meta.ScwClient())
+	res, err := asAPI.CreateServer(&applesilicon.CreateServerRequest{
+		Zone:      zone,
+		Name:      scwtypes.ExpandOrGenerateString(plan.Name.ValueString(), "m1"),
+		Type:      plan.Type.ValueString(),
+		ProjectID: plan.ProjectID.ValueString(),
+	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		framework.AddError(&resp.Diagnostics, "Cannot create the Apple silicon server", err)
+		return
 	}
 
-	d.SetId(zonal.NewIDString(zone, res.ID))
+	// The server is saved before waiting for it, so that it is tainted rather than lost if it never becomes ready.
+	plan.flatten(res)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	_, err = waitForAppleSiliconServer(ctx, asAPI, zone, res.ID, d.Timeout(schema.TimeoutCreate))
+	server, err := waitForAppleSiliconServer(ctx, asAPI, zone, res.ID, timeout)
 	if err != nil {
-		return diag.FromErr(err)
+		framework.AddError(&resp.Diagnostics, "Error waiting for the Apple silicon server", err)
+		return
 	}
 
-	return ResourceAppleSiliconServerRead(ctx, d, m)
+	plan.flatten(server)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func ResourceAppleSiliconServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	asAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
+func (r *ServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asAPI, zone, ID, err := NewAPIWithZoneAndID(r.meta, state.ID.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid ID", err.Error())
+		return
 	}
 
 	res, err := asAPI.GetServer(&applesilicon.GetServerRequest{
@@ -120,65 +190,87 @@ func ResourceAppleSiliconServerRead(ctx context.Context, d *schema.ResourceData,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if httperrors.Is404(err) {
-			d.SetId("")
-			return nil
+			resp.State.RemoveResource(ctx)
+			return
 		}
-		return diag.FromErr(err)
+		framework.AddError(&resp.Diagnostics, "Cannot read the Apple silicon server", err)
+		return
 	}
 
-	_ = d.Set("name", res.Name)
-	_ = d.Set("type", res.Type)
-	_ = d.Set("state", res.Status.String())
-	_ = d.Set("created_at", res.CreatedAt.Format(time.RFC3339))
-	_ = d.Set("updated_at", res.UpdatedAt.Format(time.RFC3339))
-	_ = d.Set("deletable_at", res.DeletableAt.Format(time.RFC3339))
-	_ = d.Set("ip", res.IP.String())
-	_ = d.Set("vnc_url", res.VncURL)
-
-	_ = d.Set("zone", res.Zone.String())
-	_ = d.Set("organization_id", res.OrganizationID)
-	_ = d.Set("project_id", res.ProjectID)
-
-	return nil
+	state.flatten(res)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
-func ResourceAppleSiliconServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	asAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
+func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state serverResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asAPI, zone, ID, err := NewAPIWithZoneAndID(r.meta, state.ID.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid ID", err.Error())
+		return
 	}
 
-	req := &applesilicon.UpdateServerRequest{
+	updateReq := &applesilicon.UpdateServerRequest{
 		Zone:     zone,
 		ServerID: ID,
 	}
-
-	if d.HasChange("name") {
-		req.Name = types.ExpandStringPtr(d.Get("name"))
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(state.Name) {
+		updateReq.Name = plan.Name.ValueStringPointer()
 	}
 
-	_, err = asAPI.UpdateServer(req, scw.WithContext(ctx))
+	res, err := asAPI.UpdateServer(updateReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		framework.AddError(&resp.Diagnostics, "Cannot update the Apple silicon server", err)
+		return
 	}
 
-	return ResourceAppleSiliconServerRead(ctx, d, m)
+	plan.flatten(res)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-func ResourceAppleSiliconServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	asAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
+func (r *ServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state serverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asAPI, zone, ID, err := NewAPIWithZoneAndID(r.meta, state.ID.ValueString())
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid ID", err.Error())
+		return
 	}
 
 	err = asAPI.DeleteServer(&applesilicon.DeleteServerRequest{
 		Zone:     zone,
 		ServerID: ID,
 	}, scw.WithContext(ctx))
-
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		framework.AddError(&resp.Diagnostics, "Cannot delete the Apple silicon server", err)
 	}
+}
+
+func (r *ServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
 
-	return nil
+// flatten sets the attributes of the model from the server returned by the API.
+func (m *serverResourceModel) flatten(server *applesilicon.Server) {
+	m.ID = types.StringValue(zonal.NewIDString(server.Zone, server.ID))
+	m.Name = types.StringValue(server.Name)
+	m.Type = types.StringValue(server.Type)
+	m.State = types.StringValue(server.Status.String())
+	m.CreatedAt = types.StringValue(server.CreatedAt.Format(time.RFC3339))
+	m.UpdatedAt = types.StringValue(server.UpdatedAt.Format(time.RFC3339))
+	m.DeletableAt = types.StringValue(server.DeletableAt.Format(time.RFC3339))
+	m.IP = types.StringValue(server.IP.String())
+	m.VncURL = types.StringValue(server.VncURL)
+	m.Zone = types.StringValue(server.Zone.String())
+	m.OrganizationID = types.StringValue(server.OrganizationID)
+	m.ProjectID = types.StringValue(server.ProjectID)
 }
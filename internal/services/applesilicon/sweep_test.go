@@ -3,7 +3,7 @@ package applesilicon_test
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	applesilicontestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/applesilicon/testfuncs"
 )
 
@@ -12,5 +12,5 @@ func init() {
 }
 
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	acctest.TestMain(m)
 }
@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_apple_silicon_instance", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_apple_silicon_instance", &resource.Sweeper{
 		Name: "scaleway_apple_silicon",
 		F:    testSweepAppleSiliconServer,
 	})
@@ -27,12 +27,20 @@ func testSweepAppleSiliconServer(_ string) error {
 		}
 
 		for _, server := range listServers.Servers {
-			errDelete := asAPI.DeleteServer(&applesiliconSDK.DeleteServerRequest{
-				ServerID: server.ID,
-				Zone:     zone,
+			errDelete := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_apple_silicon_server",
+				Locality:  zone.String(),
+				ID:        server.ID,
+				Name:      server.Name,
+				CreatedAt: server.CreatedAt,
+			}, func() error {
+				return asAPI.DeleteServer(&applesiliconSDK.DeleteServerRequest{
+					ServerID: server.ID,
+					Zone:     zone,
+				})
 			})
 			if errDelete != nil {
-				return fmt.Errorf("error deleting apple silicon server in sweeper: %s", err)
+				return fmt.Errorf("error deleting apple silicon server in sweeper: %w", errDelete)
 			}
 		}
 
@@ -6,6 +6,7 @@ import (
 
 	applesilicon "github.com/scaleway/scaleway-sdk-go/api/applesilicon/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
@@ -14,18 +15,29 @@ const (
 	defaultAppleSiliconServerRetryInterval = 5 * time.Second
 )
 
-func waitForAppleSiliconServer(ctx context.Context, api *applesilicon.API, zone scw.Zone, serverID string, timeout time.Duration) (*applesilicon.Server, error) {
-	retryInterval := defaultAppleSiliconServerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForAppleSiliconServer(ctx context.Context, api *applesilicon.API, zone scw.Zone, serverID string, timeout time.Duration) (_ *applesilicon.Server, err error) {
+	ctx, span := tracing.Start(ctx, "waitForAppleSiliconServer",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(serverID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[applesilicon.ServerStatus]struct{}{
+		applesilicon.ServerStatusReady: {},
+		applesilicon.ServerStatusError: {},
 	}
 
-	server, err := api.WaitForServer(&applesilicon.WaitForServerRequest{
-		ServerID:      serverID,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	server, err := transport.Poll(ctx, defaultAppleSiliconServerRetryInterval, timeout, func(ctx context.Context) (*applesilicon.Server, bool, error) {
+		res, err := api.GetServer(&applesilicon.GetServerRequest{
+			ServerID: serverID,
+			Zone:     zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return server, err
 }
@@ -0,0 +1,89 @@
+package baremetal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	sdkValidation "github.com/scaleway/scaleway-sdk-go/validation"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+)
+
+// customDiffServerCapacity checks that the offer of a new server is in stock and that the quota of the offer allows it.
+func customDiffServerCapacity(ctx context.Context, diff *schema.ResourceDiff, client *scw.Client) error {
+	api := baremetal.NewAPI(client)
+
+	offerID := zonal.ExpandID(diff.Get("offer"))
+	zone := scw.Zone(diff.Get("zone").(string))
+	if zone == "" {
+		zone = offerID.Zone
+	}
+	if zone == "" {
+		zone, _ = client.GetDefaultZone()
+	}
+
+	var offer *baremetal.Offer
+	var err error
+	if sdkValidation.IsUUID(offerID.ID) {
+		offer, err = api.GetOffer(&baremetal.GetOfferRequest{
+			Zone:    zone,
+			OfferID: offerID.ID,
+		}, scw.WithContext(ctx))
+	} else {
+		offer, err = findOfferByName(ctx, api, zone, offerID.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if offer.Stock == baremetal.OfferStockEmpty {
+		return cdf.OutOfStockError(fmt.Sprintf("offer %s in %s", offer.Name, zone), 1)
+	}
+	if offer.QuotaName == "" {
+		return nil
+	}
+
+	return cdf.CheckQuota(ctx, client, 1, cdf.Quota{
+		Name: offer.QuotaName,
+		Used: func() (uint64, error) {
+			organizationID, _ := client.GetDefaultOrganizationID()
+			servers, err := api.ListServers(&baremetal.ListServersRequest{
+				Zone:           zone,
+				OrganizationID: &organizationID,
+			}, scw.WithContext(ctx), scw.WithAllPages())
+			if err != nil {
+				return 0, err
+			}
+
+			used := uint64(0)
+			for _, server := range servers.Servers {
+				if server.OfferID == offer.ID {
+					used++
+				}
+			}
+			return used, nil
+		},
+	})
+}
+
+// findOfferByName is baremetal.API.GetOfferByName with the context of the plan, which the SDK helper does not take.
+func findOfferByName(ctx context.Context, api *baremetal.API, zone scw.Zone, name string) (*baremetal.Offer, error) {
+	res, err := api.ListOffers(&baremetal.ListOffersRequest{
+		Zone: zone,
+		Name: &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, offer := range res.Offers {
+		if offer.Name == name {
+			return offer, nil
+		}
+	}
+
+	return nil, fmt.Errorf("offer %s not found in zone %s", name, zone)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -144,7 +145,7 @@ func DataSourceOffer() *schema.Resource {
 func dataSourceOfferRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, fallBackZone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	zone, offerID, _ := zonal.ParseID(datasource.NewZonedID(d.Get("offer_id"), fallBackZone))
@@ -155,7 +156,7 @@ func dataSourceOfferRead(ctx context.Context, d *schema.ResourceData, m interfac
 		// Temporary fix because GetOffer doesn't fetch monthly subscription offers
 		offer, err = FindOfferByID(ctx, api, zone, offerID)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	} else {
 		listOffersRequest := &baremetal.ListOffersRequest{
@@ -167,7 +168,7 @@ func dataSourceOfferRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 		res, err := api.ListOffers(listOffersRequest, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		var matches []*baremetal.Offer
@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -43,7 +44,7 @@ func DataSourceOption() *schema.Resource {
 func dataSourceOptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var optionName string
@@ -56,7 +57,7 @@ func dataSourceOptionRead(ctx context.Context, d *schema.ResourceData, m interfa
 			OptionID: optionID.(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		optionManageable = res.Manageable
 		optionName = res.Name
@@ -65,7 +66,7 @@ func dataSourceOptionRead(ctx context.Context, d *schema.ResourceData, m interfa
 			Zone: zone,
 		}, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		if len(res.Options) == 0 {
 			return diag.FromErr(fmt.Errorf("no option found with the name %s", d.Get("name")))
@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -44,7 +45,7 @@ func DataSourceOS() *schema.Resource {
 func DataSourceOSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var osVersion, osName string
@@ -57,7 +58,7 @@ func DataSourceOSRead(ctx context.Context, d *schema.ResourceData, m interface{}
 			OsID: osID.(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		osVersion = res.Version
 		osName = res.Name
@@ -67,7 +68,7 @@ func DataSourceOSRead(ctx context.Context, d *schema.ResourceData, m interface{}
 			Zone: zone,
 		}, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		if len(res.Os) == 0 {
 			return diag.FromErr(fmt.Errorf("no os found with the name %s", d.Get("name")))
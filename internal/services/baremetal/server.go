@@ -19,6 +19,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -109,10 +110,26 @@ If this behaviour is wanted, please set 'reinstall_on_ssh_key_changes' argument
 				Description: "User used for the installation.",
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "Password used for the installation.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Password used for the installation.",
+				ConflictsWith: []string{"password_wo"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				Description:   "Write-only password used for the installation, sent to the API but never stored in the state. Requires Terraform 1.11 or later",
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Version of the write-only password. Changing it reinstalls the server with the current value of password_wo when reinstall_on_config_changes is true",
+				RequiredWith: []string{"password_wo"},
 			},
 			"service_user": {
 				Type:        schema.TypeString,
@@ -266,6 +283,7 @@ If this behaviour is wanted, please set 'reinstall_on_ssh_key_changes' argument
 		CustomizeDiff: customdiff.Sequence(
 			cdf.LocalityCheck("private_network.#.id"),
 			customDiffPrivateNetworkOption(),
+			cdf.CapacityCheck(customDiffServerCapacity, "offer"),
 		),
 	}
 }
@@ -300,12 +318,12 @@ func ResourceServerIP() *schema.Resource {
 func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	privateNetworkAPI, _, err := newPrivateNetworkAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	offerID := zonal.ExpandID(d.Get("offer"))
@@ -315,7 +333,7 @@ func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			Zone:      zone,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		offerID = zonal.NewID(zone, o.ID)
 	}
@@ -338,7 +356,7 @@ func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			todecode, _ := file.(string)
 			err = json.Unmarshal([]byte(todecode), &partitioningSchema)
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 		req.Install = &baremetal.CreateServerRequestInstall{
@@ -346,14 +364,14 @@ func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			Hostname:           d.Get("hostname").(string),
 			SSHKeyIDs:          types.ExpandStrings(d.Get("ssh_key_ids")),
 			User:               types.ExpandStringPtr(d.Get("user")),
-			Password:           types.ExpandStringPtr(d.Get("password")),
+			Password:           types.ExpandStringPtr(meta.GetWriteOnlyOrString(d, "password_wo", "password")),
 			PartitioningSchema: &partitioningSchema,
 		}
 	}
 
 	server, err := api.CreateServer(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewID(server.Zone, server.ID).String())
@@ -364,14 +382,14 @@ func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		_, err = waitForServerInstall(ctx, api, zone, server.ID, d.Timeout(schema.TimeoutCreate))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	options, optionsExist := d.GetOk("options")
 	if optionsExist {
 		opSpecs, err := expandOptions(options)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		for i := range opSpecs {
 			_, err = api.AddOptionServer(&baremetal.AddOptionServerRequest{
@@ -381,7 +399,7 @@ func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 				ExpiresAt: opSpecs[i].ExpiresAt,
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -399,12 +417,12 @@ func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			scw.WithContext(ctx),
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitForServerPrivateNetwork(ctx, privateNetworkAPI, zone, baremetalPrivateNetwork.ServerPrivateNetworks[0].ServerID, d.Timeout(schema.TimeoutCreate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -414,12 +432,12 @@ func ResourceServerCreate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zonedID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	privateNetworkAPI, _, err := newPrivateNetworkAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	server, err := api.GetServer(&baremetal.GetServerRequest{
@@ -431,7 +449,7 @@ func ResourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	offer, err := api.GetOffer(&baremetal.GetOfferRequest{
@@ -439,7 +457,7 @@ func ResourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 		OfferID: server.OfferID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var os *baremetal.OS
@@ -449,7 +467,7 @@ func ResourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 			OsID: server.Install.OsID,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -480,11 +498,11 @@ func ResourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 		ServerID: &server.ID,
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to list server's private networks: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to list server's private networks: %w", err))
 	}
 	pnRegion, err := server.Zone.Region()
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("private_network", flattenPrivateNetworks(pnRegion, listPrivateNetworks.ServerPrivateNetworks))
 
@@ -495,12 +513,12 @@ func ResourceServerRead(ctx context.Context, d *schema.ResourceData, m interface
 func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zonedID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	privateNetworkAPI, zone, err := newPrivateNetworkAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	server, err := api.GetServer(&baremetal.GetServerRequest{
@@ -508,7 +526,7 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		ServerID: zonedID.ID,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var serverGetOptionIDs []*baremetal.ServerOption
@@ -517,7 +535,7 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	if d.HasChange("options") {
 		options, err := expandOptions(d.Get("options"))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		optionsToDelete := compareOptions(options, serverGetOptionIDs)
 		for i := range optionsToDelete {
@@ -527,13 +545,13 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 				OptionID: optionsToDelete[i].ID,
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 
 		_, err = waitForServerOptions(ctx, api, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutDelete))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		optionsToAdd := compareOptions(serverGetOptionIDs, options)
@@ -545,7 +563,7 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 				ExpiresAt: optionsToAdd[i].ExpiresAt,
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -564,12 +582,12 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			scw.WithContext(ctx),
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitForServerPrivateNetwork(ctx, privateNetworkAPI, zone, baremetalPrivateNetwork.ServerPrivateNetworks[0].ServerID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -590,7 +608,7 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 		hasChanged = true
 	}
@@ -598,7 +616,7 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	if hasChanged {
 		_, err = api.UpdateServer(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -608,7 +626,7 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		Hostname:        types.ExpandStringWithDefault(d.Get("hostname"), d.Get("name").(string)),
 		SSHKeyIDs:       types.ExpandStrings(d.Get("ssh_key_ids")),
 		User:            types.ExpandStringPtr(d.Get("user")),
-		Password:        types.ExpandStringPtr(d.Get("password")),
+		Password:        types.ExpandStringPtr(meta.GetWriteOnlyOrString(d, "password_wo", "password")),
 		ServiceUser:     types.ExpandStringPtr(d.Get("service_user")),
 		ServicePassword: types.ExpandStringPtr(d.Get("service_password")),
 	}
@@ -619,18 +637,18 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 		err = installServer(ctx, d, api, installReq)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitForServerInstall(ctx, api, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	var diags diag.Diagnostics
 
-	if d.HasChanges("ssh_key_ids", "user", "password", "reinstall_on_config_changes") {
+	if d.HasChanges("ssh_key_ids", "user", "password", "password_wo_version", "reinstall_on_config_changes") {
 		if !d.Get("reinstall_on_config_changes").(bool) && !d.HasChange("os") {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Warning,
@@ -644,12 +662,12 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			}
 			err = installServer(ctx, d, api, installReq)
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			_, err = waitForServerInstall(ctx, api, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -660,12 +678,12 @@ func ResourceServerUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zonedID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = detachAllPrivateNetworkFromServer(ctx, d, m, zonedID.ID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteServer(&baremetal.DeleteServerRequest{
@@ -676,12 +694,12 @@ func ResourceServerDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		if httperrors.Is404(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForServer(ctx, api, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -700,7 +718,7 @@ func installAttributeMissing(field *baremetal.OSOSField, d *schema.ResourceData,
 func validateInstallConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	baremetalAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	os, err := baremetalAPI.GetOS(&baremetal.GetOSRequest{
@@ -708,7 +726,7 @@ func validateInstallConfig(ctx context.Context, d *schema.ResourceData, m interf
 		OsID: locality.ExpandID(d.Get("os")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := diag.Diagnostics(nil)
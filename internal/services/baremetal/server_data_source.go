@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -15,6 +16,8 @@ import (
 func DataSourceServer() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasource.SchemaFromResourceSchema(ResourceServer().Schema)
+	delete(dsSchema, "password_wo")
+	delete(dsSchema, "password_wo_version")
 
 	// Set 'Optional' schema elements
 	datasource.AddOptionalFieldsToSchema(dsSchema, "name", "zone", "project_id")
@@ -37,7 +40,7 @@ func DataSourceServer() *schema.Resource {
 func DataSourceServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	serverID, ok := d.GetOk("server_id")
@@ -49,7 +52,7 @@ func DataSourceServerRead(ctx context.Context, d *schema.ResourceData, m interfa
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundServer, err := datasource.FindExact(
@@ -58,7 +61,7 @@ func DataSourceServerRead(ctx context.Context, d *schema.ResourceData, m interfa
 			serverName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		serverID = foundServer.ID
@@ -68,7 +71,7 @@ func DataSourceServerRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.SetId(zoneID)
 	err = d.Set("server_id", zoneID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	diags := ResourceServerRead(ctx, d, m)
 	if diags != nil {
@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_baremetal_server", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_baremetal_server", &resource.Sweeper{
 		Name: "scaleway_baremetal_server",
 		F:    testSweepServer,
 	})
@@ -28,12 +28,21 @@ func testSweepServer(_ string) error {
 		}
 
 		for _, server := range listServers.Servers {
-			_, err := baremetalAPI.DeleteServer(&baremetalSDK.DeleteServerRequest{
-				Zone:     zone,
-				ServerID: server.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_baremetal_server",
+				Locality:  zone.String(),
+				ID:        server.ID,
+				Name:      server.Name,
+				CreatedAt: server.CreatedAt,
+			}, func() error {
+				_, err := baremetalAPI.DeleteServer(&baremetalSDK.DeleteServerRequest{
+					Zone:     zone,
+					ServerID: server.ID,
+				})
+				return err
 			})
 			if err != nil {
-				return fmt.Errorf("error deleting server in sweeper: %s", err)
+				return fmt.Errorf("error deleting server in sweeper: %w", err)
 			}
 		}
 
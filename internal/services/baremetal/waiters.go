@@ -2,73 +2,138 @@ package baremetal
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	baremetalV3 "github.com/scaleway/scaleway-sdk-go/api/baremetal/v3"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitForServer(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (*baremetal.Server, error) {
-	retryInterval := retryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForServer(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (_ *baremetal.Server, err error) {
+	ctx, span := tracing.Start(ctx, "waitForServer",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(serverID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[baremetal.ServerStatus]struct{}{
+		baremetal.ServerStatusReady:   {},
+		baremetal.ServerStatusStopped: {},
+		baremetal.ServerStatusError:   {},
+		baremetal.ServerStatusLocked:  {},
+		baremetal.ServerStatusUnknown: {},
 	}
 
-	server, err := api.WaitForServer(&baremetal.WaitForServerRequest{
-		Zone:          zone,
-		ServerID:      serverID,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	server, err := transport.Poll(ctx, retryInterval, timeout, func(ctx context.Context) (*baremetal.Server, bool, error) {
+		server, err := api.GetServer(&baremetal.GetServerRequest{
+			Zone:     zone,
+			ServerID: serverID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[server.Status]
+		return server, isTerminal, nil
+	})
 
 	return server, err
 }
 
-func waitForServerInstall(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (*baremetal.Server, error) {
-	retryInterval := retryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForServerInstall(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (_ *baremetal.Server, err error) {
+	ctx, span := tracing.Start(ctx, "waitForServerInstall",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(serverID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	installTerminalStatus := map[baremetal.ServerInstallStatus]struct{}{
+		baremetal.ServerInstallStatusCompleted: {},
+		baremetal.ServerInstallStatusError:     {},
+		baremetal.ServerInstallStatusUnknown:   {},
 	}
 
-	server, err := api.WaitForServerInstall(&baremetal.WaitForServerInstallRequest{
-		Zone:          zone,
-		ServerID:      serverID,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	server, err := transport.Poll(ctx, retryInterval, timeout, func(ctx context.Context) (*baremetal.Server, bool, error) {
+		server, err := api.GetServer(&baremetal.GetServerRequest{
+			Zone:     zone,
+			ServerID: serverID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		if server.Install == nil {
+			return nil, false, fmt.Errorf("server creation has not begun for server %s", serverID)
+		}
+		_, isTerminal := installTerminalStatus[server.Install.Status]
+		return server, isTerminal, nil
+	})
 
 	return server, err
 }
 
-func waitForServerOptions(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (*baremetal.Server, error) {
-	retryInterval := retryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForServerOptions(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (_ *baremetal.Server, err error) {
+	ctx, span := tracing.Start(ctx, "waitForServerOptions",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(serverID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[baremetal.ServerOptionOptionStatus]struct{}{
+		baremetal.ServerOptionOptionStatusOptionStatusEnable:  {},
+		baremetal.ServerOptionOptionStatusOptionStatusError:   {},
+		baremetal.ServerOptionOptionStatusOptionStatusUnknown: {},
 	}
 
-	server, err := api.WaitForServerOptions(&baremetal.WaitForServerOptionsRequest{
-		Zone:          zone,
-		ServerID:      serverID,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	server, err := transport.Poll(ctx, retryInterval, timeout, func(ctx context.Context) (*baremetal.Server, bool, error) {
+		server, err := api.GetServer(&baremetal.GetServerRequest{
+			Zone:     zone,
+			ServerID: serverID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		for _, option := range server.Options {
+			if _, isTerminal := terminalStatus[option.Status]; !isTerminal {
+				return server, false, nil
+			}
+		}
+		return server, true, nil
+	})
 
 	return server, err
 }
 
-func waitForServerPrivateNetwork(ctx context.Context, api *baremetalV3.PrivateNetworkAPI, zone scw.Zone, serverID string, timeout time.Duration) ([]*baremetalV3.ServerPrivateNetwork, error) {
-	retryInterval := retryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForServerPrivateNetwork(ctx context.Context, api *baremetalV3.PrivateNetworkAPI, zone scw.Zone, serverID string, timeout time.Duration) (_ []*baremetalV3.ServerPrivateNetwork, err error) {
+	ctx, span := tracing.Start(ctx, "waitForServerPrivateNetwork",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(serverID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[baremetalV3.ServerPrivateNetworkStatus]struct{}{
+		baremetalV3.ServerPrivateNetworkStatusAttached:      {},
+		baremetalV3.ServerPrivateNetworkStatusError:         {},
+		baremetalV3.ServerPrivateNetworkStatusUnknownStatus: {},
+		baremetalV3.ServerPrivateNetworkStatusLocked:        {},
 	}
-	serverPrivateNetwork, err := api.WaitForServerPrivateNetworks(&baremetalV3.WaitForServerPrivateNetworksRequest{
-		Zone:          zone,
-		ServerID:      serverID,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+
+	serverPrivateNetwork, err := transport.Poll(ctx, retryInterval, timeout, func(ctx context.Context) ([]*baremetalV3.ServerPrivateNetwork, bool, error) {
+		res, err := api.ListServerPrivateNetworks(&baremetalV3.PrivateNetworkAPIListServerPrivateNetworksRequest{
+			Zone:     zone,
+			ServerID: &serverID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		for _, serverPrivateNetwork := range res.ServerPrivateNetworks {
+			if _, isTerminal := terminalStatus[serverPrivateNetwork.Status]; !isTerminal {
+				return res.ServerPrivateNetworks, false, nil
+			}
+		}
+		return res.ServerPrivateNetworks, true, nil
+	})
 
 	return serverPrivateNetwork, err
 }
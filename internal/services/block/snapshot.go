@@ -63,7 +63,7 @@ func ResourceSnapshot() *schema.Resource {
 func ResourceBlockSnapshotCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := blockAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	snapshot, err := api.CreateSnapshot(&block.CreateSnapshotRequest{
@@ -74,14 +74,14 @@ func ResourceBlockSnapshotCreate(ctx context.Context, d *schema.ResourceData, m
 		Tags:      types.ExpandStrings(d.Get("tags")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, snapshot.ID))
 
 	_, err = waitForBlockSnapshot(ctx, api, zone, snapshot.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceBlockSnapshotRead(ctx, d, m)
@@ -90,7 +90,7 @@ func ResourceBlockSnapshotCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceBlockSnapshotRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	snapshot, err := waitForBlockSnapshot(ctx, api, zone, id, d.Timeout(schema.TimeoutRead))
@@ -99,7 +99,7 @@ func ResourceBlockSnapshotRead(ctx context.Context, d *schema.ResourceData, m in
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", snapshot.Name)
@@ -118,7 +118,7 @@ func ResourceBlockSnapshotRead(ctx context.Context, d *schema.ResourceData, m in
 func ResourceBlockSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	snapshot, err := waitForBlockSnapshot(ctx, api, zone, id, d.Timeout(schema.TimeoutUpdate))
@@ -127,7 +127,7 @@ func ResourceBlockSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &block.UpdateSnapshotRequest{
@@ -139,12 +139,12 @@ func ResourceBlockSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m
 		req.Name = types.ExpandUpdatedStringPtr(d.Get("name"))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 
 	if _, err := api.UpdateSnapshot(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceBlockSnapshotRead(ctx, d, m)
@@ -153,12 +153,12 @@ func ResourceBlockSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m
 func ResourceBlockSnapshotDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForBlockSnapshotToBeAvailable(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteSnapshot(&block.DeleteSnapshotRequest{
@@ -166,12 +166,12 @@ func ResourceBlockSnapshotDelete(ctx context.Context, d *schema.ResourceData, m
 		SnapshotID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForBlockSnapshot(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	block "github.com/scaleway/scaleway-sdk-go/api/block/v1alpha1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -34,7 +35,7 @@ func DataSourceSnapshot() *schema.Resource {
 func DataSourceBlockSnapshotRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := blockAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	snapshotID, snapshotIDExists := d.GetOk("snapshot_id")
@@ -46,7 +47,7 @@ func DataSourceBlockSnapshotRead(ctx context.Context, d *schema.ResourceData, m
 			VolumeID:  types.ExpandStringPtr(d.Get("volume_id")),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		for _, snapshot := range res.Snapshots {
 			if snapshot.Name == d.Get("name").(string) {
@@ -65,7 +66,7 @@ func DataSourceBlockSnapshotRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(zoneID)
 	err = d.Set("snapshot_id", zoneID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := ResourceBlockSnapshotRead(ctx, d, m)
@@ -11,11 +11,11 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_block_snapshot", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_block_snapshot", &resource.Sweeper{
 		Name: "scaleway_block_snapshot",
 		F:    testSweepSnapshot,
 	})
-	resource.AddTestSweepers("scaleway_block_volume", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_block_volume", &resource.Sweeper{
 		Name: "scaleway_block_volume",
 		F:    testSweepBlockVolume,
 	})
@@ -34,14 +34,22 @@ func testSweepBlockVolume(_ string) error {
 		}
 
 		for _, volume := range listVolumes.Volumes {
-			err := blockAPI.DeleteVolume(&blockSDK.DeleteVolumeRequest{
-				VolumeID: volume.ID,
-				Zone:     zone,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_block_volume",
+				Locality:  zone.String(),
+				ID:        volume.ID,
+				Name:      volume.Name,
+				CreatedAt: volume.CreatedAt,
+			}, func() error {
+				return blockAPI.DeleteVolume(&blockSDK.DeleteVolumeRequest{
+					VolumeID: volume.ID,
+					Zone:     zone,
+				})
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
 
-				return fmt.Errorf("error deleting volume in sweeper: %s", err)
+				return fmt.Errorf("error deleting volume in sweeper: %w", err)
 			}
 		}
 
@@ -62,14 +70,22 @@ func testSweepSnapshot(_ string) error {
 		}
 
 		for _, snapshot := range listSnapshots.Snapshots {
-			err := blockAPI.DeleteSnapshot(&blockSDK.DeleteSnapshotRequest{
-				SnapshotID: snapshot.ID,
-				Zone:       zone,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_block_snapshot",
+				Locality:  zone.String(),
+				ID:        snapshot.ID,
+				Name:      snapshot.Name,
+				CreatedAt: snapshot.CreatedAt,
+			}, func() error {
+				return blockAPI.DeleteSnapshot(&blockSDK.DeleteSnapshotRequest{
+					SnapshotID: snapshot.ID,
+					Zone:       zone,
+				})
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
 
-				return fmt.Errorf("error deleting snapshot in sweeper: %s", err)
+				return fmt.Errorf("error deleting snapshot in sweeper: %w", err)
 			}
 		}
 
@@ -80,7 +80,7 @@ func ResourceVolume() *schema.Resource {
 func ResourceBlockVolumeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := blockAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &block.CreateVolumeRequest{
@@ -110,14 +110,14 @@ func ResourceBlockVolumeCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	volume, err := api.CreateVolume(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, volume.ID))
 
 	_, err = waitForBlockVolume(ctx, api, zone, volume.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceBlockVolumeRead(ctx, d, m)
@@ -126,7 +126,7 @@ func ResourceBlockVolumeCreate(ctx context.Context, d *schema.ResourceData, m in
 func ResourceBlockVolumeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	volume, err := waitForBlockVolume(ctx, api, zone, id, d.Timeout(schema.TimeoutRead))
@@ -135,7 +135,7 @@ func ResourceBlockVolumeRead(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", volume.Name)
@@ -160,7 +160,7 @@ func ResourceBlockVolumeRead(ctx context.Context, d *schema.ResourceData, m inte
 func ResourceBlockVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	volume, err := waitForBlockVolume(ctx, api, zone, id, d.Timeout(schema.TimeoutUpdate))
@@ -169,7 +169,7 @@ func ResourceBlockVolumeUpdate(ctx context.Context, d *schema.ResourceData, m in
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &block.UpdateVolumeRequest{
@@ -186,12 +186,12 @@ func ResourceBlockVolumeUpdate(ctx context.Context, d *schema.ResourceData, m in
 		req.Size = &volumeSizeInBytes
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 
 	if _, err := api.UpdateVolume(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceBlockVolumeRead(ctx, d, m)
@@ -200,7 +200,7 @@ func ResourceBlockVolumeUpdate(ctx context.Context, d *schema.ResourceData, m in
 func ResourceBlockVolumeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForBlockVolume(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
@@ -209,7 +209,7 @@ func ResourceBlockVolumeDelete(ctx context.Context, d *schema.ResourceData, m in
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteVolume(&block.DeleteVolumeRequest{
@@ -217,12 +217,12 @@ func ResourceBlockVolumeDelete(ctx context.Context, d *schema.ResourceData, m in
 		VolumeID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForBlockVolume(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	block "github.com/scaleway/scaleway-sdk-go/api/block/v1alpha1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -34,7 +35,7 @@ func DataSourceVolume() *schema.Resource {
 func DataSourceBlockVolumeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := blockAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	volumeID, volumeIDExists := d.GetOk("volume_id")
@@ -45,7 +46,7 @@ func DataSourceBlockVolumeRead(ctx context.Context, d *schema.ResourceData, m in
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		for _, volume := range res.Volumes {
 			if volume.Name == d.Get("name").(string) {
@@ -64,7 +65,7 @@ func DataSourceBlockVolumeRead(ctx context.Context, d *schema.ResourceData, m in
 	d.SetId(zoneID)
 	err = d.Set("volume_id", zoneID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := ResourceBlockVolumeRead(ctx, d, m)
@@ -6,56 +6,91 @@ import (
 
 	block "github.com/scaleway/scaleway-sdk-go/api/block/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitForBlockVolume(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (*block.Volume, error) {
-	retryInterval := defaultBlockRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForBlockVolume(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (_ *block.Volume, err error) {
+	ctx, span := tracing.Start(ctx, "waitForBlockVolume",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[block.VolumeStatus]struct{}{
+		block.VolumeStatusError:     {},
+		block.VolumeStatusLocked:    {},
+		block.VolumeStatusDeleted:   {},
+		block.VolumeStatusAvailable: {},
+		block.VolumeStatusInUse:     {},
+	}
+	referenceTerminalStatus := map[block.ReferenceStatus]struct{}{
+		block.ReferenceStatusError:    {},
+		block.ReferenceStatusAttached: {},
+		block.ReferenceStatusDetached: {},
 	}
 
-	volume, err := blockAPI.WaitForVolumeAndReferences(&block.WaitForVolumeAndReferencesRequest{
-		Zone:          zone,
-		VolumeID:      id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	volume, err := transport.Poll(ctx, defaultBlockRetryInterval, timeout, func(ctx context.Context) (*block.Volume, bool, error) {
+		volume, err := blockAPI.GetVolume(&block.GetVolumeRequest{
+			Zone:     zone,
+			VolumeID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		for _, reference := range volume.References {
+			if _, isTerminal := referenceTerminalStatus[reference.Status]; !isTerminal {
+				return volume, false, nil
+			}
+		}
+		_, isTerminal := terminalStatus[volume.Status]
+		return volume, isTerminal, nil
+	})
 
 	return volume, err
 }
 
-func waitForBlockSnapshot(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (*block.Snapshot, error) {
-	retryInterval := defaultBlockRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
-
-	snapshot, err := blockAPI.WaitForSnapshot(&block.WaitForSnapshotRequest{
-		Zone:          zone,
-		SnapshotID:    id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+func waitForBlockSnapshot(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (_ *block.Snapshot, err error) {
+	ctx, span := tracing.Start(ctx, "waitForBlockSnapshot",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
 
-	return snapshot, err
+	return pollBlockSnapshot(ctx, blockAPI, zone, id, timeout, map[block.SnapshotStatus]struct{}{
+		block.SnapshotStatusError:     {},
+		block.SnapshotStatusLocked:    {},
+		block.SnapshotStatusDeleted:   {},
+		block.SnapshotStatusAvailable: {},
+		block.SnapshotStatusInUse:     {},
+	})
 }
 
-func waitForBlockSnapshotToBeAvailable(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (*block.Snapshot, error) {
-	retryInterval := defaultBlockRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
-
-	terminalStatus := block.SnapshotStatusAvailable
-	snapshot, err := blockAPI.WaitForSnapshot(&block.WaitForSnapshotRequest{
-		Zone:          zone,
-		SnapshotID:    id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
+func waitForBlockSnapshotToBeAvailable(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration) (_ *block.Snapshot, err error) {
+	ctx, span := tracing.Start(ctx, "waitForBlockSnapshotToBeAvailable",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
 
-		TerminalStatus: &terminalStatus,
-	}, scw.WithContext(ctx))
+	return pollBlockSnapshot(ctx, blockAPI, zone, id, timeout, map[block.SnapshotStatus]struct{}{
+		block.SnapshotStatusError:     {},
+		block.SnapshotStatusLocked:    {},
+		block.SnapshotStatusDeleted:   {},
+		block.SnapshotStatusAvailable: {},
+	})
+}
 
-	return snapshot, err
+func pollBlockSnapshot(ctx context.Context, blockAPI *block.API, zone scw.Zone, id string, timeout time.Duration, terminalStatus map[block.SnapshotStatus]struct{}) (*block.Snapshot, error) {
+	return transport.Poll(ctx, defaultBlockRetryInterval, timeout, func(ctx context.Context) (*block.Snapshot, bool, error) {
+		snapshot, err := blockAPI.GetSnapshot(&block.GetSnapshotRequest{
+			Zone:       zone,
+			SnapshotID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[snapshot.Status]
+		return snapshot, isTerminal, nil
+	})
 }
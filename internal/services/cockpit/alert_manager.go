@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/cockpit/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -60,7 +61,7 @@ func ResourceCockpitAlertManager() *schema.Resource {
 func ResourceCockpitAlertManagerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := cockpitAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID := d.Get("project_id").(string)
@@ -72,7 +73,7 @@ func ResourceCockpitAlertManagerCreate(ctx context.Context, d *schema.ResourceDa
 		ProjectID: projectID,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	if EnableManagedAlerts {
 		_, err = api.EnableManagedAlerts(&cockpit.RegionalAPIEnableManagedAlertsRequest{
@@ -80,7 +81,7 @@ func ResourceCockpitAlertManagerCreate(ctx context.Context, d *schema.ResourceDa
 			ProjectID: projectID,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -106,7 +107,7 @@ func ResourceCockpitAlertManagerCreate(ctx context.Context, d *schema.ResourceDa
 				Region:    region,
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -118,7 +119,7 @@ func ResourceCockpitAlertManagerCreate(ctx context.Context, d *schema.ResourceDa
 func ResourceCockpitAlertManagerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := cockpitAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID := d.Get("project_id").(string)
@@ -128,7 +129,7 @@ func ResourceCockpitAlertManagerRead(ctx context.Context, d *schema.ResourceData
 		ProjectID: projectID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("enable_managed_alerts", alertManager.ManagedAlertsEnabled)
@@ -140,7 +141,7 @@ func ResourceCockpitAlertManagerRead(ctx context.Context, d *schema.ResourceData
 		ProjectID: projectID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var contactPointsList []map[string]interface{}
@@ -159,7 +160,7 @@ func ResourceCockpitAlertManagerRead(ctx context.Context, d *schema.ResourceData
 func ResourceCockpitAlertManagerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := cockpitAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	projectID := d.Get("project_id").(string)
 
@@ -177,7 +178,7 @@ func ResourceCockpitAlertManagerUpdate(ctx context.Context, d *schema.ResourceDa
 			}, scw.WithContext(ctx))
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 	if d.HasChange("contact_points") {
@@ -206,7 +207,7 @@ func ResourceCockpitAlertManagerUpdate(ctx context.Context, d *schema.ResourceDa
 					Email:     &cockpit.ContactPointEmail{To: email},
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 			}
 		}
@@ -220,7 +221,7 @@ func ResourceCockpitAlertManagerUpdate(ctx context.Context, d *schema.ResourceDa
 					Email:     contactPointEmail,
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 			}
 		}
@@ -232,7 +233,7 @@ func ResourceCockpitAlertManagerUpdate(ctx context.Context, d *schema.ResourceDa
 func ResourceCockpitAlertManagerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := cockpitAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID := d.Get("project_id").(string)
@@ -242,7 +243,7 @@ func ResourceCockpitAlertManagerDelete(ctx context.Context, d *schema.ResourceDa
 		ProjectID: projectID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	for _, cp := range contactPoints.ContactPoints {
@@ -253,7 +254,7 @@ func ResourceCockpitAlertManagerDelete(ctx context.Context, d *schema.ResourceDa
 				Email:     &cockpit.ContactPointEmail{To: cp.Email.To},
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -263,14 +264,14 @@ func ResourceCockpitAlertManagerDelete(ctx context.Context, d *schema.ResourceDa
 		ProjectID: projectID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_, err = api.DisableAlertManager(&cockpit.RegionalAPIDisableAlertManagerRequest{
 		Region:    region,
 		ProjectID: projectID,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId("")
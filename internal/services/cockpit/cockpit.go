@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/cockpit/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 )
 
@@ -95,7 +96,7 @@ func ResourceCockpit() *schema.Resource {
 func ResourceCockpitCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, err := NewGlobalAPI(m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID := d.Get("project_id").(string)
@@ -105,7 +106,7 @@ func ResourceCockpitCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 		plans, err := api.ListPlans(&cockpit.GlobalAPIListPlansRequest{}, scw.WithContext(ctx), scw.WithAllPages()) //nolint:staticcheck
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		var planName string
@@ -125,7 +126,7 @@ func ResourceCockpitCreate(ctx context.Context, d *schema.ResourceData, m interf
 			PlanName:  cockpit.PlanName(planName),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -135,26 +136,26 @@ func ResourceCockpitCreate(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceCockpitRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, err := NewGlobalAPI(m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	regionalAPI, region, err := cockpitAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID := d.Get("project_id").(string)
 	if projectID == "" {
 		projectID, err = getDefaultProjectID(ctx, m)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 	res, err := api.GetCurrentPlan(&cockpit.GlobalAPIGetCurrentPlanRequest{ //nolint:staticcheck
 		ProjectID: projectID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("plan", res.Name.String())
 	_ = d.Set("plan_id", res.Name.String())
@@ -165,7 +166,7 @@ func ResourceCockpitRead(ctx context.Context, d *schema.ResourceData, m interfac
 		Origin:    "external",
 	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("project_id", projectID)
 	d.SetId(projectID)
@@ -174,7 +175,7 @@ func ResourceCockpitRead(ctx context.Context, d *schema.ResourceData, m interfac
 		ProjectID: projectID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	if grafana.GrafanaURL == "" {
 		grafana.GrafanaURL = createGrafanaURL(projectID, region)
@@ -184,7 +185,7 @@ func ResourceCockpitRead(ctx context.Context, d *schema.ResourceData, m interfac
 		ProjectID: projectID,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	alertManagerURL := ""
 	if alertManager.AlertManagerURL != nil {
@@ -202,7 +203,7 @@ func ResourceCockpitRead(ctx context.Context, d *schema.ResourceData, m interfac
 func ResourceCockpitUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, err := NewGlobalAPI(m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID := d.Id()
@@ -215,7 +216,7 @@ func ResourceCockpitUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 		plans, err := api.ListPlans(&cockpit.GlobalAPIListPlansRequest{}, scw.WithContext(ctx), scw.WithAllPages()) //nolint:staticcheck
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		var planName string
@@ -235,7 +236,7 @@ func ResourceCockpitUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			PlanName:  cockpit.PlanName(planName),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -38,18 +38,18 @@ func DataSourceCockpit() *schema.Resource {
 func dataSourceCockpitRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, err := NewGlobalAPI(m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	regionalAPI, region, err := cockpitAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID := d.Get("project_id").(string)
 	if projectID == "" {
 		projectID, err = getDefaultProjectID(ctx, m)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -61,7 +61,7 @@ func dataSourceCockpitRead(ctx context.Context, d *schema.ResourceData, m interf
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("project_id", d.Get("project_id").(string))
 	_ = d.Set("plan", res.Name)
@@ -73,14 +73,14 @@ func dataSourceCockpitRead(ctx context.Context, d *schema.ResourceData, m interf
 		Origin:    "external",
 	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	grafana, err := api.GetGrafana(&cockpit.GlobalAPIGetGrafanaRequest{
 		ProjectID: projectID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	if grafana.GrafanaURL == "" {
 		grafana.GrafanaURL = createGrafanaURL(projectID, region)
@@ -90,7 +90,7 @@ func dataSourceCockpitRead(ctx context.Context, d *schema.ResourceData, m interf
 		ProjectID: projectID,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	alertManagerURL := ""
 	if alertManager.AlertManagerURL != nil {
@@ -90,7 +90,7 @@ func ResourceCockpitSource() *schema.Resource {
 func ResourceCockpitSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := cockpitAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	retentionDays := uint32(d.Get("retention_days").(int))
@@ -102,7 +102,7 @@ func ResourceCockpitSourceCreate(ctx context.Context, d *schema.ResourceData, me
 		RetentionDays: &retentionDays,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, res.ID))
@@ -112,7 +112,7 @@ func ResourceCockpitSourceCreate(ctx context.Context, d *schema.ResourceData, me
 func ResourceCockpitSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := api.GetDataSource(&cockpit.RegionalAPIGetDataSourceRequest{
@@ -124,12 +124,12 @@ func ResourceCockpitSourceRead(ctx context.Context, d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	pushURL, err := createCockpitPushURL(res.Type, res.URL)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", res.Name)
@@ -150,7 +150,7 @@ func ResourceCockpitSourceRead(ctx context.Context, d *schema.ResourceData, meta
 func ResourceCockpitSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	updateRequest := &cockpit.RegionalAPIUpdateDataSourceRequest{
@@ -171,7 +171,7 @@ func ResourceCockpitSourceUpdate(ctx context.Context, d *schema.ResourceData, me
 	if d.HasChanges("retention_days", "name") {
 		_, err = api.UpdateDataSource(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -181,7 +181,7 @@ func ResourceCockpitSourceUpdate(ctx context.Context, d *schema.ResourceData, me
 func ResourceCockpitSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteDataSource(&cockpit.RegionalAPIDeleteDataSourceRequest{
@@ -189,7 +189,7 @@ func ResourceCockpitSourceDelete(ctx context.Context, d *schema.ResourceData, me
 		Region:       region,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/cockpit/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -93,7 +94,7 @@ func fetchDataSourceByID(ctx context.Context, d *schema.ResourceData, meta inter
 	regionalID := d.Get("id").(string)
 	api, region, id, err := NewAPIWithRegionAndID(meta, regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	d.SetId(id)
 	res, err := api.GetDataSource(&cockpit.RegionalAPIGetDataSourceRequest{
@@ -101,7 +102,7 @@ func fetchDataSourceByID(ctx context.Context, d *schema.ResourceData, meta inter
 		DataSourceID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	flattenDataSource(d, res)
 	return nil
@@ -110,7 +111,7 @@ func fetchDataSourceByID(ctx context.Context, d *schema.ResourceData, meta inter
 func fetchDataSourceByFilters(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := cockpitAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &cockpit.RegionalAPIListDataSourcesRequest{
@@ -127,7 +128,7 @@ func fetchDataSourceByFilters(ctx context.Context, d *schema.ResourceData, meta
 
 	res, err := api.ListDataSources(req, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if res.TotalCount == 0 {
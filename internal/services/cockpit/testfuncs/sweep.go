@@ -2,6 +2,7 @@ package cockpittestfuncs
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,19 +14,19 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_cockpit", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_cockpit", &resource.Sweeper{
 		Name: "scaleway_cockpit",
 		F:    testSweepCockpit,
 	})
-	resource.AddTestSweepers("scaleway_cockpit_grafana_user", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_cockpit_grafana_user", &resource.Sweeper{
 		Name: "scaleway_cockpit_grafana_user",
 		F:    testSweepCockpitGrafanaUser,
 	})
-	resource.AddTestSweepers("scaleway_cockpit_token", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_cockpit_token", &resource.Sweeper{
 		Name: "scaleway_cockpit_token",
 		F:    testSweepCockpitToken,
 	})
-	resource.AddTestSweepers("scaleway_cockpit_source", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_cockpit_source", &resource.Sweeper{
 		Name: "scaleway_cockpit_source",
 		F:    testSweepCockpitSource,
 	})
@@ -58,8 +59,17 @@ func testSweepCockpitToken(_ string) error {
 			}
 
 			for _, token := range listTokens.Tokens {
-				err = cockpitAPI.DeleteToken(&cockpit.RegionalAPIDeleteTokenRequest{
-					TokenID: token.ID,
+				// Resources of the test projects are swept whatever their name
+				err = acctest.SweepResource(acctest.SweptResource{
+					Type:         "scaleway_cockpit_token",
+					Locality:     token.Region.String(),
+					ID:           token.ID,
+					SweepUnnamed: true,
+					CreatedAt:    token.CreatedAt,
+				}, func() error {
+					return cockpitAPI.DeleteToken(&cockpit.RegionalAPIDeleteTokenRequest{
+						TokenID: token.ID,
+					})
 				})
 				if err != nil {
 					if !httperrors.Is404(err) {
@@ -100,9 +110,16 @@ func testSweepCockpitGrafanaUser(_ string) error {
 			}
 
 			for _, grafanaUser := range listGrafanaUsers.GrafanaUsers {
-				err = cockpitAPI.DeleteGrafanaUser(&cockpit.GlobalAPIDeleteGrafanaUserRequest{
-					ProjectID:     project.ID,
-					GrafanaUserID: grafanaUser.ID,
+				err = acctest.SweepResource(acctest.SweptResource{
+					Type:         "scaleway_cockpit_grafana_user",
+					Locality:     "global",
+					ID:           strconv.FormatUint(uint64(grafanaUser.ID), 10),
+					SweepUnnamed: true,
+				}, func() error {
+					return cockpitAPI.DeleteGrafanaUser(&cockpit.GlobalAPIDeleteGrafanaUserRequest{
+						ProjectID:     project.ID,
+						GrafanaUserID: grafanaUser.ID,
+					})
 				})
 				if err != nil {
 					if !httperrors.Is404(err) {
@@ -175,9 +192,17 @@ func testSweepCockpitSource(_ string) error {
 			}
 
 			for _, datsource := range listDatasources.DataSources {
-				err = cockpitAPI.DeleteDataSource(&cockpit.RegionalAPIDeleteDataSourceRequest{
-					DataSourceID: datsource.ID,
-					Region:       region,
+				err = acctest.SweepResource(acctest.SweptResource{
+					Type:         "scaleway_cockpit_source",
+					Locality:     region.String(),
+					ID:           datsource.ID,
+					SweepUnnamed: true,
+					CreatedAt:    datsource.CreatedAt,
+				}, func() error {
+					return cockpitAPI.DeleteDataSource(&cockpit.RegionalAPIDeleteDataSourceRequest{
+						DataSourceID: datsource.ID,
+						Region:       region,
+					})
 				})
 				if err != nil {
 					if !httperrors.Is404(err) {
@@ -143,7 +143,7 @@ func resourceCockpitTokenScopes() *schema.Resource {
 func ResourceCockpitTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := cockpitAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID := d.Get("project_id").(string)
@@ -173,7 +173,7 @@ func ResourceCockpitTokenCreate(ctx context.Context, d *schema.ResourceData, m i
 		Region:      region,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("secret_key", res.SecretKey)
@@ -184,7 +184,7 @@ func ResourceCockpitTokenCreate(ctx context.Context, d *schema.ResourceData, m i
 func ResourceCockpitTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := api.GetToken(&cockpit.RegionalAPIGetTokenRequest{
@@ -196,7 +196,7 @@ func ResourceCockpitTokenRead(ctx context.Context, d *schema.ResourceData, m int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", res.Name)
@@ -212,7 +212,7 @@ func ResourceCockpitTokenRead(ctx context.Context, d *schema.ResourceData, m int
 func ResourceCockpitTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteToken(&cockpit.RegionalAPIDeleteTokenRequest{
@@ -220,7 +220,7 @@ func ResourceCockpitTokenDelete(ctx context.Context, d *schema.ResourceData, m i
 		TokenID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -195,7 +195,7 @@ func ResourceContainer() *schema.Resource {
 func ResourceContainerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	namespaceID := locality.ExpandID(d.Get("namespace_id").(string))
@@ -207,7 +207,7 @@ func ResourceContainerCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	req, err := setCreateContainerRequest(d, region)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := api.CreateContainer(req, scw.WithContext(ctx))
@@ -230,7 +230,7 @@ func ResourceContainerCreate(ctx context.Context, d *schema.ResourceData, m inte
 		}
 		_, err = api.UpdateContainer(reqUpdate, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitForContainer(ctx, api, res.ID, region, d.Timeout(schema.TimeoutCreate))
@@ -247,7 +247,7 @@ func ResourceContainerCreate(ctx context.Context, d *schema.ResourceData, m inte
 func ResourceContainerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, containerID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	co, err := waitForContainer(ctx, api, containerID, region, d.Timeout(schema.TimeoutCreate))
@@ -288,7 +288,7 @@ func ResourceContainerRead(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceContainerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, containerID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	namespaceID := d.Get("namespace_id")
@@ -382,12 +382,12 @@ func ResourceContainerUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	con, err := api.UpdateContainer(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForContainer(ctx, api, con.ID, region, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceContainerRead(ctx, d, m)
@@ -396,13 +396,13 @@ func ResourceContainerUpdate(ctx context.Context, d *schema.ResourceData, m inte
 func ResourceContainerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, containerID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// check for container state
 	_, err = waitForContainer(ctx, api, containerID, region, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// delete container
@@ -411,7 +411,7 @@ func ResourceContainerDelete(ctx context.Context, d *schema.ResourceData, m inte
 		ContainerID: containerID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -69,12 +69,12 @@ func ResourceCron() *schema.Resource {
 func ResourceContainerCronCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	jsonObj, err := scw.DecodeJSONObject(d.Get("args").(string), scw.NoEscape)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	containerID := locality.ExpandID(d.Get("container_id").(string))
@@ -89,13 +89,13 @@ func ResourceContainerCronCreate(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := api.CreateCron(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("[INFO] Submitted new cron job: %#v", res.Schedule))
 	_, err = waitForCron(ctx, api, res.ID, region, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	tflog.Info(ctx, "[INFO] cron job ready")
 
@@ -107,7 +107,7 @@ func ResourceContainerCronCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceContainerCronRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, containerCronID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	cron, err := waitForCron(ctx, api, containerCronID, region, d.Timeout(schema.TimeoutRead))
@@ -116,12 +116,12 @@ func ResourceContainerCronRead(ctx context.Context, d *schema.ResourceData, m in
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	args, err := scw.EncodeJSONObject(*cron.Args, scw.NoEscape)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("container_id", regional.NewID(region, cron.ContainerID).String())
@@ -136,7 +136,7 @@ func ResourceContainerCronRead(ctx context.Context, d *schema.ResourceData, m in
 func ResourceContainerCronUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, containerCronID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &container.UpdateCronRequest{
@@ -154,7 +154,7 @@ func ResourceContainerCronUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChange("args") {
 		jsonObj, err := scw.DecodeJSONObject(d.Get("args").(string), scw.NoEscape)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		shouldUpdate = true
 		req.Args = &jsonObj
@@ -168,13 +168,13 @@ func ResourceContainerCronUpdate(ctx context.Context, d *schema.ResourceData, m
 	if shouldUpdate {
 		cron, err := api.UpdateCron(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		tflog.Info(ctx, fmt.Sprintf("[INFO] Updated cron job: %#v", req.Schedule))
 		_, err = waitForCron(ctx, api, cron.ID, region, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 	tflog.Info(ctx, "[INFO] cron job ready")
@@ -185,12 +185,12 @@ func ResourceContainerCronUpdate(ctx context.Context, d *schema.ResourceData, m
 func ResourceContainerCronDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, containerCronID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForCron(ctx, api, containerCronID, region, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteCron(&container.DeleteCronRequest{
@@ -198,7 +198,7 @@ func ResourceContainerCronDelete(ctx context.Context, d *schema.ResourceData, m
 		CronID: containerCronID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	tflog.Info(ctx, "[INFO] cron job deleted")
 	return nil
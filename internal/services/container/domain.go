@@ -60,7 +60,7 @@ func ResourceDomain() *schema.Resource {
 func ResourceContainerDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	hostname := d.Get("hostname").(string)
@@ -68,7 +68,7 @@ func ResourceContainerDomainCreate(ctx context.Context, d *schema.ResourceData,
 
 	_, err = waitForContainer(ctx, api, containerID, region, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &container.CreateDomainRequest{
@@ -79,12 +79,12 @@ func ResourceContainerDomainCreate(ctx context.Context, d *schema.ResourceData,
 
 	domain, err := retryCreateContainerDomain(ctx, api, req, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForDomain(ctx, api, domain.ID, region, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, domain.ID))
@@ -95,7 +95,7 @@ func ResourceContainerDomainCreate(ctx context.Context, d *schema.ResourceData,
 func ResourceContainerDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, domainID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	domain, err := waitForDomain(ctx, api, domainID, region, d.Timeout(schema.TimeoutCreate))
@@ -104,7 +104,7 @@ func ResourceContainerDomainRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("hostname", domain.Hostname)
@@ -118,7 +118,7 @@ func ResourceContainerDomainRead(ctx context.Context, d *schema.ResourceData, m
 func ResourceContainerDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, domainID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForDomain(ctx, api, domainID, region, d.Timeout(schema.TimeoutUpdate))
@@ -127,7 +127,7 @@ func ResourceContainerDomainDelete(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteDomain(&container.DeleteDomainRequest{
@@ -135,7 +135,7 @@ func ResourceContainerDomainDelete(ctx context.Context, d *schema.ResourceData,
 		DomainID: domainID,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -15,17 +15,22 @@ import (
 const defaultTriggerTimeout = 15 * time.Minute
 
 func waitForContainerTrigger(ctx context.Context, containerAPI *container.API, region scw.Region, id string, timeout time.Duration) (*container.Trigger, error) {
-	retryInterval := defaultTriggerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	terminalStatus := map[container.TriggerStatus]struct{}{
+		container.TriggerStatusError: {},
+		container.TriggerStatusReady: {},
 	}
 
-	trigger, err := containerAPI.WaitForTrigger(&container.WaitForTriggerRequest{
-		Region:        region,
-		TriggerID:     id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	trigger, err := transport.Poll(ctx, defaultTriggerRetryInterval, timeout, func(ctx context.Context) (*container.Trigger, bool, error) {
+		res, err := containerAPI.GetTrigger(&container.GetTriggerRequest{
+			TriggerID: id,
+			Region:    region,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return trigger, err
 }
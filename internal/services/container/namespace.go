@@ -102,7 +102,7 @@ func ResourceNamespace() *schema.Resource {
 func ResourceContainerNamespaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	createReq := &container.CreateNamespaceRequest{
@@ -121,14 +121,14 @@ func ResourceContainerNamespaceCreate(ctx context.Context, d *schema.ResourceDat
 
 	ns, err := api.CreateNamespace(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, ns.ID))
 
 	_, err = waitForNamespace(ctx, api, region, ns.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceContainerNamespaceRead(ctx, d, m)
@@ -137,7 +137,7 @@ func ResourceContainerNamespaceCreate(ctx context.Context, d *schema.ResourceDat
 func ResourceContainerNamespaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ns, err := waitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -146,7 +146,7 @@ func ResourceContainerNamespaceRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("description", types.FlattenStringPtr(ns.Description))
@@ -165,12 +165,12 @@ func ResourceContainerNamespaceRead(ctx context.Context, d *schema.ResourceData,
 func ResourceContainerNamespaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ns, err := waitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &container.UpdateNamespaceRequest{
@@ -182,7 +182,7 @@ func ResourceContainerNamespaceUpdate(ctx context.Context, d *schema.ResourceDat
 		req.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 
@@ -195,7 +195,7 @@ func ResourceContainerNamespaceUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if _, err := api.UpdateNamespace(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceContainerNamespaceRead(ctx, d, m)
@@ -204,7 +204,7 @@ func ResourceContainerNamespaceUpdate(ctx context.Context, d *schema.ResourceDat
 func ResourceContainerNamespaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -213,7 +213,7 @@ func ResourceContainerNamespaceDelete(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteNamespace(&container.DeleteNamespaceRequest{
@@ -221,12 +221,12 @@ func ResourceContainerNamespaceDelete(ctx context.Context, d *schema.ResourceDat
 		NamespaceID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId("")
@@ -234,7 +234,7 @@ func ResourceContainerNamespaceDelete(ctx context.Context, d *schema.ResourceDat
 	if destroy := d.Get("destroy_registry"); destroy != nil && destroy == true {
 		registryAPI, region, err := registry.NewAPIWithRegion(d, m)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		registryID := d.Get("registry_namespace_id").(string)
@@ -244,11 +244,11 @@ func ResourceContainerNamespaceDelete(ctx context.Context, d *schema.ResourceDat
 			NamespaceID: registryID,
 		})
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		_, err = registry.WaitForNamespace(ctx, registryAPI, region, registryID, d.Timeout(schema.TimeoutDelete))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
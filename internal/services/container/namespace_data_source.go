@@ -8,6 +8,7 @@ import (
 	container "github.com/scaleway/scaleway-sdk-go/api/container/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -36,7 +37,7 @@ func DataSourceNamespace() *schema.Resource {
 func DataSourceContainerNamespaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	namespaceID, ok := d.GetOk("namespace_id")
@@ -48,7 +49,7 @@ func DataSourceContainerNamespaceRead(ctx context.Context, d *schema.ResourceDat
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundNamespace, err := datasource.FindExact(
@@ -57,7 +58,7 @@ func DataSourceContainerNamespaceRead(ctx context.Context, d *schema.ResourceDat
 			namespaceName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		namespaceID = foundNamespace.ID
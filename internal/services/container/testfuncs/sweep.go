@@ -11,16 +11,16 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_container_namespace", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_container_namespace", &resource.Sweeper{
 		Name:         "scaleway_container_namespace",
 		F:            testSweepNamespace,
 		Dependencies: []string{"scaleway_container"},
 	})
-	resource.AddTestSweepers("scaleway_container", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_container", &resource.Sweeper{
 		Name: "scaleway_container",
 		F:    testSweepContainer,
 	})
-	resource.AddTestSweepers("scaleway_container_trigger", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_container_trigger", &resource.Sweeper{
 		Name: "scaleway_container_trigger",
 		F:    testSweepTrigger,
 	})
@@ -39,9 +39,17 @@ func testSweepTrigger(_ string) error {
 		}
 
 		for _, trigger := range listTriggers.Triggers {
-			_, err := containerAPI.DeleteTrigger(&containerSDK.DeleteTriggerRequest{
-				TriggerID: trigger.ID,
-				Region:    region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:     "scaleway_container_trigger",
+				Locality: region.String(),
+				ID:       trigger.ID,
+				Name:     trigger.Name,
+			}, func() error {
+				_, err := containerAPI.DeleteTrigger(&containerSDK.DeleteTriggerRequest{
+					TriggerID: trigger.ID,
+					Region:    region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -67,9 +75,18 @@ func testSweepContainer(_ string) error {
 		}
 
 		for _, cont := range listNamespaces.Containers {
-			_, err := containerAPI.DeleteContainer(&containerSDK.DeleteContainerRequest{
-				ContainerID: cont.ID,
-				Region:      region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_container",
+				Locality:  region.String(),
+				ID:        cont.ID,
+				Name:      cont.Name,
+				CreatedAt: cont.CreatedAt,
+			}, func() error {
+				_, err := containerAPI.DeleteContainer(&containerSDK.DeleteContainerRequest{
+					ContainerID: cont.ID,
+					Region:      region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -95,9 +112,18 @@ func testSweepNamespace(_ string) error {
 		}
 
 		for _, ns := range listNamespaces.Namespaces {
-			_, err := containerAPI.DeleteNamespace(&containerSDK.DeleteNamespaceRequest{
-				NamespaceID: ns.ID,
-				Region:      region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_container_namespace",
+				Locality:  region.String(),
+				ID:        ns.ID,
+				Name:      ns.Name,
+				CreatedAt: ns.CreatedAt,
+			}, func() error {
+				_, err := containerAPI.DeleteNamespace(&containerSDK.DeleteNamespaceRequest{
+					NamespaceID: ns.ID,
+					Region:      region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -67,7 +67,7 @@ func ResourceToken() *schema.Resource {
 func ResourceContainerTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	token, err := api.CreateToken(&container.CreateTokenRequest{
@@ -78,7 +78,7 @@ func ResourceContainerTokenCreate(ctx context.Context, d *schema.ResourceData, m
 		ExpiresAt:   types.ExpandTimePtr(d.Get("expires_at")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, token.ID))
@@ -91,7 +91,7 @@ func ResourceContainerTokenCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceContainerTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	token, err := api.GetToken(&container.GetTokenRequest{
 		Region:  region,
@@ -102,7 +102,7 @@ func ResourceContainerTokenRead(ctx context.Context, d *schema.ResourceData, m i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("container_id", types.FlattenStringPtr(token.ContainerID))
@@ -116,7 +116,7 @@ func ResourceContainerTokenRead(ctx context.Context, d *schema.ResourceData, m i
 func ResourceContainerTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteToken(&container.DeleteTokenRequest{
@@ -124,7 +124,7 @@ func ResourceContainerTokenDelete(ctx context.Context, d *schema.ResourceData, m
 		TokenID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId("")
@@ -139,7 +139,7 @@ func ResourceTrigger() *schema.Resource {
 func ResourceContainerTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &container.CreateTriggerRequest{
@@ -152,7 +152,7 @@ func ResourceContainerTriggerCreate(ctx context.Context, d *schema.ResourceData,
 	if scwSqs, isScwSqs := d.GetOk("sqs.0"); isScwSqs {
 		err := completeContainerTriggerMnqCreationConfig(scwSqs, d, m, region)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to complete sqs config: %w", err))
+			return httperrors.Diagnostics(fmt.Errorf("failed to complete sqs config: %w", err))
 		}
 
 		_ = d.Set("sqs", []any{scwSqs})
@@ -162,7 +162,7 @@ func ResourceContainerTriggerCreate(ctx context.Context, d *schema.ResourceData,
 	if scwNats, isScwNats := d.GetOk("nats.0"); isScwNats {
 		err := completeContainerTriggerMnqCreationConfig(scwNats, d, m, region)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to complete nats config: %w", err))
+			return httperrors.Diagnostics(fmt.Errorf("failed to complete nats config: %w", err))
 		}
 
 		_ = d.Set("nats", []any{scwNats})
@@ -171,14 +171,14 @@ func ResourceContainerTriggerCreate(ctx context.Context, d *schema.ResourceData,
 
 	trigger, err := api.CreateTrigger(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, trigger.ID))
 
 	_, err = waitForContainerTrigger(ctx, api, region, trigger.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceContainerTriggerRead(ctx, d, m)
@@ -187,7 +187,7 @@ func ResourceContainerTriggerCreate(ctx context.Context, d *schema.ResourceData,
 func ResourceContainerTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	trigger, err := waitForContainerTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -196,7 +196,7 @@ func ResourceContainerTriggerRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", trigger.Name)
@@ -222,7 +222,7 @@ func ResourceContainerTriggerRead(ctx context.Context, d *schema.ResourceData, m
 func ResourceContainerTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	trigger, err := waitForContainerTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -231,7 +231,7 @@ func ResourceContainerTriggerUpdate(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &container.UpdateTriggerRequest{
@@ -248,7 +248,7 @@ func ResourceContainerTriggerUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if _, err := api.UpdateTrigger(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceContainerTriggerRead(ctx, d, m)
@@ -257,12 +257,12 @@ func ResourceContainerTriggerUpdate(ctx context.Context, d *schema.ResourceData,
 func ResourceContainerTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForContainerTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteTrigger(&container.DeleteTriggerRequest{
@@ -270,12 +270,12 @@ func ResourceContainerTriggerDelete(ctx context.Context, d *schema.ResourceData,
 		TriggerID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForContainerTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
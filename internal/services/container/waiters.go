@@ -6,69 +6,112 @@ import (
 
 	container "github.com/scaleway/scaleway-sdk-go/api/container/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitForNamespace(ctx context.Context, containerAPI *container.API, region scw.Region, namespaceID string, timeout time.Duration) (*container.Namespace, error) {
-	retryInterval := DefaultContainerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForNamespace(ctx context.Context, containerAPI *container.API, region scw.Region, namespaceID string, timeout time.Duration) (_ *container.Namespace, err error) {
+	ctx, span := tracing.Start(ctx, "waitForNamespace",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(namespaceID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[container.NamespaceStatus]struct{}{
+		container.NamespaceStatusError:  {},
+		container.NamespaceStatusReady:  {},
+		container.NamespaceStatusLocked: {},
 	}
 
-	ns, err := containerAPI.WaitForNamespace(&container.WaitForNamespaceRequest{
-		Region:        region,
-		NamespaceID:   namespaceID,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	ns, err := transport.Poll(ctx, DefaultContainerRetryInterval, timeout, func(ctx context.Context) (*container.Namespace, bool, error) {
+		res, err := containerAPI.GetNamespace(&container.GetNamespaceRequest{
+			NamespaceID: namespaceID,
+			Region:      region,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return ns, err
 }
 
-func waitForCron(ctx context.Context, api *container.API, cronID string, region scw.Region, timeout time.Duration) (*container.Cron, error) {
-	retryInterval := DefaultContainerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
+func waitForCron(ctx context.Context, api *container.API, cronID string, region scw.Region, timeout time.Duration) (_ *container.Cron, err error) {
+	ctx, span := tracing.Start(ctx, "waitForCron",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(cronID),
+	)
+	defer func() { tracing.End(span, err) }()
 
-	request := container.WaitForCronRequest{
-		CronID:        cronID,
-		Region:        region,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
+	terminalStatus := map[container.CronStatus]struct{}{
+		container.CronStatusError:  {},
+		container.CronStatusReady:  {},
+		container.CronStatusLocked: {},
 	}
 
-	return api.WaitForCron(&request, scw.WithContext(ctx))
+	return transport.Poll(ctx, DefaultContainerRetryInterval, timeout, func(ctx context.Context) (*container.Cron, bool, error) {
+		res, err := api.GetCron(&container.GetCronRequest{
+			CronID: cronID,
+			Region: region,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
 
-func waitForContainer(ctx context.Context, api *container.API, containerID string, region scw.Region, timeout time.Duration) (*container.Container, error) {
-	retryInterval := DefaultContainerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
+func waitForContainer(ctx context.Context, api *container.API, containerID string, region scw.Region, timeout time.Duration) (_ *container.Container, err error) {
+	ctx, span := tracing.Start(ctx, "waitForContainer",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(containerID),
+	)
+	defer func() { tracing.End(span, err) }()
 
-	request := container.WaitForContainerRequest{
-		ContainerID:   containerID,
-		Region:        region,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
+	terminalStatus := map[container.ContainerStatus]struct{}{
+		container.ContainerStatusError:   {},
+		container.ContainerStatusCreated: {},
+		container.ContainerStatusReady:   {},
+		container.ContainerStatusLocked:  {},
 	}
 
-	return api.WaitForContainer(&request, scw.WithContext(ctx))
+	return transport.Poll(ctx, DefaultContainerRetryInterval, timeout, func(ctx context.Context) (*container.Container, bool, error) {
+		res, err := api.GetContainer(&container.GetContainerRequest{
+			ContainerID: containerID,
+			Region:      region,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
 
-func waitForDomain(ctx context.Context, api *container.API, domainID string, region scw.Region, timeout time.Duration) (*container.Domain, error) {
-	retryInterval := DefaultContainerRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
+func waitForDomain(ctx context.Context, api *container.API, domainID string, region scw.Region, timeout time.Duration) (_ *container.Domain, err error) {
+	ctx, span := tracing.Start(ctx, "waitForDomain",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(domainID),
+	)
+	defer func() { tracing.End(span, err) }()
 
-	request := container.WaitForDomainRequest{
-		DomainID:      domainID,
-		Region:        region,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
+	terminalStatus := map[container.DomainStatus]struct{}{
+		container.DomainStatusError: {},
+		container.DomainStatusReady: {},
 	}
 
-	return api.WaitForDomain(&request, scw.WithContext(ctx))
+	return transport.Poll(ctx, DefaultContainerRetryInterval, timeout, func(ctx context.Context) (*container.Domain, bool, error) {
+		res, err := api.GetDomain(&container.GetDomainRequest{
+			DomainID: domainID,
+			Region:   region,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
@@ -280,12 +280,12 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		ReturnAllRecords: scw.BoolPtr(false),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	record, err = waitForDNSRecordExist(ctx, domainAPI, dnsZone, record.Name, record.Type, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("DNS ZONE domain: %s record: %s, type: %s",
@@ -299,12 +299,12 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		Type:    recordType,
 	}, scw.WithAllPages(), scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	currentRecord, err := getRecordFromTypeAndData(recordType, recordData, dnsZoneData.Records)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	recordID := fmt.Sprintf("%s/%s", dnsZone, currentRecord.ID)
@@ -340,7 +340,7 @@ func resourceDomainRecordRead(ctx context.Context, d *schema.ResourceData, m int
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		if len(res.Records) > 0 {
@@ -370,7 +370,7 @@ func resourceDomainRecordRead(ctx context.Context, d *schema.ResourceData, m int
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		if len(res.Records) > 0 {
@@ -389,7 +389,7 @@ func resourceDomainRecordRead(ctx context.Context, d *schema.ResourceData, m int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// get the default first record
@@ -457,12 +457,12 @@ func resourceDomainRecordUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 	_, err := domainAPI.UpdateDNSZoneRecords(req)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForDNSRecordExist(ctx, domainAPI, d.Get("dns_zone").(string), record.Name, record.Type, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return resourceDomainRecordRead(ctx, d, m)
@@ -484,7 +484,7 @@ func resourceDomainRecordDelete(ctx context.Context, d *schema.ResourceData, m i
 		ReturnAllRecords: scw.BoolPtr(false),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	d.SetId("")
 
@@ -500,7 +500,7 @@ func resourceDomainRecordDelete(ctx context.Context, d *schema.ResourceData, m i
 		if httperrors.Is404(err) || httperrors.Is403(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	for _, r := range res.Records {
@@ -516,7 +516,7 @@ func resourceDomainRecordDelete(ctx context.Context, d *schema.ResourceData, m i
 		if httperrors.Is404(err) || httperrors.Is403(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = domainAPI.DeleteDNSZone(&domain.DeleteDNSZoneRequest{
@@ -527,7 +527,7 @@ func resourceDomainRecordDelete(ctx context.Context, d *schema.ResourceData, m i
 		if httperrors.Is404(err) || httperrors.Is403(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
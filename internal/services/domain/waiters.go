@@ -2,10 +2,12 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
@@ -15,30 +17,47 @@ const (
 	defaultDomainZoneRetryInterval = 5 * time.Second
 )
 
-func waitForDNSZone(ctx context.Context, domainAPI *domain.API, dnsZone string, timeout time.Duration) (*domain.DNSZone, error) {
-	retryInterval := defaultDomainZoneRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForDNSZone(ctx context.Context, domainAPI *domain.API, dnsZone string, timeout time.Duration) (_ *domain.DNSZone, err error) {
+	ctx, span := tracing.Start(ctx, "waitForDNSZone")
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[domain.DNSZoneStatus]struct{}{
+		domain.DNSZoneStatusActive: {},
+		domain.DNSZoneStatusLocked: {},
+		domain.DNSZoneStatusError:  {},
 	}
 
-	return domainAPI.WaitForDNSZone(&domain.WaitForDNSZoneRequest{
-		DNSZone:       dnsZone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: scw.TimeDurationPtr(retryInterval),
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, defaultDomainZoneRetryInterval, timeout, func(ctx context.Context) (*domain.DNSZone, bool, error) {
+		res, err := domainAPI.ListDNSZones(&domain.ListDNSZonesRequest{
+			DNSZone: &dnsZone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		if len(res.DNSZones) == 0 {
+			return nil, false, errors.New(domain.ErrCodeNoSuchDNSZone)
+		}
+		_, isTerminal := terminalStatus[res.DNSZones[0].Status]
+		return res.DNSZones[0], isTerminal, nil
+	})
 }
 
-func waitForDNSRecordExist(ctx context.Context, domainAPI *domain.API, dnsZone, recordName string, recordType domain.RecordType, timeout time.Duration) (*domain.Record, error) {
-	retryInterval := defaultDomainZoneRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
+func waitForDNSRecordExist(ctx context.Context, domainAPI *domain.API, dnsZone, recordName string, recordType domain.RecordType, timeout time.Duration) (_ *domain.Record, err error) {
+	ctx, span := tracing.Start(ctx, "waitForDNSRecordExist")
+	defer func() { tracing.End(span, err) }()
 
-	return domainAPI.WaitForDNSRecordExist(&domain.WaitForDNSRecordExistRequest{
-		DNSZone:       dnsZone,
-		RecordName:    recordName,
-		RecordType:    recordType,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: scw.TimeDurationPtr(retryInterval),
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, defaultDomainZoneRetryInterval, timeout, func(ctx context.Context) (*domain.Record, bool, error) {
+		res, err := domainAPI.ListDNSZoneRecords(&domain.ListDNSZoneRecordsRequest{
+			DNSZone: dnsZone,
+			Name:    recordName,
+			Type:    recordType,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		if res.TotalCount == 0 {
+			return nil, false, errors.New(domain.ErrCodeNoSuchDNSRecord)
+		}
+		return res.Records[0], true, nil
+	})
 }
@@ -95,7 +95,7 @@ func resourceDomainZoneCreate(ctx context.Context, d *schema.ResourceData, m int
 		DNSZones:  []string{zoneName},
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	for i := range zones.DNSZones {
@@ -117,7 +117,7 @@ func resourceDomainZoneCreate(ctx context.Context, d *schema.ResourceData, m int
 		if httperrors.Is409(err) {
 			return resourceDomainZoneRead(ctx, d, m)
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	d.SetId(fmt.Sprintf("%s.%s", dnsZone.Subdomain, dnsZone.Domain))
 
@@ -138,7 +138,7 @@ func resourceDomainZoneRead(ctx context.Context, d *schema.ResourceData, m inter
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if len(zones.DNSZones) == 0 {
@@ -174,7 +174,7 @@ func resourceZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface
 			NewDNSZone: scw.StringPtr(d.Get("subdomain").(string)),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 	return resourceDomainZoneRead(ctx, d, m)
@@ -188,7 +188,7 @@ func resourceZoneDelete(ctx context.Context, d *schema.ResourceData, m interface
 		if httperrors.Is404(err) || httperrors.Is403(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = domainAPI.DeleteDNSZone(&domain.DeleteDNSZoneRequest{
@@ -197,7 +197,7 @@ func resourceZoneDelete(ctx context.Context, d *schema.ResourceData, m interface
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) && !httperrors.Is403(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	flexibleip "github.com/scaleway/scaleway-sdk-go/api/flexibleip/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -47,7 +48,7 @@ func DataSourceFlexibleIP() *schema.Resource {
 func DataSourceFlexibleIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ipID, ipIDExists := d.GetOk("flexible_ip_id")
@@ -58,7 +59,7 @@ func DataSourceFlexibleIPRead(ctx context.Context, d *schema.ResourceData, m int
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		for _, ip := range res.FlexibleIPs {
@@ -78,7 +79,7 @@ func DataSourceFlexibleIPRead(ctx context.Context, d *schema.ResourceData, m int
 	d.SetId(zoneID)
 	err = d.Set("flexible_ip_id", zoneID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := ResourceFlexibleIPRead(ctx, d, m)
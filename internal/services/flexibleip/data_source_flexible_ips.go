@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	flexibleip "github.com/scaleway/scaleway-sdk-go/api/flexibleip/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
@@ -129,7 +130,7 @@ func DataSourceFlexibleIPs() *schema.Resource {
 func DataSourceFlexibleIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := fipAPI.ListFlexibleIPs(&flexibleip.ListFlexibleIPsRequest{
@@ -139,7 +140,7 @@ func DataSourceFlexibleIPsRead(ctx context.Context, d *schema.ResourceData, m in
 		Tags:      types.ExpandStrings(d.Get("tags")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	fips := []interface{}(nil)
@@ -157,7 +158,7 @@ func DataSourceFlexibleIPsRead(ctx context.Context, d *schema.ResourceData, m in
 		rawFip["status"] = fip.Status
 		ip, err := types.FlattenIPNet(fip.IPAddress)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		rawFip["ip_address"] = ip
 		if fip.MacAddress != nil {
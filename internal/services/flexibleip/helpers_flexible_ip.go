@@ -40,15 +40,33 @@ func NewAPIWithZoneAndID(m interface{}, id string) (*flexibleip.API, scw.Zone, s
 }
 
 func waitFlexibleIP(ctx context.Context, api *flexibleip.API, zone scw.Zone, id string, timeout time.Duration) (*flexibleip.FlexibleIP, error) {
-	retryInterval := retryFlexibleIPInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	terminalStatus := map[flexibleip.FlexibleIPStatus]struct{}{
+		flexibleip.FlexibleIPStatusError:    {},
+		flexibleip.FlexibleIPStatusReady:    {},
+		flexibleip.FlexibleIPStatusAttached: {},
+		flexibleip.FlexibleIPStatusLocked:   {},
+	}
+	macAddressTerminalStatus := map[flexibleip.MACAddressStatus]struct{}{
+		flexibleip.MACAddressStatusUnknown: {},
+		flexibleip.MACAddressStatusReady:   {},
+		flexibleip.MACAddressStatusUsed:    {},
+		flexibleip.MACAddressStatusError:   {},
 	}
 
-	return api.WaitForFlexibleIP(&flexibleip.WaitForFlexibleIPRequest{
-		FipID:         id,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, retryFlexibleIPInterval, timeout, func(ctx context.Context) (*flexibleip.FlexibleIP, bool, error) {
+		res, err := api.GetFlexibleIP(&flexibleip.GetFlexibleIPRequest{
+			FipID: id,
+			Zone:  zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		if res.MacAddress != nil {
+			if _, isTerminal := macAddressTerminalStatus[res.MacAddress.Status]; !isTerminal {
+				return res, false, nil
+			}
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
@@ -95,7 +95,7 @@ func ResourceIP() *schema.Resource {
 func ResourceFlexibleIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	flexibleIP, err := fipAPI.CreateFlexibleIP(&flexibleip.CreateFlexibleIPRequest{
@@ -108,14 +108,14 @@ func ResourceFlexibleIPCreate(ctx context.Context, d *schema.ResourceData, m int
 		IsIPv6:      d.Get("is_ipv6").(bool),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, flexibleIP.ID))
 
 	_, err = waitFlexibleIP(ctx, fipAPI, zone, flexibleIP.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	return ResourceFlexibleIPRead(ctx, d, m)
 }
@@ -123,13 +123,13 @@ func ResourceFlexibleIPCreate(ctx context.Context, d *schema.ResourceData, m int
 func ResourceFlexibleIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	fipAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// verify resource is ready
 	_, err = waitFlexibleIP(ctx, fipAPI, zone, ID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	flexibleIP, err := fipAPI.GetFlexibleIP(&flexibleip.GetFlexibleIPRequest{
@@ -142,7 +142,7 @@ func ResourceFlexibleIPRead(ctx context.Context, d *schema.ResourceData, m inter
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("ip_address", flexibleIP.IPAddress.String())
@@ -167,12 +167,12 @@ func ResourceFlexibleIPRead(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceFlexibleIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	fipAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	flexibleIP, err := waitFlexibleIP(ctx, fipAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	updateRequest := &flexibleip.UpdateFlexibleIPRequest{
 		Zone:  zone,
@@ -186,7 +186,7 @@ func ResourceFlexibleIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 		hasChanged = true
 	}
@@ -199,12 +199,12 @@ func ResourceFlexibleIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 	if hasChanged {
 		_, err = fipAPI.UpdateFlexibleIP(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitFlexibleIP(ctx, fipAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -215,7 +215,7 @@ func ResourceFlexibleIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 				FipsIDs: []string{ID},
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		} else {
 			_, err = fipAPI.AttachFlexibleIP(&flexibleip.AttachFlexibleIPRequest{
@@ -224,14 +224,14 @@ func ResourceFlexibleIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 				ServerID: locality.ExpandID(d.Get("server_id")),
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
 
 	_, err = waitFlexibleIP(ctx, fipAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceFlexibleIPRead(ctx, d, m)
@@ -240,12 +240,12 @@ func ResourceFlexibleIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 func ResourceFlexibleIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	fipAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	flexibleIP, err := waitFlexibleIP(ctx, fipAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = fipAPI.DeleteFlexibleIP(&flexibleip.DeleteFlexibleIPRequest{
@@ -254,12 +254,12 @@ func ResourceFlexibleIPDelete(ctx context.Context, d *schema.ResourceData, m int
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) && !httperrors.Is403(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitFlexibleIP(ctx, fipAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) && !httperrors.Is403(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
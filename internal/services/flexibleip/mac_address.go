@@ -85,13 +85,13 @@ func ResourceMACAddress() *schema.Resource {
 func ResourceFlexibleIPMACCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	fipID := locality.ExpandID(d.Get("flexible_ip_id"))
 	_, err = waitFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := fipAPI.GenerateMACAddr(&flexibleip.GenerateMACAddrRequest{
@@ -100,7 +100,7 @@ func ResourceFlexibleIPMACCreate(ctx context.Context, d *schema.ResourceData, m
 		MacType: flexibleip.MACAddressType(d.Get("type").(string)),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if res.MacAddress != nil {
@@ -109,7 +109,7 @@ func ResourceFlexibleIPMACCreate(ctx context.Context, d *schema.ResourceData, m
 
 	fip, err := waitFlexibleIP(ctx, fipAPI, zone, res.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	duplicateIDs, duplicateIDsExist := d.GetOk("flexible_ip_ids_to_duplicate")
@@ -122,11 +122,11 @@ func ResourceFlexibleIPMACCreate(ctx context.Context, d *schema.ResourceData, m
 				DuplicateFromFipID: fip.ID,
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			_, err = waitFlexibleIP(ctx, fipAPI, zone, locality.ExpandID(dupID), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -137,7 +137,7 @@ func ResourceFlexibleIPMACCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceFlexibleIPMACRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	fip, err := fipAPI.GetFlexibleIP(&flexibleip.GetFlexibleIPRequest{
@@ -150,7 +150,7 @@ func ResourceFlexibleIPMACRead(ctx context.Context, d *schema.ResourceData, m in
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("flexible_ip_id", zonal.NewIDString(zone, fip.ID))
@@ -170,12 +170,12 @@ func ResourceFlexibleIPMACRead(ctx context.Context, d *schema.ResourceData, m in
 func ResourceFlexibleIPMACUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	flexibleIP, err := waitFlexibleIP(ctx, fipAPI, zone, locality.ExpandID(d.Get("flexible_ip_id")), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChange("flexible_ip_id") {
@@ -189,12 +189,12 @@ func ResourceFlexibleIPMACUpdate(ctx context.Context, d *schema.ResourceData, m
 			DstFipID: newFipID,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		flexibleIP, err = waitFlexibleIP(ctx, fipAPI, zone, res.ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -208,7 +208,7 @@ func ResourceFlexibleIPMACUpdate(ctx context.Context, d *schema.ResourceData, m
 			if !types.SliceContainsString(oldIDs, newID) {
 				_, err = waitFlexibleIP(ctx, fipAPI, zone, locality.ExpandID(newID), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 				_, err := fipAPI.DuplicateMACAddr(&flexibleip.DuplicateMACAddrRequest{
 					Zone:               zone,
@@ -216,11 +216,11 @@ func ResourceFlexibleIPMACUpdate(ctx context.Context, d *schema.ResourceData, m
 					DuplicateFromFipID: flexibleIP.ID,
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 				_, err = waitFlexibleIP(ctx, fipAPI, zone, locality.ExpandID(newID), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 			}
 		}
@@ -232,11 +232,11 @@ func ResourceFlexibleIPMACUpdate(ctx context.Context, d *schema.ResourceData, m
 					FipID: locality.ExpandID(oldID),
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 				_, err = waitFlexibleIP(ctx, fipAPI, zone, locality.ExpandID(oldID), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 			}
 		}
@@ -244,7 +244,7 @@ func ResourceFlexibleIPMACUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	_, err = waitFlexibleIP(ctx, fipAPI, zone, flexibleIP.ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceFlexibleIPMACRead(ctx, d, m)
@@ -253,12 +253,12 @@ func ResourceFlexibleIPMACUpdate(ctx context.Context, d *schema.ResourceData, m
 func ResourceFlexibleIPMACDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	flexibleIP, err := waitFlexibleIP(ctx, fipAPI, zone, locality.ExpandID(d.Get("flexible_ip_id")), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = fipAPI.DeleteMACAddr(&flexibleip.DeleteMACAddrRequest{
@@ -266,12 +266,12 @@ func ResourceFlexibleIPMACDelete(ctx context.Context, d *schema.ResourceData, m
 		Zone:  zone,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) && !httperrors.Is403(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitFlexibleIP(ctx, fipAPI, zone, locality.ExpandID(d.Get("flexible_ip_id")), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
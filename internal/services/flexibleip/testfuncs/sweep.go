@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_flexible_ip", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_flexible_ip", &resource.Sweeper{
 		Name: "scaleway_flexible_ip",
 		F:    testSweepFlexibleIP,
 	})
@@ -28,9 +28,17 @@ func testSweepFlexibleIP(_ string) error {
 		}
 
 		for _, ip := range listIPs.FlexibleIPs {
-			err := fipAPI.DeleteFlexibleIP(&flexibleipSDK.DeleteFlexibleIPRequest{
-				FipID: ip.ID,
-				Zone:  zone,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:         "scaleway_flexible_ip",
+				Locality:     zone.String(),
+				ID:           ip.ID,
+				SweepUnnamed: true,
+				CreatedAt:    ip.CreatedAt,
+			}, func() error {
+				return fipAPI.DeleteFlexibleIP(&flexibleipSDK.DeleteFlexibleIPRequest{
+					FipID: ip.ID,
+					Zone:  zone,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting ip in sweeper: %s", err)
@@ -69,13 +69,13 @@ func ResourceCron() *schema.Resource {
 func ResourceFunctionCronCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	functionID := locality.ExpandID(d.Get("function_id").(string))
 	f, err := waitForFunction(ctx, api, region, functionID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	request := &function.CreateCronRequest{
@@ -88,19 +88,19 @@ func ResourceFunctionCronCreate(ctx context.Context, d *schema.ResourceData, m i
 	if args, ok := d.GetOk("args"); ok {
 		jsonObj, err := scw.DecodeJSONObject(args.(string), scw.NoEscape)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		request.Args = &jsonObj
 	}
 
 	cron, err := api.CreateCron(request, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForCron(ctx, api, region, cron.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, cron.ID))
@@ -111,7 +111,7 @@ func ResourceFunctionCronCreate(ctx context.Context, d *schema.ResourceData, m i
 func ResourceFunctionCronRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	cron, err := waitForCron(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -120,7 +120,7 @@ func ResourceFunctionCronRead(ctx context.Context, d *schema.ResourceData, m int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("function_id", regional.NewID(region, cron.FunctionID).String())
@@ -128,7 +128,7 @@ func ResourceFunctionCronRead(ctx context.Context, d *schema.ResourceData, m int
 	_ = d.Set("name", cron.Name)
 	args, err := scw.EncodeJSONObject(*cron.Args, scw.NoEscape)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("args", args)
@@ -140,12 +140,12 @@ func ResourceFunctionCronRead(ctx context.Context, d *schema.ResourceData, m int
 func ResourceFunctionCronUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	cron, err := waitForCron(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &function.UpdateCronRequest{
@@ -165,7 +165,7 @@ func ResourceFunctionCronUpdate(ctx context.Context, d *schema.ResourceData, m i
 	if d.HasChange("args") {
 		jsonObj, err := scw.DecodeJSONObject(d.Get("args").(string), scw.NoEscape)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		shouldUpdate = true
 		req.Args = &jsonObj
@@ -174,7 +174,7 @@ func ResourceFunctionCronUpdate(ctx context.Context, d *schema.ResourceData, m i
 	if shouldUpdate {
 		_, err = api.UpdateCron(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -184,7 +184,7 @@ func ResourceFunctionCronUpdate(ctx context.Context, d *schema.ResourceData, m i
 func ResourceFunctionCronDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	cron, err := waitForCron(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -193,7 +193,7 @@ func ResourceFunctionCronDelete(ctx context.Context, d *schema.ResourceData, m i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteCron(&function.DeleteCronRequest{
@@ -202,7 +202,7 @@ func ResourceFunctionCronDelete(ctx context.Context, d *schema.ResourceData, m i
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -34,7 +35,7 @@ func DataSourceFunction() *schema.Resource {
 func DataSourceFunctionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	functionID, ok := d.GetOk("function_id")
@@ -47,7 +48,7 @@ func DataSourceFunctionRead(ctx context.Context, d *schema.ResourceData, m inter
 			ProjectID:   types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundFunction, err := datasource.FindExact(
@@ -56,7 +57,7 @@ func DataSourceFunctionRead(ctx context.Context, d *schema.ResourceData, m inter
 			functionName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		functionID = foundFunction.ID
@@ -59,13 +59,13 @@ func ResourceDomain() *schema.Resource {
 func ResourceFunctionDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	functionID := regional.ExpandID(d.Get("function_id").(string)).ID
 	_, err = waitForFunction(ctx, api, region, functionID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	hostname := d.Get("hostname").(string)
@@ -78,14 +78,14 @@ func ResourceFunctionDomainCreate(ctx context.Context, d *schema.ResourceData, m
 
 	domain, err := retryCreateFunctionDomain(ctx, api, req, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, domain.ID))
 
 	_, err = waitForDomain(ctx, api, region, domain.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceFunctionDomainRead(ctx, d, m)
@@ -94,7 +94,7 @@ func ResourceFunctionDomainCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceFunctionDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	domain, err := waitForDomain(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -103,7 +103,7 @@ func ResourceFunctionDomainRead(ctx context.Context, d *schema.ResourceData, m i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("hostname", domain.Hostname)
@@ -117,7 +117,7 @@ func ResourceFunctionDomainRead(ctx context.Context, d *schema.ResourceData, m i
 func ResourceFunctionDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForDomain(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -134,12 +134,12 @@ func ResourceFunctionDomainDelete(ctx context.Context, d *schema.ResourceData, m
 		Region:   region,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForDomain(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -171,12 +171,12 @@ func ResourceFunction() *schema.Resource {
 func ResourceFunctionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, namespace, err := regional.ParseID(d.Get("namespace_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &function.CreateFunctionRequest{
@@ -202,7 +202,7 @@ func ResourceFunctionCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	f, err := api.CreateFunction(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var diags diag.Diagnostics
@@ -250,7 +250,7 @@ func ResourceFunctionCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	_, err = waitForFunction(ctx, api, region, f.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return append(diags, ResourceFunctionRead(ctx, d, m)...)
@@ -259,7 +259,7 @@ func ResourceFunctionCreate(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceFunctionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	f, err := waitForFunction(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -268,7 +268,7 @@ func ResourceFunctionRead(ctx context.Context, d *schema.ResourceData, m interfa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var diags diag.Diagnostics
@@ -312,7 +312,7 @@ func ResourceFunctionRead(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	f, err := waitForFunction(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -321,7 +321,7 @@ func ResourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, m inter
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &function.UpdateFunctionRequest{
@@ -388,7 +388,7 @@ func ResourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	if updated {
 		_, err = api.UpdateFunction(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		// Function is not in transit state at this point, api did not update it instantly when processing UpdateFunction
 		// We sleep so api has time to change resource to a transit state
@@ -402,7 +402,7 @@ func ResourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	if zipHasChanged {
 		err = functionUpload(ctx, m, api, region, f.ID, d.Get("zip_file").(string))
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to upload function: %w", err))
+			return httperrors.Diagnostics(fmt.Errorf("failed to upload function: %w", err))
 		}
 	}
 
@@ -413,7 +413,7 @@ func ResourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		}
 		err = functionDeploy(ctx, api, region, f.ID)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -423,7 +423,7 @@ func ResourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceFunctionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForFunction(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -437,7 +437,7 @@ func ResourceFunctionDelete(ctx context.Context, d *schema.ResourceData, m inter
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
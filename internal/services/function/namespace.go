@@ -93,7 +93,7 @@ func ResourceNamespace() *schema.Resource {
 func ResourceFunctionNamespaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	createReq := &function.CreateNamespaceRequest{
@@ -112,14 +112,14 @@ func ResourceFunctionNamespaceCreate(ctx context.Context, d *schema.ResourceData
 
 	ns, err := api.CreateNamespace(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, ns.ID))
 
 	_, err = waitForNamespace(ctx, api, region, ns.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceFunctionNamespaceRead(ctx, d, m)
@@ -128,7 +128,7 @@ func ResourceFunctionNamespaceCreate(ctx context.Context, d *schema.ResourceData
 func ResourceFunctionNamespaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ns, err := waitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -137,7 +137,7 @@ func ResourceFunctionNamespaceRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("description", ns.Description)
@@ -156,7 +156,7 @@ func ResourceFunctionNamespaceRead(ctx context.Context, d *schema.ResourceData,
 func ResourceFunctionNamespaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ns, err := waitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -165,7 +165,7 @@ func ResourceFunctionNamespaceUpdate(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &function.UpdateNamespaceRequest{
@@ -177,7 +177,7 @@ func ResourceFunctionNamespaceUpdate(ctx context.Context, d *schema.ResourceData
 		req.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 
@@ -190,7 +190,7 @@ func ResourceFunctionNamespaceUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if _, err := api.UpdateNamespace(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceFunctionNamespaceRead(ctx, d, m)
@@ -199,12 +199,12 @@ func ResourceFunctionNamespaceUpdate(ctx context.Context, d *schema.ResourceData
 func ResourceFunctionNamespaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteNamespace(&function.DeleteNamespaceRequest{
@@ -212,12 +212,12 @@ func ResourceFunctionNamespaceDelete(ctx context.Context, d *schema.ResourceData
 		NamespaceID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -12,19 +12,19 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_function_cron", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_function_cron", &resource.Sweeper{
 		Name: "scaleway_function_cron",
 		F:    testSweepFunctionCron,
 	})
-	resource.AddTestSweepers("scaleway_function", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_function", &resource.Sweeper{
 		Name: "scaleway_function",
 		F:    testSweepFunction,
 	})
-	resource.AddTestSweepers("scaleway_function_namespace", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_function_namespace", &resource.Sweeper{
 		Name: "scaleway_function_namespace",
 		F:    testSweepFunctionNamespace,
 	})
-	resource.AddTestSweepers("scaleway_function_trigger", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_function_trigger", &resource.Sweeper{
 		Name: "scaleway_function_trigger",
 		F:    testSweepFunctionTrigger,
 	})
@@ -43,9 +43,17 @@ func testSweepFunctionTrigger(_ string) error {
 		}
 
 		for _, trigger := range listTriggers.Triggers {
-			_, err := functionAPI.DeleteTrigger(&functionSDK.DeleteTriggerRequest{
-				TriggerID: trigger.ID,
-				Region:    region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:     "scaleway_function_trigger",
+				Locality: region.String(),
+				ID:       trigger.ID,
+				Name:     trigger.Name,
+			}, func() error {
+				_, err := functionAPI.DeleteTrigger(&functionSDK.DeleteTriggerRequest{
+					TriggerID: trigger.ID,
+					Region:    region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -71,9 +79,18 @@ func testSweepFunctionNamespace(_ string) error {
 		}
 
 		for _, ns := range listNamespaces.Namespaces {
-			_, err := functionAPI.DeleteNamespace(&functionSDK.DeleteNamespaceRequest{
-				NamespaceID: ns.ID,
-				Region:      region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_function_namespace",
+				Locality:  region.String(),
+				ID:        ns.ID,
+				Name:      ns.Name,
+				CreatedAt: ns.CreatedAt,
+			}, func() error {
+				_, err := functionAPI.DeleteNamespace(&functionSDK.DeleteNamespaceRequest{
+					NamespaceID: ns.ID,
+					Region:      region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -99,9 +116,18 @@ func testSweepFunction(_ string) error {
 		}
 
 		for _, f := range listFunctions.Functions {
-			_, err := functionAPI.DeleteFunction(&functionSDK.DeleteFunctionRequest{
-				FunctionID: f.ID,
-				Region:     region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_function",
+				Locality:  region.String(),
+				ID:        f.ID,
+				Name:      f.Name,
+				CreatedAt: f.CreatedAt,
+			}, func() error {
+				_, err := functionAPI.DeleteFunction(&functionSDK.DeleteFunctionRequest{
+					FunctionID: f.ID,
+					Region:     region,
+				})
+				return err
 			})
 			if err != nil && !httperrors.Is404(err) {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -127,9 +153,17 @@ func testSweepFunctionCron(_ string) error {
 		}
 
 		for _, cron := range listCron.Crons {
-			_, err := functionAPI.DeleteCron(&functionSDK.DeleteCronRequest{
-				CronID: cron.ID,
-				Region: region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:     "scaleway_function_cron",
+				Locality: region.String(),
+				ID:       cron.ID,
+				Name:     cron.Name,
+			}, func() error {
+				_, err := functionAPI.DeleteCron(&functionSDK.DeleteCronRequest{
+					CronID: cron.ID,
+					Region: region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -69,7 +69,7 @@ func ResourceToken() *schema.Resource {
 func ResourceFunctionTokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	token, err := api.CreateToken(&function.CreateTokenRequest{
@@ -80,7 +80,7 @@ func ResourceFunctionTokenCreate(ctx context.Context, d *schema.ResourceData, m
 		ExpiresAt:   types.ExpandTimePtr(d.Get("expires_at")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, token.ID))
@@ -93,7 +93,7 @@ func ResourceFunctionTokenCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceFunctionTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	token, err := api.GetToken(&function.GetTokenRequest{
 		Region:  region,
@@ -104,7 +104,7 @@ func ResourceFunctionTokenRead(ctx context.Context, d *schema.ResourceData, m in
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("function_id", types.FlattenStringPtr(token.FunctionID))
@@ -118,7 +118,7 @@ func ResourceFunctionTokenRead(ctx context.Context, d *schema.ResourceData, m in
 func ResourceFunctionTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, ID, err := container.NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteToken(&containerSDK.DeleteTokenRequest{
@@ -126,7 +126,7 @@ func ResourceFunctionTokenDelete(ctx context.Context, d *schema.ResourceData, m
 		TokenID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId("")
@@ -139,7 +139,7 @@ func ResourceTrigger() *schema.Resource {
 func ResourceFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &function.CreateTriggerRequest{
@@ -152,7 +152,7 @@ func ResourceFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData,
 	if scwSqs, isScwSqs := d.GetOk("sqs.0"); isScwSqs {
 		err := completeFunctionTriggerMnqCreationConfig(scwSqs, d, m, region)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to complete sqs config: %w", err))
+			return httperrors.Diagnostics(fmt.Errorf("failed to complete sqs config: %w", err))
 		}
 
 		_ = d.Set("sqs", []any{scwSqs})
@@ -162,7 +162,7 @@ func ResourceFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData,
 	if scwNats, isScwNats := d.GetOk("nats.0"); isScwNats {
 		err := completeFunctionTriggerMnqCreationConfig(scwNats, d, m, region)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to complete nats config: %w", err))
+			return httperrors.Diagnostics(fmt.Errorf("failed to complete nats config: %w", err))
 		}
 
 		_ = d.Set("nats", []any{scwNats})
@@ -171,14 +171,14 @@ func ResourceFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData,
 
 	trigger, err := api.CreateTrigger(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, trigger.ID))
 
 	_, err = waitForTrigger(ctx, api, region, trigger.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceFunctionTriggerRead(ctx, d, m)
@@ -187,7 +187,7 @@ func ResourceFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData,
 func ResourceFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	trigger, err := waitForTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -196,7 +196,7 @@ func ResourceFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", trigger.Name)
@@ -222,7 +222,7 @@ func ResourceFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, m
 func ResourceFunctionTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	trigger, err := waitForTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -231,7 +231,7 @@ func ResourceFunctionTriggerUpdate(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &function.UpdateTriggerRequest{
@@ -248,7 +248,7 @@ func ResourceFunctionTriggerUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if _, err := api.UpdateTrigger(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceFunctionTriggerRead(ctx, d, m)
@@ -257,12 +257,12 @@ func ResourceFunctionTriggerUpdate(ctx context.Context, d *schema.ResourceData,
 func ResourceFunctionTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteTrigger(&function.DeleteTriggerRequest{
@@ -270,12 +270,12 @@ func ResourceFunctionTriggerDelete(ctx context.Context, d *schema.ResourceData,
 		TriggerID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
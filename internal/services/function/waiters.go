@@ -6,83 +6,143 @@ import (
 
 	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitForNamespace(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (*function.Namespace, error) {
-	retryInterval := DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForNamespace(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (_ *function.Namespace, err error) {
+	ctx, span := tracing.Start(ctx, "waitForNamespace",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[function.NamespaceStatus]struct{}{
+		function.NamespaceStatusError:  {},
+		function.NamespaceStatusReady:  {},
+		function.NamespaceStatusLocked: {},
 	}
 
-	ns, err := functionAPI.WaitForNamespace(&function.WaitForNamespaceRequest{
-		Region:        region,
-		NamespaceID:   id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	ns, err := transport.Poll(ctx, DefaultFunctionRetryInterval, timeout, func(ctx context.Context) (*function.Namespace, bool, error) {
+		res, err := functionAPI.GetNamespace(&function.GetNamespaceRequest{
+			Region:      region,
+			NamespaceID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return ns, err
 }
 
-func waitForFunction(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (*function.Function, error) {
-	retryInterval := DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForFunction(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (_ *function.Function, err error) {
+	ctx, span := tracing.Start(ctx, "waitForFunction",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[function.FunctionStatus]struct{}{
+		function.FunctionStatusCreated: {},
+		function.FunctionStatusError:   {},
+		function.FunctionStatusLocked:  {},
+		function.FunctionStatusReady:   {},
 	}
 
-	f, err := functionAPI.WaitForFunction(&function.WaitForFunctionRequest{
-		Region:        region,
-		FunctionID:    id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	f, err := transport.Poll(ctx, DefaultFunctionRetryInterval, timeout, func(ctx context.Context) (*function.Function, bool, error) {
+		res, err := functionAPI.GetFunction(&function.GetFunctionRequest{
+			Region:     region,
+			FunctionID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return f, err
 }
 
-func waitForCron(ctx context.Context, functionAPI *function.API, region scw.Region, cronID string, timeout time.Duration) (*function.Cron, error) {
-	retryInterval := DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForCron(ctx context.Context, functionAPI *function.API, region scw.Region, cronID string, timeout time.Duration) (_ *function.Cron, err error) {
+	ctx, span := tracing.Start(ctx, "waitForCron",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(cronID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[function.CronStatus]struct{}{
+		function.CronStatusError:  {},
+		function.CronStatusReady:  {},
+		function.CronStatusLocked: {},
 	}
 
-	return functionAPI.WaitForCron(&function.WaitForCronRequest{
-		Region:        region,
-		CronID:        cronID,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, DefaultFunctionRetryInterval, timeout, func(ctx context.Context) (*function.Cron, bool, error) {
+		res, err := functionAPI.GetCron(&function.GetCronRequest{
+			Region: region,
+			CronID: cronID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
 
-func waitForDomain(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (*function.Domain, error) {
-	retryInterval := DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForDomain(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (_ *function.Domain, err error) {
+	ctx, span := tracing.Start(ctx, "waitForDomain",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[function.DomainStatus]struct{}{
+		function.DomainStatusError: {},
+		function.DomainStatusReady: {},
 	}
 
-	domain, err := functionAPI.WaitForDomain(&function.WaitForDomainRequest{
-		Region:        region,
-		DomainID:      id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	domain, err := transport.Poll(ctx, DefaultFunctionRetryInterval, timeout, func(ctx context.Context) (*function.Domain, bool, error) {
+		res, err := functionAPI.GetDomain(&function.GetDomainRequest{
+			Region:   region,
+			DomainID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return domain, err
 }
 
-func waitForTrigger(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (*function.Trigger, error) {
-	retryInterval := DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForTrigger(ctx context.Context, functionAPI *function.API, region scw.Region, id string, timeout time.Duration) (_ *function.Trigger, err error) {
+	ctx, span := tracing.Start(ctx, "waitForTrigger",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[function.TriggerStatus]struct{}{
+		function.TriggerStatusError: {},
+		function.TriggerStatusReady: {},
 	}
 
-	trigger, err := functionAPI.WaitForTrigger(&function.WaitForTriggerRequest{
-		Region:        region,
-		TriggerID:     id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	trigger, err := transport.Poll(ctx, DefaultFunctionRetryInterval, timeout, func(ctx context.Context) (*function.Trigger, bool, error) {
+		res, err := functionAPI.GetTrigger(&function.GetTriggerRequest{
+			Region:    region,
+			TriggerID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return trigger, err
 }
@@ -99,7 +99,7 @@ func resourceIamAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m inte
 		Description:      d.Get("description").(string),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("secret_key", res.SecretKey)
@@ -119,7 +119,7 @@ func resourceIamAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interf
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("description", res.Description)
 	_ = d.Set("created_at", types.FlattenTime(res.CreatedAt))
@@ -163,7 +163,7 @@ func resourceIamAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	if hasChanged {
 		_, err := api.UpdateAPIKey(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -177,7 +177,7 @@ func resourceIamAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m inte
 		AccessKey: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
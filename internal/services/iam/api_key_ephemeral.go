@@ -0,0 +1,205 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/framework"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	scwtypes "github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+const (
+	apiKeyEphemeralResourceType = "scaleway_iam_api_key"
+	defaultAPIKeyEphemeralTTL   = time.Hour
+	// apiKeyPrivateKey is the key of the access key in the private data of the ephemeral resource.
+	apiKeyPrivateKey = "access_key"
+)
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = (*APIKeyEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithValidateConfig = (*APIKeyEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithClose          = (*APIKeyEphemeralResource)(nil)
+)
+
+// APIKeyEphemeralResource creates a short-lived API key for the duration of a terraform run, without storing it in the state.
+// The key is deleted once terraform does not need it anymore, and expires by itself if it could not be deleted.
+type APIKeyEphemeralResource struct {
+	meta *meta.Meta
+}
+
+func NewAPIKeyEphemeralResource() ephemeral.EphemeralResource { //nolint:ireturn
+	return &APIKeyEphemeralResource{}
+}
+
+type apiKeyEphemeralResourceModel struct {
+	ApplicationID    types.String `tfsdk:"application_id"`
+	UserID           types.String `tfsdk:"user_id"`
+	Description      types.String `tfsdk:"description"`
+	DefaultProjectID types.String `tfsdk:"default_project_id"`
+	TTL              types.String `tfsdk:"ttl"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	AccessKey        types.String `tfsdk:"access_key"`
+	SecretKey        types.String `tfsdk:"secret_key"`
+}
+
+func (r *APIKeyEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = apiKeyEphemeralResourceType
+}
+
+func (r *APIKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived API key, deleted at the end of the terraform run and never stored in the state",
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "ID of the application attached to the api key",
+				Optional:    true,
+				Validators: []validator.String{
+					framework.ValidateDiagFunc(verify.IsUUID()),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "ID of the user attached to the api key",
+				Optional:    true,
+				Validators: []validator.String{
+					framework.ValidateDiagFunc(verify.IsUUID()),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the iam api key",
+				Optional:    true,
+			},
+			"default_project_id": schema.StringAttribute{
+				Description: "The default project ID to use with object storage",
+				Optional:    true,
+				Validators: []validator.String{
+					framework.ValidateDiagFunc(verify.IsUUID()),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				Description: "The duration after which the api key expires if it could not be deleted, for example `30m`. Defaults to `1h`",
+				Optional:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The date and time of the expiration of the iam api key",
+				Computed:    true,
+			},
+			"access_key": schema.StringAttribute{
+				Description: "The access key of the iam api key",
+				Computed:    true,
+			},
+			"secret_key": schema.StringAttribute{
+				Description: "The secret Key of the iam api key",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *APIKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	m, diags := framework.ExtractMeta(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.meta = m
+}
+
+// ValidateConfig checks that the api key is attached either to an application or to a user, and that the ttl is a duration.
+func (r *APIKeyEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config apiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ApplicationID.IsUnknown() && !config.UserID.IsUnknown() && config.ApplicationID.IsNull() == config.UserID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("application_id"), "Invalid api key bearer", "exactly one of application_id and user_id must be set")
+	}
+
+	if config.TTL.ValueString() != "" {
+		if _, err := time.ParseDuration(config.TTL.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ttl"), "Invalid ttl", err.Error())
+		}
+	}
+}
+
+func (r *APIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, span := framework.StartOperation(ctx, r.meta, apiKeyEphemeralResourceType, "open")
+	defer framework.EndOperation(span, &resp.Diagnostics)
+
+	var config apiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ttl := defaultAPIKeyEphemeralTTL
+	if config.TTL.ValueString() != "" {
+		var err error
+		ttl, err = time.ParseDuration(config.TTL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ttl"), "Invalid ttl", err.Error())
+			return
+		}
+	}
+	expiresAt := time.Now().Add(ttl)
+
+	res, err := NewAPI(r.meta).CreateAPIKey(&iam.CreateAPIKeyRequest{
+		ApplicationID:    config.ApplicationID.ValueStringPointer(),
+		UserID:           config.UserID.ValueStringPointer(),
+		ExpiresAt:        &expiresAt,
+		DefaultProjectID: config.DefaultProjectID.ValueStringPointer(),
+		Description:      config.Description.ValueString(),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		framework.AddError(&resp.Diagnostics, "Cannot create the api key", err)
+		return
+	}
+
+	privateAccessKey, err := json.Marshal(res.AccessKey)
+	if err != nil {
+		framework.AddError(&resp.Diagnostics, "Cannot save the access key of the api key", err)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyPrivateKey, privateAccessKey)...)
+
+	config.ExpiresAt = types.StringValue(scwtypes.FlattenTime(res.ExpiresAt).(string))
+	config.AccessKey = types.StringValue(res.AccessKey)
+	config.SecretKey = types.StringPointerValue(res.SecretKey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
+
+// Close deletes the api key created by Open.
+func (r *APIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	ctx, span := framework.StartOperation(ctx, r.meta, apiKeyEphemeralResourceType, "close")
+	defer framework.EndOperation(span, &resp.Diagnostics)
+
+	privateAccessKey, diags := req.Private.GetKey(ctx, apiKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateAccessKey == nil {
+		return
+	}
+
+	var accessKey string
+	if err := json.Unmarshal(privateAccessKey, &accessKey); err != nil {
+		framework.AddError(&resp.Diagnostics, "Cannot read the access key of the api key", err)
+		return
+	}
+
+	err := NewAPI(r.meta).DeleteAPIKey(&iam.DeleteAPIKeyRequest{
+		AccessKey: accessKey,
+	}, scw.WithContext(ctx))
+	if err != nil && !httperrors.Is404(err) {
+		framework.AddError(&resp.Diagnostics, "Cannot delete the api key", err)
+	}
+}
@@ -71,7 +71,7 @@ func resourceIamApplicationCreate(ctx context.Context, d *schema.ResourceData, m
 		Tags:           types.ExpandStrings(d.Get("tags")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(app.ID)
@@ -89,7 +89,7 @@ func resourceIamApplicationRead(ctx context.Context, d *schema.ResourceData, m i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("name", app.Name)
 	_ = d.Set("description", app.Description)
@@ -119,7 +119,7 @@ func resourceIamApplicationUpdate(ctx context.Context, d *schema.ResourceData, m
 		req.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
 		hasChanged = true
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 		hasChanged = true
 	}
@@ -127,7 +127,7 @@ func resourceIamApplicationUpdate(ctx context.Context, d *schema.ResourceData, m
 	if hasChanged {
 		_, err := api.UpdateApplication(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -141,7 +141,7 @@ func resourceIamApplicationDelete(ctx context.Context, d *schema.ResourceData, m
 		ApplicationID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -51,7 +52,7 @@ func DataSourceIamApplicationRead(ctx context.Context, d *schema.ResourceData, m
 			Name:           types.ExpandStringPtr(applicationName),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundApp, err := datasource.FindExact(
@@ -60,7 +61,7 @@ func DataSourceIamApplicationRead(ctx context.Context, d *schema.ResourceData, m
 			applicationName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		appID = foundApp.ID
@@ -69,7 +70,7 @@ func DataSourceIamApplicationRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(appID.(string))
 	err := d.Set("application_id", appID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := resourceIamApplicationRead(ctx, d, m)
@@ -92,7 +92,7 @@ func resourceIamGroupCreate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 	group, err := api.CreateGroup(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(group.ID)
@@ -106,7 +106,7 @@ func resourceIamGroupCreate(ctx context.Context, d *schema.ResourceData, m inter
 			UserIDs:        userIDs,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -123,7 +123,7 @@ func resourceIamGroupRead(ctx context.Context, d *schema.ResourceData, m interfa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", group.Name)
@@ -148,10 +148,10 @@ func resourceIamGroupUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		GroupID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
-	if d.HasChanges("name", "description", "tags") {
+	if d.HasChanges("name", "description", "tags", "tags_all") {
 		_, err = api.UpdateGroup(&iam.UpdateGroupRequest{
 			GroupID:     group.ID,
 			Name:        types.ExpandUpdatedStringPtr(d.Get("name")),
@@ -159,7 +159,7 @@ func resourceIamGroupUpdate(ctx context.Context, d *schema.ResourceData, m inter
 			Tags:        types.ExpandUpdatedStringsPtr(d.Get("tags")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -173,7 +173,7 @@ func resourceIamGroupUpdate(ctx context.Context, d *schema.ResourceData, m inter
 				GroupID:        group.ID,
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		} else {
 			for i := range group.ApplicationIDs {
@@ -182,7 +182,7 @@ func resourceIamGroupUpdate(ctx context.Context, d *schema.ResourceData, m inter
 					ApplicationID: &group.ApplicationIDs[i],
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 			}
 			for i := range group.UserIDs {
@@ -191,7 +191,7 @@ func resourceIamGroupUpdate(ctx context.Context, d *schema.ResourceData, m inter
 					UserID:  &group.UserIDs[i],
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 			}
 		}
@@ -207,7 +207,7 @@ func resourceIamGroupDelete(ctx context.Context, d *schema.ResourceData, m inter
 		GroupID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -52,7 +53,7 @@ func DataSourceIamGroupRead(ctx context.Context, d *schema.ResourceData, m inter
 
 		res, err := api.ListGroups(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundGroup, err := datasource.FindExact(
@@ -61,7 +62,7 @@ func DataSourceIamGroupRead(ctx context.Context, d *schema.ResourceData, m inter
 			groupName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		groupID = foundGroup.ID
@@ -70,7 +71,7 @@ func DataSourceIamGroupRead(ctx context.Context, d *schema.ResourceData, m inter
 	d.SetId(groupID.(string))
 	err := d.Set("group_id", groupID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := resourceIamGroupRead(ctx, d, m)
@@ -59,7 +59,7 @@ func resourceIamGroupMembershipCreate(ctx context.Context, d *schema.ResourceDat
 		ApplicationID: applicationID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(GroupMembershipID(group.ID, userID, applicationID))
@@ -71,7 +71,7 @@ func resourceIamGroupMembershipRead(ctx context.Context, d *schema.ResourceData,
 	api := NewAPI(m)
 	groupID, userID, applicationID, err := ExpandGroupMembershipID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	group, err := api.GetGroup(&iam.GetGroupRequest{
@@ -84,7 +84,7 @@ func resourceIamGroupMembershipRead(ctx context.Context, d *schema.ResourceData,
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	foundInGroup := false
@@ -121,7 +121,7 @@ func resourceIamGroupMembershipDelete(ctx context.Context, d *schema.ResourceDat
 	api := NewAPI(m)
 	groupID, userID, applicationID, err := ExpandGroupMembershipID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &iam.RemoveGroupMemberRequest{
@@ -141,7 +141,7 @@ func resourceIamGroupMembershipDelete(ctx context.Context, d *schema.ResourceDat
 
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -143,7 +143,7 @@ func resourceIamPolicyCreate(ctx context.Context, d *schema.ResourceData, m inte
 		Tags:           types.ExpandStrings(d.Get("tags")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(pol.ID)
@@ -161,7 +161,7 @@ func resourceIamPolicyRead(ctx context.Context, d *schema.ResourceData, m interf
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("name", pol.Name)
 	_ = d.Set("description", pol.Description)
@@ -187,7 +187,7 @@ func resourceIamPolicyRead(ctx context.Context, d *schema.ResourceData, m interf
 		PolicyID: pol.ID,
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to list policy's rules: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to list policy's rules: %w", err))
 	}
 
 	_ = d.Set("rule", flattenPolicyRules(listRules.Rules))
@@ -212,7 +212,7 @@ func resourceIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		hasUpdated = true
 		req.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		hasUpdated = true
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
@@ -235,7 +235,7 @@ func resourceIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	if hasUpdated {
 		_, err := api.UpdatePolicy(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -245,7 +245,7 @@ func resourceIamPolicyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			Rules:    expandPolicyRuleSpecs(d.Get("rule")),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -263,7 +263,7 @@ func resourceIamPolicyDelete(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -92,7 +92,7 @@ func resourceIamSSKKeyCreate(ctx context.Context, d *schema.ResourceData, m inte
 		ProjectID: (d.Get("project_id")).(string),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if _, disabledExists := d.GetOk("disabled"); disabledExists {
@@ -101,7 +101,7 @@ func resourceIamSSKKeyCreate(ctx context.Context, d *schema.ResourceData, m inte
 			Disabled: types.ExpandBoolPtr(types.GetBool(d, "disabled")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -121,7 +121,7 @@ func resourceIamSSHKeyRead(ctx context.Context, d *schema.ResourceData, m interf
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", res.Name)
@@ -157,7 +157,7 @@ func resourceIamSSKKeyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 				Disabled: types.ExpandBoolPtr(false),
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		} else {
 			_, err := api.UpdateSSHKey(&iam.UpdateSSHKeyRequest{
@@ -165,7 +165,7 @@ func resourceIamSSKKeyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 				Disabled: types.ExpandBoolPtr(types.GetBool(d, "disabled")),
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -173,7 +173,7 @@ func resourceIamSSKKeyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	if hasUpdated {
 		_, err := api.UpdateSSHKey(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -187,7 +187,7 @@ func resourceIamSSKKeyDelete(ctx context.Context, d *schema.ResourceData, m inte
 		SSHKeyID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -42,7 +43,7 @@ func DataSourceIamSSHKeyRead(ctx context.Context, d *schema.ResourceData, m inte
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundKey, err := datasource.FindExact(
@@ -51,7 +52,7 @@ func DataSourceIamSSHKeyRead(ctx context.Context, d *schema.ResourceData, m inte
 			sshKeyName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		sshKeyID = foundKey.ID
@@ -61,7 +62,7 @@ func DataSourceIamSSHKeyRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	err := d.Set("ssh_key_id", sshKeyID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := resourceIamSSHKeyRead(ctx, d, m)
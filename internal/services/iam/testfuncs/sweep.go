@@ -12,27 +12,27 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_iam_api_key", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_api_key", &resource.Sweeper{
 		Name: "scaleway_iam_api_key",
 		F:    testSweepIamAPIKey,
 	})
-	resource.AddTestSweepers("scaleway_iam_application", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_application", &resource.Sweeper{
 		Name: "scaleway_iam_application",
 		F:    testSweepIamApplication,
 	})
-	resource.AddTestSweepers("scaleway_iam_group", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_group", &resource.Sweeper{
 		Name: "scaleway_iam_group",
 		F:    testSweepIamGroup,
 	})
-	resource.AddTestSweepers("scaleway_iam_policy", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_policy", &resource.Sweeper{
 		Name: "scaleway_iam_policy",
 		F:    testSweepIamPolicy,
 	})
-	resource.AddTestSweepers("scaleway_iam_ssh_key", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_ssh_key", &resource.Sweeper{
 		Name: "scaleway_iam_ssh_key",
 		F:    testSweepSSHKey,
 	})
-	resource.AddTestSweepers("scaleway_iam_user", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iam_user", &resource.Sweeper{
 		Name: "scaleway_iam_user",
 		F:    testSweepUser,
 	})
@@ -57,8 +57,16 @@ func testSweepUser(_ string) error {
 			if !acctest.IsTestResource(user.Email) {
 				continue
 			}
-			err = api.DeleteUser(&iamSDK.DeleteUserRequest{
-				UserID: user.ID,
+			err = acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_iam_user",
+				Locality:  "global",
+				ID:        user.ID,
+				Name:      user.Email,
+				CreatedAt: user.CreatedAt,
+			}, func() error {
+				return api.DeleteUser(&iamSDK.DeleteUserRequest{
+					UserID: user.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("failed to delete user: %w", err)
@@ -83,8 +91,16 @@ func testSweepSSHKey(_ string) error {
 			if !acctest.IsTestResource(sshKey.Name) {
 				continue
 			}
-			err := iamAPI.DeleteSSHKey(&iamSDK.DeleteSSHKeyRequest{
-				SSHKeyID: sshKey.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_iam_ssh_key",
+				Locality:  "global",
+				ID:        sshKey.ID,
+				Name:      sshKey.Name,
+				CreatedAt: sshKey.CreatedAt,
+			}, func() error {
+				return iamAPI.DeleteSSHKey(&iamSDK.DeleteSSHKeyRequest{
+					SSHKeyID: sshKey.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting SSH key in sweeper: %s", err)
@@ -114,8 +130,16 @@ func testSweepIamPolicy(_ string) error {
 			if !acctest.IsTestResource(pol.Name) {
 				continue
 			}
-			err = api.DeletePolicy(&iamSDK.DeletePolicyRequest{
-				PolicyID: pol.ID,
+			err = acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_iam_policy",
+				Locality:  "global",
+				ID:        pol.ID,
+				Name:      pol.Name,
+				CreatedAt: pol.CreatedAt,
+			}, func() error {
+				return api.DeletePolicy(&iamSDK.DeletePolicyRequest{
+					PolicyID: pol.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("failed to delete policy: %w", err)
@@ -144,8 +168,16 @@ func testSweepIamGroup(_ string) error {
 			if !acctest.IsTestResource(group.Name) {
 				continue
 			}
-			err = api.DeleteGroup(&iamSDK.DeleteGroupRequest{
-				GroupID: group.ID,
+			err = acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_iam_group",
+				Locality:  "global",
+				ID:        group.ID,
+				Name:      group.Name,
+				CreatedAt: group.CreatedAt,
+			}, func() error {
+				return api.DeleteGroup(&iamSDK.DeleteGroupRequest{
+					GroupID: group.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("failed to delete group: %w", err)
@@ -175,8 +207,16 @@ func testSweepIamApplication(_ string) error {
 				continue
 			}
 
-			err = api.DeleteApplication(&iamSDK.DeleteApplicationRequest{
-				ApplicationID: app.ID,
+			err = acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_iam_application",
+				Locality:  "global",
+				ID:        app.ID,
+				Name:      app.Name,
+				CreatedAt: app.CreatedAt,
+			}, func() error {
+				return api.DeleteApplication(&iamSDK.DeleteApplicationRequest{
+					ApplicationID: app.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("failed to delete application: %w", err)
@@ -207,8 +247,16 @@ func testSweepIamAPIKey(_ string) error {
 			if !acctest.IsTestResource(key.Description) {
 				continue
 			}
-			err = api.DeleteAPIKey(&iamSDK.DeleteAPIKeyRequest{
-				AccessKey: key.AccessKey,
+			err = acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_iam_api_key",
+				Locality:  "global",
+				ID:        key.AccessKey,
+				Name:      key.Description,
+				CreatedAt: key.CreatedAt,
+			}, func() error {
+				return api.DeleteAPIKey(&iamSDK.DeleteAPIKeyRequest{
+					AccessKey: key.AccessKey,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("failed to delete api key: %w", err)
@@ -91,7 +91,7 @@ func resourceIamUserCreate(ctx context.Context, d *schema.ResourceData, m interf
 		Tags:           types.ExpandStrings(d.Get("tags")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(user.ID)
@@ -109,7 +109,7 @@ func resourceIamUserRead(ctx context.Context, d *schema.ResourceData, m interfac
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("email", user.Email)
@@ -133,16 +133,16 @@ func resourceIamUserUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		UserID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
-	if d.HasChanges("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		_, err = api.UpdateUser(&iam.UpdateUserRequest{
 			UserID: user.ID,
 			Tags:   types.ExpandUpdatedStringsPtr(d.Get("tags")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -156,7 +156,7 @@ func resourceIamUserDelete(ctx context.Context, d *schema.ResourceData, m interf
 		UserID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -61,7 +62,7 @@ func DataSourceIamUserRead(ctx context.Context, d *schema.ResourceData, m interf
 			UserID: userID.(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		email = res.Email
 		organizationID = res.OrganizationID
@@ -71,7 +72,7 @@ func DataSourceIamUserRead(ctx context.Context, d *schema.ResourceData, m interf
 			OrganizationID: account.GetOrganizationID(m, d),
 		}, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		if len(res.Users) == 0 {
 			return diag.FromErr(fmt.Errorf("no user found with the email address %s", d.Get("email")))
@@ -92,7 +93,7 @@ func DataSourceIamUserRead(ctx context.Context, d *schema.ResourceData, m interf
 	d.SetId(userID.(string))
 	err := d.Set("user_id", userID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("user_id", userID)
@@ -174,7 +174,7 @@ func ResourceDeployment() *schema.Resource {
 func ResourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := NewAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &inference.CreateDeploymentRequest{
@@ -201,14 +201,14 @@ func ResourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	deployment, err := api.CreateDeployment(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, deployment.ID))
 
 	_, err = waitForDeployment(ctx, api, region, deployment.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceDeploymentRead(ctx, d, m)
@@ -247,7 +247,7 @@ func buildEndpoints(d *schema.ResourceData) []*inference.EndpointSpec {
 func ResourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	deployment, err := waitForDeployment(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -256,7 +256,7 @@ func ResourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m inter
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", deployment.Name)
@@ -308,7 +308,7 @@ func ResourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	deployment, err := waitForDeployment(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -317,7 +317,7 @@ func ResourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &inference.UpdateDeploymentRequest{
@@ -329,7 +329,7 @@ func ResourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m int
 		req.Name = types.ExpandUpdatedStringPtr(d.Get("name"))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 
@@ -342,7 +342,7 @@ func ResourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	if _, err := api.UpdateDeployment(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceDeploymentRead(ctx, d, m)
@@ -351,23 +351,23 @@ func ResourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m int
 func ResourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForDeployment(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_, err = api.DeleteDeployment(&inference.DeleteDeploymentRequest{
 		Region:       region,
 		DeploymentID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_, err = waitForDeployment(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
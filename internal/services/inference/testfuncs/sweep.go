@@ -11,10 +11,9 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_instance_deployment", &resource.Sweeper{
-		Name:         "scaleway_instance_deployment",
-		Dependencies: nil,
-		F:            testSweepDeployment,
+	acctest.AddTestSweepers("scaleway_inference_deployment", &resource.Sweeper{
+		Name: "scaleway_inference_deployment",
+		F:    testSweepDeployment,
 	})
 }
 
@@ -31,9 +30,18 @@ func testSweepDeployment(_ string) error {
 		}
 
 		for _, deployment := range listDeployments.Deployments {
-			_, err := inferenceAPI.DeleteDeployment(&inference.DeleteDeploymentRequest{
-				DeploymentID: deployment.ID,
-				Region:       region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_inference_deployment",
+				Locality:  region.String(),
+				ID:        deployment.ID,
+				Name:      deployment.Name,
+				CreatedAt: deployment.CreatedAt,
+			}, func() error {
+				_, err := inferenceAPI.DeleteDeployment(&inference.DeleteDeploymentRequest{
+					DeploymentID: deployment.ID,
+					Region:       region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -6,21 +6,34 @@ import (
 
 	inference "github.com/scaleway/scaleway-sdk-go/api/inference/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitForDeployment(ctx context.Context, inferenceAPI *inference.API, region scw.Region, id string, timeout time.Duration) (*inference.Deployment, error) {
-	retryInterval := defaultDeploymentRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForDeployment(ctx context.Context, inferenceAPI *inference.API, region scw.Region, id string, timeout time.Duration) (_ *inference.Deployment, err error) {
+	ctx, span := tracing.Start(ctx, "waitForDeployment",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[inference.DeploymentStatus]struct{}{
+		inference.DeploymentStatusReady:  {},
+		inference.DeploymentStatusError:  {},
+		inference.DeploymentStatusLocked: {},
 	}
 
-	deployment, err := inferenceAPI.WaitForDeployment(&inference.WaitForDeploymentRequest{
-		Region:        region,
-		DeploymentID:  id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	deployment, err := transport.Poll(ctx, defaultDeploymentRetryInterval, timeout, func(ctx context.Context) (*inference.Deployment, bool, error) {
+		res, err := inferenceAPI.GetDeployment(&inference.GetDeploymentRequest{
+			Region:       region,
+			DeploymentID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return deployment, err
 }
@@ -1 +1,79 @@
 package instance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
+)
+
+// CheckServerTypeCapacity checks that the given number of servers of a commercial type can be created in a zone:
+// the commercial type must be in stock and the organization quotas must allow them.
+func CheckServerTypeCapacity(ctx context.Context, client *scw.Client, zone scw.Zone, commercialType string, requested uint64) error {
+	api := instance.NewAPI(client)
+	commercialType = strings.ToUpper(strings.ReplaceAll(commercialType, "_", "-"))
+
+	availabilities, err := api.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
+		Zone: zone,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return err
+	}
+	if availability, exists := availabilities.Servers[commercialType]; exists && availability.Availability == instance.ServerTypesAvailabilityShortage {
+		return cdf.OutOfStockError(fmt.Sprintf("commercial type %s in %s", commercialType, zone), requested)
+	}
+
+	var dashboard *instance.Dashboard
+	getDashboard := func() (*instance.Dashboard, error) {
+		if dashboard != nil {
+			return dashboard, nil
+		}
+		organizationID, _ := client.GetDefaultOrganizationID()
+		res, err := api.GetDashboard(&instance.GetDashboardRequest{
+			Zone:         zone,
+			Organization: &organizationID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		dashboard = res.Dashboard
+		return dashboard, nil
+	}
+
+	return cdf.CheckQuota(ctx, client, requested,
+		cdf.Quota{
+			Name: "instances_" + strings.ToLower(strings.ReplaceAll(commercialType, "-", "_")) + "_servers",
+			Used: func() (uint64, error) {
+				d, err := getDashboard()
+				if err != nil {
+					return 0, err
+				}
+				return uint64(d.ServersByTypes[commercialType]), nil
+			},
+		},
+		cdf.Quota{
+			Name: "instances_servers",
+			Used: func() (uint64, error) {
+				d, err := getDashboard()
+				if err != nil {
+					return 0, err
+				}
+				return uint64(d.ServersCount), nil
+			},
+		},
+	)
+}
+
+// customDiffInstanceServerCapacity checks that the commercial type of a new or resized server can be ordered.
+func customDiffInstanceServerCapacity(ctx context.Context, diff *schema.ResourceDiff, client *scw.Client) error {
+	zone := scw.Zone(diff.Get("zone").(string))
+	if zone == "" {
+		zone, _ = client.GetDefaultZone()
+	}
+
+	return CheckServerTypeCapacity(ctx, client, zone, diff.Get("type").(string), 1)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -42,7 +43,7 @@ func DataSourcePrivateNIC() *schema.Resource {
 func DataSourceInstancePrivateNICRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	serverID := locality.ExpandID(d.Get("server_id"))
@@ -56,12 +57,12 @@ func DataSourceInstancePrivateNICRead(ctx context.Context, d *schema.ResourceDat
 			Tags:     types.ExpandStrings(d.Get("tags")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to list instance private_nic: %w", err))
+			return httperrors.Diagnostics(fmt.Errorf("failed to list instance private_nic: %w", err))
 		}
 
 		privateNic, err := privateNICWithFilters(resp.PrivateNics, d)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		privateNICID = privateNic.ID
@@ -77,7 +78,7 @@ func DataSourceInstancePrivateNICRead(ctx context.Context, d *schema.ResourceDat
 	d.SetId(zonedID)
 	err = d.Set("private_nic_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := ResourceInstancePrivateNICRead(ctx, d, m)
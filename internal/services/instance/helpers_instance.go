@@ -20,6 +20,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/block"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -140,7 +141,16 @@ func serverStateExpand(rawState string) (instance.ServerState, error) {
 	return apiState, nil
 }
 
-func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, serverID string, toState instance.ServerState) error {
+// reachState applies the actions moving a server to toState and waits for them to complete.
+// With skipWait, the last action is only started and the server may still be in a transient state.
+func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, serverID string, toState instance.ServerState, skipWait bool) (err error) {
+	ctx, span := tracing.Start(ctx, "reachState",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(serverID),
+		tracing.AttributeTargetStatus.String(toState.String()),
+	)
+	defer func() { tracing.End(span, err) }()
+
 	response, err := api.GetServer(&instance.GetServerRequest{
 		Zone:     zone,
 		ServerID: serverID,
@@ -191,7 +201,15 @@ func reachState(ctx context.Context, api *BlockAndInstanceAPI, zone scw.Zone, se
 		}
 	}
 
-	for _, a := range actions {
+	for i, a := range actions {
+		if skipWait && i == len(actions)-1 {
+			_, err = api.ServerAction(&instance.ServerActionRequest{
+				ServerID: serverID,
+				Action:   a,
+				Zone:     zone,
+			}, scw.WithContext(ctx))
+			return err
+		}
 		err = api.ServerActionAndWait(&instance.ServerActionAndWaitRequest{
 			ServerID:      serverID,
 			Action:        a,
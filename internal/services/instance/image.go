@@ -179,7 +179,7 @@ func ResourceImage() *schema.Resource {
 func ResourceInstanceImageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := instanceAndBlockAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &instanceSDK.CreateImageRequest{
@@ -205,7 +205,7 @@ func ResourceInstanceImageCreate(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := api.CreateImage(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.Image.ID))
@@ -217,7 +217,7 @@ func ResourceInstanceImageCreate(ctx context.Context, d *schema.ResourceData, m
 		Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutCreate)),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceInstanceImageRead(ctx, d, m)
@@ -226,7 +226,7 @@ func ResourceInstanceImageCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceInstanceImageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	image, err := instanceAPI.GetImage(&instanceSDK.GetImageRequest{
@@ -238,7 +238,7 @@ func ResourceInstanceImageRead(ctx context.Context, d *schema.ResourceData, m in
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", image.Image.Name)
@@ -261,7 +261,7 @@ func ResourceInstanceImageRead(ctx context.Context, d *schema.ResourceData, m in
 func ResourceInstanceImageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := instanceAndBlockAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &instanceSDK.UpdateImageRequest{
@@ -285,7 +285,7 @@ func ResourceInstanceImageUpdate(ctx context.Context, d *schema.ResourceData, m
 		ImageID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChange("additional_volume_ids") {
@@ -310,17 +310,17 @@ func ResourceInstanceImageUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	_, err = waitForImage(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.UpdateImage(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't update image: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't update image: %w", err))
 	}
 
 	_, err = waitForImage(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceInstanceImageRead(ctx, d, m)
@@ -329,12 +329,12 @@ func ResourceInstanceImageUpdate(ctx context.Context, d *schema.ResourceData, m
 func ResourceInstanceImageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForImage(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = instanceAPI.DeleteImage(&instanceSDK.DeleteImageRequest{
@@ -343,14 +343,14 @@ func ResourceInstanceImageDelete(ctx context.Context, d *schema.ResourceData, m
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	_, err = waitForImage(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		if !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
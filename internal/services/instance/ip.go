@@ -74,7 +74,7 @@ func ResourceIP() *schema.Resource {
 func ResourceInstanceIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	req := &instanceSDK.CreateIPRequest{
 		Zone:    zone,
@@ -87,7 +87,7 @@ func ResourceInstanceIPCreate(ctx context.Context, d *schema.ResourceData, m int
 	}
 	res, err := instanceAPI.CreateIP(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	reverseRaw, ok := d.GetOk("reverse")
@@ -100,7 +100,7 @@ func ResourceInstanceIPCreate(ctx context.Context, d *schema.ResourceData, m int
 		}
 		_, err = instanceAPI.UpdateIP(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -111,20 +111,20 @@ func ResourceInstanceIPCreate(ctx context.Context, d *schema.ResourceData, m int
 func ResourceInstanceIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	req := &instanceSDK.UpdateIPRequest{
 		IP:   ID,
 		Zone: zone,
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 
 	_, err = instanceAPI.UpdateIP(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceInstanceIPRead(ctx, d, m)
@@ -133,7 +133,7 @@ func ResourceInstanceIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 func ResourceInstanceIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := instanceAPI.GetIP(&instanceSDK.GetIPRequest{
@@ -146,7 +146,7 @@ func ResourceInstanceIPRead(ctx context.Context, d *schema.ResourceData, m inter
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	address := res.IP.Address.String()
@@ -184,7 +184,7 @@ func ResourceInstanceIPRead(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceInstanceIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = instanceAPI.DeleteIP(&instanceSDK.DeleteIPRequest{
@@ -197,7 +197,7 @@ func ResourceInstanceIPDelete(ctx context.Context, d *schema.ResourceData, m int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
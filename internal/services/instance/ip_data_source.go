@@ -44,7 +44,7 @@ func DataSourceIP() *schema.Resource {
 func DataSourceInstanceIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	id, ok := d.GetOk("id")
@@ -60,7 +60,7 @@ func DataSourceInstanceIPRead(ctx context.Context, d *schema.ResourceData, m int
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		ID = res.IP.ID
 	} else {
@@ -71,7 +71,7 @@ func ResourcePlacementGroup() *schema.Resource {
 func ResourceInstancePlacementGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := instanceAPI.CreatePlacementGroup(&instanceSDK.CreatePlacementGroupRequest{
@@ -83,7 +83,7 @@ func ResourceInstancePlacementGroupCreate(ctx context.Context, d *schema.Resourc
 		Tags:       types.ExpandStrings(d.Get("tags")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.PlacementGroup.ID))
@@ -93,7 +93,7 @@ func ResourceInstancePlacementGroupCreate(ctx context.Context, d *schema.Resourc
 func ResourceInstancePlacementGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := instanceAPI.GetPlacementGroup(&instanceSDK.GetPlacementGroupRequest{
@@ -105,7 +105,7 @@ func ResourceInstancePlacementGroupRead(ctx context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", res.PlacementGroup.Name)
@@ -123,7 +123,7 @@ func ResourceInstancePlacementGroupRead(ctx context.Context, d *schema.ResourceD
 func ResourceInstancePlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	req := &instanceSDK.UpdatePlacementGroupRequest{
 		Zone:             zone,
@@ -150,7 +150,7 @@ func ResourceInstancePlacementGroupUpdate(ctx context.Context, d *schema.Resourc
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 		hasChanged = true
 	}
@@ -158,7 +158,7 @@ func ResourceInstancePlacementGroupUpdate(ctx context.Context, d *schema.Resourc
 	if hasChanged {
 		_, err = instanceAPI.UpdatePlacementGroup(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -168,7 +168,7 @@ func ResourceInstancePlacementGroupUpdate(ctx context.Context, d *schema.Resourc
 func ResourceInstancePlacementGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = instanceAPI.DeletePlacementGroup(&instanceSDK.DeletePlacementGroupRequest{
@@ -177,7 +177,7 @@ func ResourceInstancePlacementGroupDelete(ctx context.Context, d *schema.Resourc
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
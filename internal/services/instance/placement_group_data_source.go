@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -35,7 +36,7 @@ func DataSourcePlacementGroup() *schema.Resource {
 func DataSourcePlacementGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	placementGroupID, placementGroupIDExists := d.GetOk("placement_group_id")
@@ -46,7 +47,7 @@ func DataSourcePlacementGroupRead(ctx context.Context, d *schema.ResourceData, m
 			Project: types.ExpandStringPtr(d.Get("project_id")),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		for _, placementGroup := range res.PlacementGroups {
@@ -66,7 +67,7 @@ func DataSourcePlacementGroupRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(zoneID)
 	err = d.Set("placement_group_id", zoneID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := ResourceInstancePlacementGroupRead(ctx, d, m)
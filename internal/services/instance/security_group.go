@@ -106,7 +106,7 @@ func ResourceSecurityGroup() *schema.Resource {
 func ResourceInstanceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &instanceSDK.CreateSecurityGroupRequest{
@@ -125,7 +125,7 @@ func ResourceInstanceSecurityGroupCreate(ctx context.Context, d *schema.Resource
 	}
 	res, err := instanceAPI.CreateSecurityGroup(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.SecurityGroup.ID))
@@ -140,7 +140,7 @@ func ResourceInstanceSecurityGroupCreate(ctx context.Context, d *schema.Resource
 func ResourceInstanceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := instanceAPI.GetSecurityGroup(&instanceSDK.GetSecurityGroupRequest{
@@ -152,7 +152,7 @@ func ResourceInstanceSecurityGroupRead(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("zone", zone)
@@ -169,7 +169,7 @@ func ResourceInstanceSecurityGroupRead(ctx context.Context, d *schema.ResourceDa
 	if !d.Get("external_rules").(bool) {
 		inboundRules, outboundRules, err := getSecurityGroupRules(ctx, instanceAPI, zone, ID, d)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		_ = d.Set("inbound_rule", inboundRules)
 		_ = d.Set("outbound_rule", outboundRules)
@@ -240,11 +240,11 @@ func getSecurityGroupRules(ctx context.Context, instanceAPI *instanceSDK.API, zo
 func ResourceInstanceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, _, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	zone, ID, err := zonal.ParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	inboundDefaultPolicy := instanceSDK.SecurityGroupPolicy("")
@@ -286,13 +286,13 @@ func ResourceInstanceSecurityGroupUpdate(ctx context.Context, d *schema.Resource
 
 	_, err = instanceAPI.UpdateSecurityGroup(updateReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if !d.Get("external_rules").(bool) {
 		err = updateSecurityGroupeRules(ctx, d, zone, ID, instanceAPI)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -343,11 +343,11 @@ func updateSecurityGroupeRules(ctx context.Context, d *schema.ResourceData, zone
 func ResourceInstanceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, _, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	zone, ID, err := zonal.ParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = instanceAPI.DeleteSecurityGroup(&instanceSDK.DeleteSecurityGroupRequest{
@@ -356,7 +356,7 @@ func ResourceInstanceSecurityGroupDelete(ctx context.Context, d *schema.Resource
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
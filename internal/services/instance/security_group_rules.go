@@ -57,7 +57,7 @@ func ResourceInstanceSecurityGroupRulesRead(ctx context.Context, d *schema.Resou
 
 	instanceAPI, zone, securityGroupID, err := NewAPIWithZoneAndID(m, securityGroupZonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("security_group_id", securityGroupZonedID)
@@ -68,7 +68,7 @@ func ResourceInstanceSecurityGroupRulesRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("inbound_rule", inboundRules)
@@ -81,12 +81,12 @@ func ResourceInstanceSecurityGroupRulesUpdate(ctx context.Context, d *schema.Res
 	securityGroupZonedID := d.Id()
 	instanceAPI, zone, securityGroupID, err := NewAPIWithZoneAndID(m, securityGroupZonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = updateSecurityGroupeRules(ctx, d, zone, securityGroupID, instanceAPI)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceInstanceSecurityGroupRulesRead(ctx, d, m)
@@ -96,7 +96,7 @@ func ResourceInstanceSecurityGroupRulesDelete(ctx context.Context, d *schema.Res
 	securityGroupZonedID := d.Id()
 	instanceAPI, zone, securityGroupID, err := NewAPIWithZoneAndID(m, securityGroupZonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("inbound_rule", nil)
@@ -104,7 +104,7 @@ func ResourceInstanceSecurityGroupRulesDelete(ctx context.Context, d *schema.Res
 
 	err = updateSecurityGroupeRules(ctx, d, zone, securityGroupID, instanceAPI)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -249,6 +249,16 @@ func ResourceServer() *schema.Resource {
 					InstanceServerStateStandby,
 				}, false),
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the server, such as starting while it boots",
+			},
+			"skip_wait": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Return right after the create and update calls instead of waiting for the server to reach its state",
+			},
 			"boot_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -353,6 +363,7 @@ func ResourceServer() *schema.Resource {
 			customDiffInstanceServerType,
 			customDiffInstanceServerImage,
 			customDiffInstanceRootVolumeSize,
+			cdf.CapacityCheck(customDiffInstanceServerCapacity, "type"),
 		),
 	}
 }
@@ -361,7 +372,7 @@ func ResourceServer() *schema.Resource {
 func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := instanceAndBlockAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////
@@ -423,7 +434,7 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 			// We have to get the volume to know whether it is a local or a block volume
 			volumeTemplate, err := instanceServerAdditionalVolumeTemplate(api, zone, volumeID.(string))
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("failed to get additional volume: %w", err))
+				return httperrors.Diagnostics(fmt.Errorf("failed to get additional volume: %w", err))
 			}
 			req.Volumes[strconv.Itoa(i+1)] = volumeTemplate
 		}
@@ -431,7 +442,7 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 
 	// Validate total local volume sizes.
 	if err = validateLocalVolumeSizes(req.Volumes, serverType, req.CommercialType); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if imageUUID != "" && !scwvalidation.IsUUID(imageUUID) {
@@ -446,7 +457,7 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 			Type:           volumeTypeToMarketplaceFilter(req.Volumes["0"].VolumeType),
 		})
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("could not get image '%s': %s", zonal.NewID(zone, imageLabel), err))
+			return httperrors.Diagnostics(fmt.Errorf("could not get image '%s': %w", zonal.NewID(zone, imageLabel), err))
 		}
 		imageUUID = image.ID
 	}
@@ -457,14 +468,14 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := api.CreateServer(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewID(zone, res.Server.ID).String())
 
 	_, err = waitForServer(ctx, api.API, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////
@@ -502,22 +513,22 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 	if len(userDataRequests.UserData) > 0 {
 		_, err := waitForServer(ctx, api.API, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		err = api.SetAllServerUserData(userDataRequests)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	targetState, err := serverStateExpand(d.Get("state").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
-	err = reachState(ctx, api, zone, res.Server.ID, targetState)
+	err = reachState(ctx, api, zone, res.Server.ID, targetState, meta.ExtractSkipWait(d, m) && len(d.Get("private_network").([]interface{})) == 0)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////
@@ -526,33 +537,33 @@ func ResourceInstanceServerCreate(ctx context.Context, d *schema.ResourceData, m
 	if rawPNICs, ok := d.GetOk("private_network"); ok {
 		vpcAPI, err := vpc.NewAPI(m)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		pnRequest, err := preparePrivateNIC(ctx, rawPNICs, res.Server, vpcAPI)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		// compute attachment
 		for _, q := range pnRequest {
 			_, err := waitForServer(ctx, api.API, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			pn, err := api.CreatePrivateNIC(q, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			tflog.Debug(ctx, fmt.Sprintf("private network created (ID: %s, status: %s)", pn.PrivateNic.ID, pn.PrivateNic.State))
 
 			_, err = waitForPrivateNIC(ctx, api.API, zone, res.Server.ID, pn.PrivateNic.ID, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			_, err = waitForMACAddress(ctx, api.API, zone, res.Server.ID, pn.PrivateNic.ID, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -568,28 +579,45 @@ func errorCheck(err error, message string) bool {
 func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := instanceAndBlockAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
-	server, err := waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutRead))
+	skipWait := meta.ExtractSkipWait(d, m)
+	var server *instanceSDK.Server
+	if skipWait {
+		var res *instanceSDK.GetServerResponse
+		res, err = api.GetServer(&instanceSDK.GetServerRequest{
+			Zone:     zone,
+			ServerID: id,
+		}, scw.WithContext(ctx))
+		if res != nil {
+			server = res.Server
+		}
+	} else {
+		server, err = waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutRead))
+	}
 	if err != nil {
 		if errorCheck(err, "is not found") {
 			log.Printf("[WARN] instance %s not found droping from state", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	////
 	// Read Server
 	////
 
 	if err == nil {
+		_ = d.Set("status", server.State.String())
 		state, err := serverStateFlatten(server.State)
-		if err != nil {
-			return diag.FromErr(err)
+		if err != nil && (!skipWait || server.State == instanceSDK.ServerStateLocked) {
+			return httperrors.Diagnostics(err)
+		}
+		// The state of a server still in a transient state is kept as configured
+		if err == nil {
+			_ = d.Set("state", state)
 		}
-		_ = d.Set("state", state)
 		_ = d.Set("zone", string(zone))
 		_ = d.Set("name", server.Name)
 		_ = d.Set("boot_type", server.BootType)
@@ -658,7 +686,7 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 			_ = d.Set("ipv6_gateway", server.IPv6.Gateway.String()) //nolint:staticcheck
 			prefixLength, err := strconv.Atoi(server.IPv6.Netmask)  //nolint:staticcheck
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			_ = d.Set("ipv6_prefix_length", prefixLength)
 		} else {
@@ -682,7 +710,7 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 					Zone:     server.Zone,
 				})
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("failed to read instance volume %s: %w", serverVolume.ID, err))
+					return httperrors.Diagnostics(fmt.Errorf("failed to read instance volume %s: %w", serverVolume.ID, err))
 				}
 
 				rootVolume["volume_id"] = zonal.NewID(zone, vol.ID).String()
@@ -722,7 +750,7 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 		for key, value := range allUserData.UserData {
 			userDataValue, err := io.ReadAll(value)
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			// if key != "cloud-init" {
 			userData[key] = string(userDataValue)
@@ -737,13 +765,13 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 		////
 		ph, err := newPrivateNICHandler(api.API, id, zone)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		// set private networks
 		err = ph.set(d)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		return nil
@@ -755,7 +783,7 @@ func ResourceInstanceServerRead(ctx context.Context, d *schema.ResourceData, m i
 func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := instanceAndBlockAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	wantedState := d.Get("state").(string)
@@ -765,12 +793,13 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	server, err := waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	////
 	// Construct UpdateServerRequest
 	////
 	serverShouldUpdate := false
+	skipWait := meta.ExtractSkipWait(d, m)
 	updateRequest := &instanceSDK.UpdateServerRequest{
 		Zone:     zone,
 		ServerID: server.ID,
@@ -781,7 +810,7 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 		updateRequest.Name = types.ExpandStringPtr(d.Get("name"))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		serverShouldUpdate = true
 		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
@@ -807,7 +836,7 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChanges("additional_volume_ids", "root_volume") {
 		volumes, err := instanceServerVolumesUpdate(ctx, d, api, zone, isStopped)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		serverShouldUpdate = true
 		updateRequest.Volumes = &volumes
@@ -832,7 +861,7 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChange("ip_id") && !instanceIPHasMigrated(d) {
 		server, err := waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		ipID := zonal.ExpandID(d.Get("ip_id")).ID
@@ -844,19 +873,19 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 				Server: &instanceSDK.NullableStringValue{Null: true},
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			// we wait to ensure to not detach the new ip.
 			_, err := waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 		// If a new IP is provided, we attach it to the server
 		if ipID != "" {
 			_, err := waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			_, err = api.UpdateIP(&instanceSDK.UpdateIPRequest{
@@ -865,12 +894,12 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 				Server: &instanceSDK.NullableStringValue{Value: id},
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			_, err = waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -878,7 +907,7 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChange("ip_ids") {
 		err := ResourceInstanceServerUpdateIPs(ctx, d, api.API, zone, id)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -919,12 +948,12 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 
 		_, err := waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		err = api.SetAllServerUserData(userDataRequests)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -934,7 +963,7 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChanges("private_network") {
 		ph, err := newPrivateNICHandler(api.API, id, zone)
 		if err != nil {
-			diag.FromErr(err)
+			httperrors.Diagnostics(err)
 		}
 		if raw, ok := d.GetOk("private_network"); ok {
 			// retrieve all current private network interfaces
@@ -945,16 +974,16 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 					if !cmp.Equal(n, o) {
 						_, err := waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
-							return diag.FromErr(err)
+							return httperrors.Diagnostics(err)
 						}
 
 						err = ph.detach(ctx, o, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
-							return diag.FromErr(err)
+							return httperrors.Diagnostics(err)
 						}
 						err = ph.attach(ctx, n, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
-							return diag.FromErr(err)
+							return httperrors.Diagnostics(err)
 						}
 					}
 				}
@@ -967,12 +996,12 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 				if pnExist {
 					_, err := waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
-						return diag.FromErr(err)
+						return httperrors.Diagnostics(err)
 					}
 
 					err = ph.detach(ctx, pn["pn_id"], d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
-						return diag.FromErr(err)
+						return httperrors.Diagnostics(err)
 					}
 				}
 			}
@@ -985,31 +1014,33 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 	if d.HasChange("state") {
 		targetState, err := serverStateExpand(d.Get("state").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		// reach expected state
-		err = reachState(ctx, api, zone, id, targetState)
+		err = reachState(ctx, api, zone, id, targetState, skipWait && !serverShouldUpdate && !d.HasChange("type"))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	if serverShouldUpdate {
 		_, err = api.UpdateServer(updateRequest)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
-	_, err = waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
-	if err != nil {
-		return diag.FromErr(err)
+	if !skipWait || d.HasChange("type") {
+		_, err = waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return httperrors.Diagnostics(err)
+		}
 	}
 
 	if d.HasChange("type") {
 		err := ResourceInstanceServerMigrate(ctx, d, api, zone, id)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -1023,7 +1054,7 @@ func ResourceInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, m
 func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := instanceAndBlockAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	// detach eip to ensure to free eip even if instanceSDK won't stop
 	if ipID, ok := d.GetOk("ip_id"); ok {
@@ -1048,19 +1079,19 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	// reach stopped state
-	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped)
+	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped, false)
 	if httperrors.Is404(err) {
 		return nil
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// Delete private-nic if managed by instance_server resource
 	if raw, ok := d.GetOk("private_network"); ok {
 		ph, err := newPrivateNICHandler(api.API, id, zone)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		for index := range raw.([]interface{}) {
@@ -1068,14 +1099,14 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 			pn := d.Get(pnKey)
 			err := ph.detach(ctx, pn, d.Timeout(schema.TimeoutDelete))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
 
 	_, err = waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteServer(&instanceSDK.DeleteServerRequest{
@@ -1083,12 +1114,12 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 		ServerID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForServer(ctx, api.API, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// Related to https://github.com/hashicorp/terraform-plugin-sdk/issues/142
@@ -1103,7 +1134,7 @@ func ResourceInstanceServerDelete(ctx context.Context, d *schema.ResourceData, m
 			VolumeID: locality.ExpandID(volumeID),
 		})
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -1259,7 +1290,7 @@ func ResourceInstanceServerMigrate(ctx context.Context, d *schema.ResourceData,
 	}
 	beginningState := server.State
 
-	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped)
+	err = reachState(ctx, api, zone, id, instanceSDK.ServerStateStopped, false)
 	if err != nil {
 		return fmt.Errorf("failed to stop server before changing server type: %w", err)
 	}
@@ -1273,7 +1304,7 @@ func ResourceInstanceServerMigrate(ctx context.Context, d *schema.ResourceData,
 		return errors.New("failed to change server type server")
 	}
 
-	err = reachState(ctx, api, zone, id, beginningState)
+	err = reachState(ctx, api, zone, id, beginningState, false)
 	if err != nil {
 		return fmt.Errorf("failed to start server after changing server type: %w", err)
 	}
@@ -1340,7 +1371,7 @@ func ResourceInstanceServerUpdateRootVolumeIOPS(ctx context.Context, api *BlockA
 		ServerID: serverID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	rootVolume, exists := res.Server.Volumes["0"]
@@ -2048,3 +2048,40 @@ func TestAccServer_PrivateNetworkMissingPNIC(t *testing.T) {
 		},
 	})
 }
+
+func TestAccServer_SkipWait(t *testing.T) {
+	tt := acctest.NewFakeTestTools(t)
+	defer tt.Cleanup()
+	config := `
+		resource "scaleway_instance_server" "main" {
+			name      = "tf-tests-server-skip-wait"
+			type      = "DEV1-S"
+			image     = "ubuntu_jammy"
+			state     = "started"
+			skip_wait = true
+		}
+	`
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				// The server is still booting when the apply returns
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					isServerPresent(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "status", "starting"),
+				),
+			},
+			{
+				// It is running on the next refresh
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "status", "running"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "state", "started"),
+				),
+			},
+		},
+	})
+}
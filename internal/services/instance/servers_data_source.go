@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -149,7 +150,7 @@ func DataSourceServers() *schema.Resource {
 func DataSourceInstanceServersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	res, err := instanceAPI.ListServers(&instance.ListServersRequest{
 		Zone:    zone,
@@ -158,7 +159,7 @@ func DataSourceInstanceServersRead(ctx context.Context, d *schema.ResourceData,
 		Tags:    types.ExpandStrings(d.Get("tags")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var diags diag.Diagnostics
@@ -178,7 +179,7 @@ func DataSourceInstanceServersRead(ctx context.Context, d *schema.ResourceData,
 		}
 		state, err := serverStateFlatten(server.State)
 		if err != nil {
-			diags = append(diags, diag.FromErr(err)...)
+			diags = append(diags, httperrors.Diagnostics(err)...)
 			continue
 		}
 		rawServer["state"] = state
@@ -209,7 +210,7 @@ func DataSourceInstanceServersRead(ctx context.Context, d *schema.ResourceData,
 			rawServer["ipv6_gateway"] = server.IPv6.Gateway.String() //nolint:staticcheck
 			prefixLength, err := strconv.Atoi(server.IPv6.Netmask)   //nolint:staticcheck
 			if err != nil {
-				diags = append(diags, diag.FromErr(fmt.Errorf("failed to read ipv6 netmask: %w", err))...)
+				diags = append(diags, httperrors.Diagnostics(fmt.Errorf("failed to read ipv6 netmask: %w", err))...)
 				continue
 			}
 
@@ -115,7 +115,7 @@ func ResourceSnapshot() *schema.Resource {
 func ResourceInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &instanceSDK.CreateSnapshotRequest{
@@ -142,7 +142,7 @@ func ResourceInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 
 	res, err := instanceAPI.CreateSnapshot(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.Snapshot.ID))
@@ -154,7 +154,7 @@ func ResourceInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 		Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutCreate)),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceInstanceSnapshotRead(ctx, d, m)
@@ -163,7 +163,7 @@ func ResourceInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 func ResourceInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	snapshot, err := instanceAPI.GetSnapshot(&instanceSDK.GetSnapshotRequest{
@@ -175,7 +175,7 @@ func ResourceInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", snapshot.Snapshot.Name)
@@ -189,7 +189,7 @@ func ResourceInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, m
 func ResourceInstanceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &instanceSDK.UpdateSnapshotRequest{
@@ -200,13 +200,13 @@ func ResourceInstanceSnapshotUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	tags := types.ExpandStrings(d.Get("tags"))
-	if d.HasChange("tags") && len(tags) > 0 {
+	if d.HasChanges("tags", "tags_all") && len(tags) > 0 {
 		req.Tags = scw.StringsPtr(types.ExpandStrings(d.Get("tags")))
 	}
 
 	_, err = instanceAPI.UpdateSnapshot(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't update snapshot: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't update snapshot: %w", err))
 	}
 
 	return ResourceInstanceSnapshotRead(ctx, d, m)
@@ -215,12 +215,12 @@ func ResourceInstanceSnapshotUpdate(ctx context.Context, d *schema.ResourceData,
 func ResourceInstanceSnapshotDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForSnapshot(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = instanceAPI.DeleteSnapshot(&instanceSDK.DeleteSnapshotRequest{
@@ -229,14 +229,14 @@ func ResourceInstanceSnapshotDelete(ctx context.Context, d *schema.ResourceData,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	_, err = waitForSnapshot(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		if !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -37,7 +38,7 @@ func DataSourceSnapshot() *schema.Resource {
 func DataSourceInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	snapshotID, ok := d.GetOk("snapshot_id")
@@ -49,7 +50,7 @@ func DataSourceInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData,
 			Project: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundSnapshot, err := datasource.FindExact(
@@ -58,7 +59,7 @@ func DataSourceInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData,
 			snapshotName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		snapshotID = foundSnapshot.ID
@@ -70,7 +71,7 @@ func DataSourceInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData,
 
 	err = d.Set("snapshot_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	diags := ResourceInstanceSnapshotRead(ctx, d, m)
 	if len(diags) > 0 {
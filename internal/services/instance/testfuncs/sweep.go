@@ -11,34 +11,39 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_instance_image", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_instance_image", &resource.Sweeper{
 		Name:         "scaleway_instance_image",
 		Dependencies: []string{"scaleway_instance_server"},
 		F:            testSweepImage,
 	})
-	resource.AddTestSweepers("scaleway_instance_ip", &resource.Sweeper{
-		Name: "scaleway_instance_ip",
-		F:    testSweepIP,
+	acctest.AddTestSweepers("scaleway_instance_ip", &resource.Sweeper{
+		Name:         "scaleway_instance_ip",
+		Dependencies: []string{"scaleway_instance_server"},
+		F:            testSweepIP,
 	})
-	resource.AddTestSweepers("scaleway_instance_placement_group", &resource.Sweeper{
-		Name: "scaleway_instance_placement_group",
-		F:    testSweepPlacementGroup,
+	acctest.AddTestSweepers("scaleway_instance_placement_group", &resource.Sweeper{
+		Name:         "scaleway_instance_placement_group",
+		Dependencies: []string{"scaleway_instance_server"},
+		F:            testSweepPlacementGroup,
 	})
-	resource.AddTestSweepers("scaleway_instance_security_group", &resource.Sweeper{
-		Name: "scaleway_instance_security_group",
-		F:    testSweepSecurityGroup,
+	acctest.AddTestSweepers("scaleway_instance_security_group", &resource.Sweeper{
+		Name:         "scaleway_instance_security_group",
+		Dependencies: []string{"scaleway_instance_server"},
+		F:            testSweepSecurityGroup,
 	})
-	resource.AddTestSweepers("scaleway_instance_server", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_instance_server", &resource.Sweeper{
 		Name: "scaleway_instance_server",
 		F:    testSweepServer,
 	})
-	resource.AddTestSweepers("scaleway_instance_snapshot", &resource.Sweeper{
-		Name: "scaleway_instance_snapshot",
-		F:    testSweepSnapshot,
+	acctest.AddTestSweepers("scaleway_instance_snapshot", &resource.Sweeper{
+		Name:         "scaleway_instance_snapshot",
+		Dependencies: []string{"scaleway_instance_image"},
+		F:            testSweepSnapshot,
 	})
-	resource.AddTestSweepers("scaleway_instance_volume", &resource.Sweeper{
-		Name: "scaleway_instance_volume",
-		F:    testSweepVolume,
+	acctest.AddTestSweepers("scaleway_instance_volume", &resource.Sweeper{
+		Name:         "scaleway_instance_volume",
+		Dependencies: []string{"scaleway_instance_server"},
+		F:            testSweepVolume,
 	})
 }
 
@@ -56,9 +61,17 @@ func testSweepVolume(_ string) error {
 
 		for _, volume := range listVolumesResponse.Volumes {
 			if volume.Server == nil {
-				err := instanceAPI.DeleteVolume(&instanceSDK.DeleteVolumeRequest{
-					Zone:     zone,
-					VolumeID: volume.ID,
+				err := acctest.SweepResource(acctest.SweptResource{
+					Type:      "scaleway_instance_volume",
+					Locality:  zone.String(),
+					ID:        volume.ID,
+					Name:      volume.Name,
+					CreatedAt: volume.CreationDate,
+				}, func() error {
+					return instanceAPI.DeleteVolume(&instanceSDK.DeleteVolumeRequest{
+						Zone:     zone,
+						VolumeID: volume.ID,
+					})
 				})
 				if err != nil {
 					return fmt.Errorf("error deleting volume in sweeper: %s", err)
@@ -82,9 +95,17 @@ func testSweepSnapshot(_ string) error {
 		}
 
 		for _, snapshot := range listSnapshotsResponse.Snapshots {
-			err := api.DeleteSnapshot(&instanceSDK.DeleteSnapshotRequest{
-				Zone:       zone,
-				SnapshotID: snapshot.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_instance_snapshot",
+				Locality:  zone.String(),
+				ID:        snapshot.ID,
+				Name:      snapshot.Name,
+				CreatedAt: snapshot.CreationDate,
+			}, func() error {
+				return api.DeleteSnapshot(&instanceSDK.DeleteSnapshotRequest{
+					Zone:       zone,
+					SnapshotID: snapshot.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting instance snapshot in sweeper: %w", err)
@@ -106,23 +127,31 @@ func testSweepServer(_ string) error {
 		}
 
 		for _, srv := range listServers.Servers {
-			if srv.State == instanceSDK.ServerStateStopped || srv.State == instanceSDK.ServerStateStoppedInPlace {
-				err := instanceAPI.DeleteServer(&instanceSDK.DeleteServerRequest{
-					Zone:     zone,
-					ServerID: srv.ID,
-				})
-				if err != nil {
-					return fmt.Errorf("error deleting server in sweeper: %s", err)
+			if srv.State != instanceSDK.ServerStateStopped && srv.State != instanceSDK.ServerStateStoppedInPlace && srv.State != instanceSDK.ServerStateRunning {
+				continue
+			}
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_instance_server",
+				Locality:  zone.String(),
+				ID:        srv.ID,
+				Name:      srv.Name,
+				CreatedAt: srv.CreationDate,
+			}, func() error {
+				if srv.State == instanceSDK.ServerStateRunning {
+					_, err := instanceAPI.ServerAction(&instanceSDK.ServerActionRequest{
+						Zone:     zone,
+						ServerID: srv.ID,
+						Action:   instanceSDK.ServerActionTerminate,
+					})
+					return err
 				}
-			} else if srv.State == instanceSDK.ServerStateRunning {
-				_, err := instanceAPI.ServerAction(&instanceSDK.ServerActionRequest{
+				return instanceAPI.DeleteServer(&instanceSDK.DeleteServerRequest{
 					Zone:     zone,
 					ServerID: srv.ID,
-					Action:   instanceSDK.ServerActionTerminate,
 				})
-				if err != nil {
-					return fmt.Errorf("error terminating server in sweeper: %s", err)
-				}
+			})
+			if err != nil {
+				return fmt.Errorf("error deleting server in sweeper: %s", err)
 			}
 		}
 
@@ -148,9 +177,17 @@ func testSweepSecurityGroup(_ string) error {
 			if securityGroup.ProjectDefault {
 				continue
 			}
-			err = instanceAPI.DeleteSecurityGroup(&instanceSDK.DeleteSecurityGroupRequest{
-				Zone:            zone,
-				SecurityGroupID: securityGroup.ID,
+			err = acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_instance_security_group",
+				Locality:  zone.String(),
+				ID:        securityGroup.ID,
+				Name:      securityGroup.Name,
+				CreatedAt: securityGroup.CreationDate,
+			}, func() error {
+				return instanceAPI.DeleteSecurityGroup(&instanceSDK.DeleteSecurityGroupRequest{
+					Zone:            zone,
+					SecurityGroupID: securityGroup.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting security groups in sweeper: %s", err)
@@ -174,9 +211,16 @@ func testSweepPlacementGroup(_ string) error {
 		}
 
 		for _, pg := range listPlacementGroups.PlacementGroups {
-			err := instanceAPI.DeletePlacementGroup(&instanceSDK.DeletePlacementGroupRequest{
-				Zone:             zone,
-				PlacementGroupID: pg.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:     "scaleway_instance_placement_group",
+				Locality: zone.String(),
+				ID:       pg.ID,
+				Name:     pg.Name,
+			}, func() error {
+				return instanceAPI.DeletePlacementGroup(&instanceSDK.DeletePlacementGroupRequest{
+					Zone:             zone,
+					PlacementGroupID: pg.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting placement group in sweeper: %s", err)
@@ -198,9 +242,16 @@ func testSweepIP(_ string) error {
 		}
 
 		for _, ip := range listIPs.IPs {
-			err := instanceAPI.DeleteIP(&instanceSDK.DeleteIPRequest{
-				IP:   ip.ID,
-				Zone: zone,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:         "scaleway_instance_ip",
+				Locality:     zone.String(),
+				ID:           ip.ID,
+				SweepUnnamed: true,
+			}, func() error {
+				return instanceAPI.DeleteIP(&instanceSDK.DeleteIPRequest{
+					IP:   ip.ID,
+					Zone: zone,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting ip in sweeper: %s", err)
@@ -225,9 +276,17 @@ func testSweepImage(_ string) error {
 		}
 
 		for _, image := range listImagesResponse.Images {
-			err := api.DeleteImage(&instanceSDK.DeleteImageRequest{
-				Zone:    zone,
-				ImageID: image.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_instance_image",
+				Locality:  zone.String(),
+				ID:        image.ID,
+				Name:      image.Name,
+				CreatedAt: image.CreationDate,
+			}, func() error {
+				return api.DeleteImage(&instanceSDK.DeleteImageRequest{
+					Zone:    zone,
+					ImageID: image.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting instance image in sweeper: %w", err)
@@ -59,13 +59,13 @@ func ResourceUserData() *schema.Resource {
 func ResourceInstanceUserDataCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	serverID := locality.ExpandID(d.Get("server_id").(string))
 	server, err := waitForServer(ctx, instanceAPI, zone, serverID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	key := d.Get("key").(string)
@@ -84,7 +84,7 @@ func ResourceInstanceUserDataCreate(ctx context.Context, d *schema.ResourceData,
 
 	err = instanceAPI.SetServerUserData(userDataRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewNestedIDString(zone, key, server.ID))
@@ -95,12 +95,12 @@ func ResourceInstanceUserDataCreate(ctx context.Context, d *schema.ResourceData,
 func ResourceInstanceUserDataRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, key, err := NewAPIWithZoneAndNestedID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	server, err := waitForServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	requestGetUserData := &instanceSDK.GetServerUserDataRequest{
@@ -120,12 +120,12 @@ func ResourceInstanceUserDataRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	userDataValue, err := io.ReadAll(serverUserDataRawValue)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("server_id", zonal.NewID(zone, server.ID).String())
 	_ = d.Set("key", key)
@@ -138,12 +138,12 @@ func ResourceInstanceUserDataRead(ctx context.Context, d *schema.ResourceData, m
 func ResourceInstanceUserDataUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, key, err := NewAPIWithZoneAndNestedID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	server, err := waitForServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	userDataRequest := &instanceSDK.SetServerUserDataRequest{
@@ -163,12 +163,12 @@ func ResourceInstanceUserDataUpdate(ctx context.Context, d *schema.ResourceData,
 
 	err = instanceAPI.SetServerUserData(userDataRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceInstanceUserDataRead(ctx, d, m)
@@ -177,7 +177,7 @@ func ResourceInstanceUserDataUpdate(ctx context.Context, d *schema.ResourceData,
 func ResourceInstanceUserDataDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, key, err := NewAPIWithZoneAndNestedID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	deleteUserData := &instanceSDK.DeleteServerUserDataRequest{
@@ -193,7 +193,7 @@ func ResourceInstanceUserDataDelete(ctx context.Context, d *schema.ResourceData,
 	err = instanceAPI.DeleteServerUserData(deleteUserData, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -86,7 +86,7 @@ func ResourceVolume() *schema.Resource {
 func ResourceInstanceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	createVolumeRequest := &instanceSDK.CreateVolumeRequest{
@@ -111,7 +111,7 @@ func ResourceInstanceVolumeCreate(ctx context.Context, d *schema.ResourceData, m
 
 	res, err := instanceAPI.CreateVolume(createVolumeRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't create volume: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't create volume: %w", err))
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.Volume.ID))
@@ -123,7 +123,7 @@ func ResourceInstanceVolumeCreate(ctx context.Context, d *schema.ResourceData, m
 		Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutCreate)),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceInstanceVolumeRead(ctx, d, m)
@@ -132,7 +132,7 @@ func ResourceInstanceVolumeCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceInstanceVolumeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := instanceAPI.GetVolume(&instanceSDK.GetVolumeRequest{
@@ -144,7 +144,7 @@ func ResourceInstanceVolumeRead(ctx context.Context, d *schema.ResourceData, m i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("couldn't read volume: %v", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't read volume: %w", err))
 	}
 
 	_ = d.Set("name", res.Volume.Name)
@@ -171,7 +171,7 @@ func ResourceInstanceVolumeRead(ctx context.Context, d *schema.ResourceData, m i
 func ResourceInstanceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &instanceSDK.UpdateVolumeRequest{
@@ -186,7 +186,7 @@ func ResourceInstanceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	tags := types.ExpandStrings(d.Get("tags"))
-	if d.HasChange("tags") && len(tags) > 0 {
+	if d.HasChanges("tags", "tags_all") && len(tags) > 0 {
 		req.Tags = scw.StringsPtr(types.ExpandStrings(d.Get("tags")))
 	}
 
@@ -200,7 +200,7 @@ func ResourceInstanceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m
 
 		_, err = waitForVolume(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		volumeSizeInBytes := scw.Size(uint64(d.Get("size_in_gb").(int)) * gb)
@@ -210,17 +210,17 @@ func ResourceInstanceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m
 			Size:     &volumeSizeInBytes,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("couldn't resize volume: %s", err))
+			return httperrors.Diagnostics(fmt.Errorf("couldn't resize volume: %w", err))
 		}
 		_, err = waitForVolume(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	_, err = instanceAPI.UpdateVolume(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't update volume: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't update volume: %w", err))
 	}
 
 	return ResourceInstanceVolumeRead(ctx, d, m)
@@ -229,7 +229,7 @@ func ResourceInstanceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m
 func ResourceInstanceVolumeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	volume, err := instanceAPI.WaitForVolume(&instanceSDK.WaitForVolumeRequest{
@@ -242,7 +242,7 @@ func ResourceInstanceVolumeDelete(ctx context.Context, d *schema.ResourceData, m
 		if httperrors.Is404(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if volume.Server != nil {
@@ -256,7 +256,7 @@ func ResourceInstanceVolumeDelete(ctx context.Context, d *schema.ResourceData, m
 
 	err = instanceAPI.DeleteVolume(deleteRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
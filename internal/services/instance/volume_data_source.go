@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -37,7 +38,7 @@ func DataSourceVolume() *schema.Resource {
 func DataSourceInstanceVolumeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	volumeID, ok := d.GetOk("volume_id")
@@ -49,7 +50,7 @@ func DataSourceInstanceVolumeRead(ctx context.Context, d *schema.ResourceData, m
 			Project: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundVolume, err := datasource.FindExact(
@@ -58,7 +59,7 @@ func DataSourceInstanceVolumeRead(ctx context.Context, d *schema.ResourceData, m
 			volumeName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		volumeID = foundVolume.ID
@@ -68,7 +69,7 @@ func DataSourceInstanceVolumeRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(zonedID)
 	err = d.Set("volume_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	return ResourceInstanceVolumeRead(ctx, d, m)
 }
@@ -6,102 +6,165 @@ import (
 
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitForSnapshot(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Snapshot, error) {
-	retryInterval := defaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForSnapshot(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (_ *instance.Snapshot, err error) {
+	ctx, span := tracing.Start(ctx, "waitForSnapshot",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[instance.SnapshotState]struct{}{
+		instance.SnapshotStateAvailable: {},
+		instance.SnapshotStateError:     {},
 	}
 
-	snapshot, err := api.WaitForSnapshot(&instance.WaitForSnapshotRequest{
-		SnapshotID:    id,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	snapshot, err := transport.Poll(ctx, defaultInstanceRetryInterval, timeout, func(ctx context.Context) (*instance.Snapshot, bool, error) {
+		res, err := api.GetSnapshot(&instance.GetSnapshotRequest{
+			SnapshotID: id,
+			Zone:       zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Snapshot.State]
+		return res.Snapshot, isTerminal, nil
+	})
 
 	return snapshot, err
 }
 
-func waitForVolume(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Volume, error) {
-	retryInterval := defaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForVolume(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (_ *instance.Volume, err error) {
+	ctx, span := tracing.Start(ctx, "waitForVolume",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[instance.VolumeState]struct{}{
+		instance.VolumeStateAvailable: {},
+		instance.VolumeStateError:     {},
 	}
 
-	volume, err := api.WaitForVolume(&instance.WaitForVolumeRequest{
-		VolumeID:      id,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	volume, err := transport.Poll(ctx, defaultInstanceRetryInterval, timeout, func(ctx context.Context) (*instance.Volume, bool, error) {
+		res, err := api.GetVolume(&instance.GetVolumeRequest{
+			VolumeID: id,
+			Zone:     zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Volume.State]
+		return res.Volume, isTerminal, nil
+	})
 	return volume, err
 }
 
-func waitForServer(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Server, error) {
-	retryInterval := defaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForServer(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (_ *instance.Server, err error) {
+	ctx, span := tracing.Start(ctx, "waitForServer",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[instance.ServerState]struct{}{
+		instance.ServerStateStopped:        {},
+		instance.ServerStateStoppedInPlace: {},
+		instance.ServerStateLocked:         {},
+		instance.ServerStateRunning:        {},
 	}
 
-	server, err := api.WaitForServer(&instance.WaitForServerRequest{
-		Zone:          zone,
-		ServerID:      id,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	server, err := transport.Poll(ctx, defaultInstanceRetryInterval, timeout, func(ctx context.Context) (*instance.Server, bool, error) {
+		res, err := api.GetServer(&instance.GetServerRequest{
+			Zone:     zone,
+			ServerID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Server.State]
+		return res.Server, isTerminal, nil
+	})
 
 	return server, err
 }
 
-func waitForPrivateNIC(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, serverID string, privateNICID string, timeout time.Duration) (*instance.PrivateNIC, error) {
-	retryInterval := defaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForPrivateNIC(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, serverID string, privateNICID string, timeout time.Duration) (_ *instance.PrivateNIC, err error) {
+	ctx, span := tracing.Start(ctx, "waitForPrivateNIC",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(serverID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[instance.PrivateNICState]struct{}{
+		instance.PrivateNICStateAvailable:    {},
+		instance.PrivateNICStateSyncingError: {},
 	}
 
-	nic, err := instanceAPI.WaitForPrivateNIC(&instance.WaitForPrivateNICRequest{
-		ServerID:      serverID,
-		PrivateNicID:  privateNICID,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: scw.TimeDurationPtr(retryInterval),
-	}, scw.WithContext(ctx))
+	nic, err := transport.Poll(ctx, defaultInstanceRetryInterval, timeout, func(ctx context.Context) (*instance.PrivateNIC, bool, error) {
+		res, err := instanceAPI.GetPrivateNIC(&instance.GetPrivateNICRequest{
+			ServerID:     serverID,
+			PrivateNicID: privateNICID,
+			Zone:         zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.PrivateNic.State]
+		return res.PrivateNic, isTerminal, nil
+	})
 
 	return nic, err
 }
 
-func waitForMACAddress(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, serverID string, privateNICID string, timeout time.Duration) (*instance.PrivateNIC, error) {
-	retryInterval := defaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
-
-	nic, err := instanceAPI.WaitForMACAddress(&instance.WaitForMACAddressRequest{
-		ServerID:      serverID,
-		PrivateNicID:  privateNICID,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: scw.TimeDurationPtr(retryInterval),
-	}, scw.WithContext(ctx))
+func waitForMACAddress(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, serverID string, privateNICID string, timeout time.Duration) (_ *instance.PrivateNIC, err error) {
+	ctx, span := tracing.Start(ctx, "waitForMACAddress",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(serverID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	nic, err := transport.Poll(ctx, defaultInstanceRetryInterval, timeout, func(ctx context.Context) (*instance.PrivateNIC, bool, error) {
+		res, err := instanceAPI.GetPrivateNIC(&instance.GetPrivateNICRequest{
+			ServerID:     serverID,
+			PrivateNicID: privateNICID,
+			Zone:         zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		return res.PrivateNic, len(res.PrivateNic.MacAddress) > 0, nil
+	})
 
 	return nic, err
 }
 
-func waitForImage(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (*instance.Image, error) {
-	retryInterval := defaultInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForImage(ctx context.Context, api *instance.API, zone scw.Zone, id string, timeout time.Duration) (_ *instance.Image, err error) {
+	ctx, span := tracing.Start(ctx, "waitForImage",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[instance.ImageState]struct{}{
+		instance.ImageStateAvailable: {},
+		instance.ImageStateError:     {},
 	}
 
-	image, err := api.WaitForImage(&instance.WaitForImageRequest{
-		ImageID:       id,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	image, err := transport.Poll(ctx, defaultInstanceRetryInterval, timeout, func(ctx context.Context) (*instance.Image, bool, error) {
+		res, err := api.GetImage(&instance.GetImageRequest{
+			ImageID: id,
+			Zone:    zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Image.State]
+		return res.Image, isTerminal, nil
+	})
 
 	return image, err
 }
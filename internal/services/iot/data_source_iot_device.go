@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/iot/v1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -36,7 +37,7 @@ func DataSourceDevice() *schema.Resource {
 func DataSourceIotDeviceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := iotAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	deviceID, ok := d.GetOk("device_id")
@@ -45,7 +46,7 @@ func DataSourceIotDeviceRead(ctx context.Context, d *schema.ResourceData, m inte
 		if hubIDExists {
 			_, hubID, err = regional.ParseID(hubID.(string))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 		deviceName := d.Get("name").(string)
@@ -55,7 +56,7 @@ func DataSourceIotDeviceRead(ctx context.Context, d *schema.ResourceData, m inte
 			HubID:  types.ExpandStringPtr(hubID),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundDevice, err := datasource.FindExact(
@@ -64,7 +65,7 @@ func DataSourceIotDeviceRead(ctx context.Context, d *schema.ResourceData, m inte
 			deviceName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		deviceID = foundDevice.ID
@@ -74,7 +75,7 @@ func DataSourceIotDeviceRead(ctx context.Context, d *schema.ResourceData, m inte
 	d.SetId(regionalID)
 	err = d.Set("device_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	diags := ResourceIotDeviceRead(ctx, d, m)
 	if diags != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/iot/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -35,7 +36,7 @@ func DataSourceHub() *schema.Resource {
 func DataSourceIotHubRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := iotAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	hubID, ok := d.GetOk("hub_id")
@@ -47,7 +48,7 @@ func DataSourceIotHubRead(ctx context.Context, d *schema.ResourceData, m interfa
 			Name:      types.ExpandStringPtr(hubName),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundHub, err := datasource.FindExact(
@@ -56,7 +57,7 @@ func DataSourceIotHubRead(ctx context.Context, d *schema.ResourceData, m interfa
 			hubName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		hubID = foundHub.ID
@@ -66,7 +67,7 @@ func DataSourceIotHubRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.SetId(regionalID)
 	err = d.Set("hub_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	diags := ResourceIotHubRead(ctx, d, m)
 	if diags != nil {
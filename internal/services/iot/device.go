@@ -184,7 +184,7 @@ func ResourceDevice() *schema.Resource {
 func ResourceIotDeviceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, err := iotAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &iot.CreateDeviceRequest{
@@ -242,7 +242,7 @@ func ResourceIotDeviceCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	res, err := iotAPI.CreateDevice(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, res.Device.ID))
@@ -257,7 +257,7 @@ func ResourceIotDeviceCreate(ctx context.Context, d *schema.ResourceData, m inte
 			CertificatePem: devCrt.(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	} else {
 		// Update certificate and key as they cannot be retrieved later.
@@ -274,7 +274,7 @@ func ResourceIotDeviceCreate(ctx context.Context, d *schema.ResourceData, m inte
 func ResourceIotDeviceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, deviceID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	device, err := iotAPI.GetDevice(&iot.GetDeviceRequest{
@@ -286,7 +286,7 @@ func ResourceIotDeviceRead(ctx context.Context, d *schema.ResourceData, m interf
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", device.Name)
@@ -343,7 +343,7 @@ func ResourceIotDeviceRead(ctx context.Context, d *schema.ResourceData, m interf
 			DeviceID: deviceID,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		// Set device certificate.
 		cert := map[string]interface{}{
@@ -359,7 +359,7 @@ func ResourceIotDeviceRead(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceIotDeviceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, deviceID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	updateRequest := &iot.UpdateDeviceRequest{
@@ -415,7 +415,7 @@ func ResourceIotDeviceUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	_, err = iotAPI.UpdateDevice(updateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// Set the device certificate if changed
@@ -426,7 +426,7 @@ func ResourceIotDeviceUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			CertificatePem: d.Get("certificate.0.crt").(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -436,7 +436,7 @@ func ResourceIotDeviceUpdate(ctx context.Context, d *schema.ResourceData, m inte
 func ResourceIotDeviceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, deviceID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = iotAPI.DeleteDevice(&iot.DeleteDeviceRequest{
@@ -445,7 +445,7 @@ func ResourceIotDeviceDelete(ctx context.Context, d *schema.ResourceData, m inte
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
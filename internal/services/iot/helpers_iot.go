@@ -37,17 +37,23 @@ func NewAPIWithRegionAndID(m interface{}, id string) (*iot.API, scw.Region, stri
 }
 
 func waitIotHub(ctx context.Context, api *iot.API, region scw.Region, id string, timeout time.Duration) (*iot.Hub, error) {
-	retryInterval := defaultIoTRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	terminalStatus := map[iot.HubStatus]struct{}{
+		iot.HubStatusError:    {},
+		iot.HubStatusReady:    {},
+		iot.HubStatusDisabled: {},
 	}
 
-	hub, err := api.WaitForHub(&iot.WaitForHubRequest{
-		HubID:         id,
-		Region:        region,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	hub, err := transport.Poll(ctx, defaultIoTRetryInterval, timeout, func(ctx context.Context) (*iot.Hub, bool, error) {
+		res, err := api.GetHub(&iot.GetHubRequest{
+			HubID:  id,
+			Region: region,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return hub, err
 }
@@ -128,7 +128,7 @@ func ResourceHub() *schema.Resource {
 func ResourceIotHubCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, err := iotAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	req := &iot.CreateHubRequest{
 		Region:      region,
@@ -150,13 +150,13 @@ func ResourceIotHubCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	res, err := iotAPI.CreateHub(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	d.SetId(regional.NewIDString(region, res.ID))
 
 	_, err = waitIotHub(ctx, iotAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// Set user CA if needed. It cannot currently be added in the create hub request.
@@ -168,7 +168,7 @@ func ResourceIotHubCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			ChallengeCertPem: d.Get("hub_ca_challenge").(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -178,7 +178,7 @@ func ResourceIotHubCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			EnableDeviceAutoProvisioning: scw.BoolPtr(devProv.(bool)),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -192,12 +192,12 @@ func ResourceIotHubCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			HubID:  res.ID,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitIotHub(ctx, iotAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 	MQTTUrl := computeIotHubCaURL(req.ProductPlan, region)
@@ -209,7 +209,7 @@ func ResourceIotHubCreate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceIotHubRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, hubID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	response, err := iotAPI.GetHub(&iot.GetHubRequest{
@@ -221,7 +221,7 @@ func ResourceIotHubRead(ctx context.Context, d *schema.ResourceData, m interface
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("region", string(region))
@@ -258,7 +258,7 @@ func ResourceIotHubRead(ctx context.Context, d *schema.ResourceData, m interface
 func ResourceIotHubUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, hubID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////
@@ -280,12 +280,12 @@ func ResourceIotHubUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			}, scw.WithContext(ctx))
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -300,7 +300,7 @@ func ResourceIotHubUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			ChallengeCertPem: d.Get("hub_ca_challenge").(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -337,7 +337,7 @@ func ResourceIotHubUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	////
 	_, err = iotAPI.UpdateHub(updateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceIotHubRead(ctx, d, m)
@@ -346,7 +346,7 @@ func ResourceIotHubUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceIotHubDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = iotAPI.DeleteHub(&iot.DeleteHubRequest{
@@ -355,12 +355,12 @@ func ResourceIotHubDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		// Don't force delete if devices. This avoids deleting a hub by mistake
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitIotHub(ctx, iotAPI, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -82,7 +82,7 @@ func ResourceNetwork() *schema.Resource {
 func ResourceIotNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, err := iotAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &iot.CreateNetworkRequest{
@@ -98,7 +98,7 @@ func ResourceIotNetworkCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	res, err := iotAPI.CreateNetwork(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, res.Network.ID))
@@ -112,7 +112,7 @@ func ResourceIotNetworkCreate(ctx context.Context, d *schema.ResourceData, m int
 func ResourceIotNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, networkID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	network, err := iotAPI.GetNetwork(&iot.GetNetworkRequest{
@@ -124,7 +124,7 @@ func ResourceIotNetworkRead(ctx context.Context, d *schema.ResourceData, m inter
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", network.Name)
@@ -141,7 +141,7 @@ func ResourceIotNetworkRead(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceIotNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, networkID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	hubID := zonal.ExpandID(d.Get("hub_id").(string)).ID
@@ -152,13 +152,13 @@ func ResourceIotNetworkDelete(ctx context.Context, d *schema.ResourceData, m int
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
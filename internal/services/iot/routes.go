@@ -191,13 +191,13 @@ func ResourceRoute() *schema.Resource {
 func ResourceIotRouteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, err := iotAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	hubID := zonal.ExpandID(d.Get("hub_id")).ID
 	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &iot.CreateRouteRequest{
@@ -238,14 +238,14 @@ func ResourceIotRouteCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	res, err := iotAPI.CreateRoute(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, res.ID))
 
 	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceIotRouteRead(ctx, d, m)
@@ -254,7 +254,7 @@ func ResourceIotRouteCreate(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceIotRouteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, routeID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	response, err := iotAPI.GetRoute(&iot.GetRouteRequest{
@@ -266,7 +266,7 @@ func ResourceIotRouteRead(ctx context.Context, d *schema.ResourceData, m interfa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("region", string(region))
@@ -310,13 +310,13 @@ func ResourceIotRouteRead(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceIotRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	iotAPI, region, routeID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	hubID := zonal.ExpandID(d.Get("hub_id")).ID
 	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = iotAPI.DeleteRoute(&iot.DeleteRouteRequest{
@@ -327,12 +327,12 @@ func ResourceIotRouteDelete(ctx context.Context, d *schema.ResourceData, m inter
 		if httperrors.Is404(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutCreate))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_iot_hub", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_iot_hub", &resource.Sweeper{
 		Name: "scaleway_iot_hub",
 		F:    testSweepHub,
 	})
@@ -29,10 +29,18 @@ func testSweepHub(_ string) error {
 
 		deleteDevices := true
 		for _, hub := range listHubs.Hubs {
-			err := iotAPI.DeleteHub(&iotSDK.DeleteHubRequest{
-				HubID:         hub.ID,
-				Region:        hub.Region,
-				DeleteDevices: &deleteDevices,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_iot_hub",
+				Locality:  region.String(),
+				ID:        hub.ID,
+				Name:      hub.Name,
+				CreatedAt: hub.CreatedAt,
+			}, func() error {
+				return iotAPI.DeleteHub(&iotSDK.DeleteHubRequest{
+					HubID:         hub.ID,
+					Region:        hub.Region,
+					DeleteDevices: &deleteDevices,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting hub in sweeper: %s", err)
@@ -172,7 +172,7 @@ func ResourceIP() *schema.Resource {
 func ResourceIPAMIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ipamAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &ipam.BookIPRequest{
@@ -189,7 +189,7 @@ func ResourceIPAMIPCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		if err != nil {
 			parsedIP = net.ParseIP(addressStr)
 			if parsedIP == nil {
-				return diag.FromErr(fmt.Errorf("error parsing IP address: %s", err))
+				return httperrors.Diagnostics(fmt.Errorf("error parsing IP address: %w", err))
 			}
 		}
 		req.Address = scw.IPPtr(parsedIP)
@@ -205,7 +205,7 @@ func ResourceIPAMIPCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	res, err := ipamAPI.BookIP(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, res.ID))
@@ -216,11 +216,11 @@ func ResourceIPAMIPCreate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ipamAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	vpcAPI, err := vpc.NewAPI(m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := ipamAPI.GetIP(&ipam.GetIPRequest{
@@ -232,7 +232,7 @@ func ResourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m interface
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	privateNetworkID := ""
@@ -244,7 +244,7 @@ func ResourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m interface
 				Region:           region,
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			ipv4Subnets, ipv6Subnets := vpc.FlattenAndSortSubnets(pn.Subnets)
@@ -264,7 +264,7 @@ func ResourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	address, err := types.FlattenIPNet(res.Address)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("address", address)
 	_ = d.Set("source", flattenIPSource(res.Source, privateNetworkID))
@@ -288,7 +288,7 @@ func ResourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m interface
 func ResourceIPAMIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ipamAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChange("custom_resource") {
@@ -303,18 +303,18 @@ func ResourceIPAMIPUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			ToResource:   newCustomResource,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		_, err = ipamAPI.UpdateIP(&ipam.UpdateIPRequest{
 			IPID:   ID,
 			Region: region,
 			Tags:   types.ExpandUpdatedStringsPtr(d.Get("tags")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -324,7 +324,7 @@ func ResourceIPAMIPUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceIPAMIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ipamAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if customResource, ok := d.GetOk("custom_resource"); ok {
@@ -334,7 +334,7 @@ func ResourceIPAMIPDelete(ctx context.Context, d *schema.ResourceData, m interfa
 			Resource: expandCustomResource(customResource),
 		}, scw.WithContext(ctx))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -343,7 +343,7 @@ func ResourceIPAMIPDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		IPID:   ID,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
@@ -125,7 +126,7 @@ func DataSourceIP() *schema.Resource {
 func DataSourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var address, addressCidr string
@@ -205,7 +206,7 @@ func DataSourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m interfa
 			return nil
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	} else {
 		res, err := api.GetIP(&ipam.GetIPRequest{
@@ -213,7 +214,7 @@ func DataSourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m interfa
 			IPID:   locality.ExpandID(IPID.(string)),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		ip = res.Address
@@ -222,7 +223,7 @@ func DataSourceIPAMIPRead(ctx context.Context, d *schema.ResourceData, m interfa
 	address = ip.IP.String()
 	addressCidr, err = types.FlattenIPNet(ip)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(IPID.(string))
@@ -55,7 +55,7 @@ func ResourceIPReverseDNS() *schema.Resource {
 func ResourceIPAMIPReverseDNSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ipamAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := ipamAPI.GetIP(&ipam.GetIPRequest{
@@ -63,7 +63,7 @@ func ResourceIPAMIPReverseDNSCreate(ctx context.Context, d *schema.ResourceData,
 		IPID:   locality.ExpandID(d.Get("ipam_ip_id")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, res.ID))
@@ -81,7 +81,7 @@ func ResourceIPAMIPReverseDNSCreate(ctx context.Context, d *schema.ResourceData,
 
 		_, err := ipamAPI.UpdateIP(updateReverseReq, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -91,7 +91,7 @@ func ResourceIPAMIPReverseDNSCreate(ctx context.Context, d *schema.ResourceData,
 func ResourceIPAMIPReverseDNSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ipamAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := ipamAPI.GetIP(&ipam.GetIPRequest{
@@ -103,7 +103,7 @@ func ResourceIPAMIPReverseDNSRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	managedHostname := d.Get("hostname").(string)
@@ -124,7 +124,7 @@ func ResourceIPAMIPReverseDNSRead(ctx context.Context, d *schema.ResourceData, m
 func ResourceIPAMIPReverseDNSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ipamAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChanges("hostname", "address") {
@@ -141,7 +141,7 @@ func ResourceIPAMIPReverseDNSUpdate(ctx context.Context, d *schema.ResourceData,
 
 		_, err := ipamAPI.UpdateIP(updateReverseReq, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -151,7 +151,7 @@ func ResourceIPAMIPReverseDNSUpdate(ctx context.Context, d *schema.ResourceData,
 func ResourceIPAMIPReverseDNSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	ipamAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	updateReverseReq := &ipam.UpdateIPRequest{
@@ -162,7 +162,7 @@ func ResourceIPAMIPReverseDNSDelete(ctx context.Context, d *schema.ResourceData,
 
 	_, err = ipamAPI.UpdateIP(updateReverseReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId("")
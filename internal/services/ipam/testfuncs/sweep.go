@@ -11,9 +11,21 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_ipam_ip", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_ipam_ip", &resource.Sweeper{
 		Name: "scaleway_ipam_ip",
-		F:    testSweepIPAMIP,
+		// IPs booked by resources attached to private networks are released with them
+		Dependencies: []string{
+			"scaleway_instance_server",
+			"scaleway_baremetal_server",
+			"scaleway_lb",
+			"scaleway_k8s_cluster",
+			"scaleway_rdb_instance",
+			"scaleway_redis_cluster",
+			"scaleway_mongodb_instance",
+			"scaleway_inference_deployment",
+			"scaleway_gateway_network",
+		},
+		F: testSweepIPAMIP,
 	})
 }
 
@@ -29,9 +41,17 @@ func testSweepIPAMIP(_ string) error {
 		}
 
 		for _, v := range listIPs.IPs {
-			err := ipamAPI.ReleaseIP(&ipamSDK.ReleaseIPRequest{
-				IPID:   v.ID,
-				Region: region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:         "scaleway_ipam_ip",
+				Locality:     region.String(),
+				ID:           v.ID,
+				SweepUnnamed: true,
+				CreatedAt:    v.CreatedAt,
+			}, func() error {
+				return ipamAPI.ReleaseIP(&ipamSDK.ReleaseIPRequest{
+					IPID:   v.ID,
+					Region: region,
+				})
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -97,7 +97,7 @@ func ResourceDefinition() *schema.Resource {
 func ResourceJobDefinitionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &jobs.CreateJobDefinitionRequest{
@@ -129,7 +129,7 @@ func ResourceJobDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 
 	definition, err := api.CreateJobDefinition(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, definition.ID))
@@ -140,7 +140,7 @@ func ResourceJobDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceJobDefinitionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	definition, err := api.GetJobDefinition(&jobs.GetJobDefinitionRequest{
@@ -152,7 +152,7 @@ func ResourceJobDefinitionRead(ctx context.Context, d *schema.ResourceData, m in
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", definition.Name)
@@ -173,7 +173,7 @@ func ResourceJobDefinitionRead(ctx context.Context, d *schema.ResourceData, m in
 func ResourceJobDefinitionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &jobs.UpdateJobDefinitionRequest{
@@ -230,7 +230,7 @@ func ResourceJobDefinitionUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if _, err := api.UpdateJobDefinition(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceJobDefinitionRead(ctx, d, m)
@@ -239,7 +239,7 @@ func ResourceJobDefinitionUpdate(ctx context.Context, d *schema.ResourceData, m
 func ResourceJobDefinitionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteJobDefinition(&jobs.DeleteJobDefinitionRequest{
@@ -247,7 +247,7 @@ func ResourceJobDefinitionDelete(ctx context.Context, d *schema.ResourceData, m
 		JobDefinitionID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId("")
@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_job_definition", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_job_definition", &resource.Sweeper{
 		Name: "scaleway_job_definition",
 		F:    testSweepJobDefinition,
 	})
@@ -30,9 +30,17 @@ func testSweepJobDefinition(_ string) error {
 		}
 
 		for _, definition := range listJobDefinitions.JobDefinitions {
-			err := jobsAPI.DeleteJobDefinition(&jobsSDK.DeleteJobDefinitionRequest{
-				JobDefinitionID: definition.ID,
-				Region:          region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_job_definition",
+				Locality:  region.String(),
+				ID:        definition.ID,
+				Name:      definition.Name,
+				CreatedAt: definition.CreatedAt,
+			}, func() error {
+				return jobsAPI.DeleteJobDefinition(&jobsSDK.DeleteJobDefinitionRequest{
+					JobDefinitionID: definition.ID,
+					Region:          region,
+				})
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
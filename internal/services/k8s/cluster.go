@@ -18,6 +18,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -151,6 +152,11 @@ func ResourceCluster() *schema.Resource {
 				Required:    true,
 				Description: "Delete additional resources like block volumes, load-balancers and the private network (if empty) on cluster deletion",
 			},
+			"skip_wait": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Return right after the create and update calls instead of waiting for the cluster and its pools to be ready",
+			},
 			"private_network_id": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -311,7 +317,7 @@ func ResourceCluster() *schema.Resource {
 func ResourceK8SClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////
@@ -477,7 +483,7 @@ func ResourceK8SClusterCreate(ctx context.Context, d *schema.ResourceData, m int
 	if versionIsOnlyMinor {
 		version, err = k8sGetLatestVersionFromMinor(ctx, k8sAPI, region, version)
 		if err != nil {
-			return append(diag.FromErr(err), diags...)
+			return append(httperrors.Diagnostics(err), diags...)
 		}
 	}
 
@@ -493,10 +499,13 @@ func ResourceK8SClusterCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	res, err := k8sAPI.CreateCluster(req, scw.WithContext(ctx))
 	if err != nil {
-		return append(diag.FromErr(err), diags...)
+		return append(httperrors.Diagnostics(err), diags...)
 	}
 
 	d.SetId(regional.NewIDString(region, res.ID))
+	if meta.ExtractSkipWait(d, m) {
+		return append(ResourceK8SClusterRead(ctx, d, m), diags...)
+	}
 	if strings.Contains(clusterType.(string), "multicloud") {
 		// In case of multi-cloud, we do not have the guarantee that a pool will be created in Scaleway.
 		_, err = waitCluster(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
@@ -505,7 +514,7 @@ func ResourceK8SClusterCreate(ctx context.Context, d *schema.ResourceData, m int
 		_, err = waitClusterPool(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 	}
 	if err != nil {
-		return append(diag.FromErr(err), diags...)
+		return append(httperrors.Diagnostics(err), diags...)
 	}
 
 	return append(ResourceK8SClusterRead(ctx, d, m), diags...)
@@ -514,19 +523,27 @@ func ResourceK8SClusterCreate(ctx context.Context, d *schema.ResourceData, m int
 func ResourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, clusterID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////
 	// Read Cluster
 	////
-	cluster, err := waitCluster(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutRead))
+	var cluster *k8s.Cluster
+	if meta.ExtractSkipWait(d, m) {
+		cluster, err = k8sAPI.GetCluster(&k8s.GetClusterRequest{
+			Region:    region,
+			ClusterID: clusterID,
+		}, scw.WithContext(ctx))
+	} else {
+		cluster, err = waitCluster(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutRead))
+	}
 	if err != nil {
 		if httperrors.Is404(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("region", string(region))
@@ -552,7 +569,7 @@ func ResourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m inter
 	if cluster.AutoUpgrade != nil && cluster.AutoUpgrade.Enabled {
 		version, err = GetMinorVersionFromFull(version)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 	_ = d.Set("version", version)
@@ -590,7 +607,7 @@ func ResourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m inter
 			})
 		}
 	} else {
-		diags = append(diags, diag.FromErr(err)...)
+		diags = append(diags, httperrors.Diagnostics(err)...)
 	}
 	_ = d.Set("kubeconfig", []map[string]interface{}{kubeconfig})
 
@@ -601,13 +618,13 @@ func ResourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, clusterID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChange("type") {
 		_, err = waitCluster(ctx, k8sAPI, region, clusterID, defaultK8SClusterTimeout)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		_, err = k8sAPI.SetClusterType(&k8s.SetClusterTypeRequest{
 			Region:    region,
@@ -615,12 +632,12 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 			Type:      d.Get("type").(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		// We have to wait for the pools to reach a stable state too (e.g. being detached from the private network)
 		_, err = waitClusterPool(ctx, k8sAPI, region, clusterID, defaultK8SClusterTimeout)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -653,7 +670,7 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 		updateRequest.Description = types.ExpandUpdatedStringPtr(d.Get("description"))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 
@@ -701,7 +718,7 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 	if versionIsOnlyMinor {
 		version, err = k8sGetLatestVersionFromMinor(ctx, k8sAPI, region, version)
 		if err != nil {
-			return append(diag.FromErr(err), diags...)
+			return append(httperrors.Diagnostics(err), diags...)
 		}
 	}
 
@@ -714,7 +731,7 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 			Region:    region,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return append(diag.FromErr(err), diags...)
+			return append(httperrors.Diagnostics(err), diags...)
 		}
 
 		if clusterResp.Version == version {
@@ -824,12 +841,17 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 	////
 	_, err = k8sAPI.UpdateCluster(updateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return append(diag.FromErr(err), diags...)
+		return append(httperrors.Diagnostics(err), diags...)
+	}
+
+	skipWait := meta.ExtractSkipWait(d, m)
+	if skipWait && !canUpgrade {
+		return append(ResourceK8SClusterRead(ctx, d, m), diags...)
 	}
 
 	_, err = waitCluster(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return append(diag.FromErr(err), diags...)
+		return append(httperrors.Diagnostics(err), diags...)
 	}
 
 	////
@@ -844,12 +866,15 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 		}
 		_, err = k8sAPI.UpgradeCluster(upgradeRequest)
 		if err != nil {
-			return append(diag.FromErr(err), diags...)
+			return append(httperrors.Diagnostics(err), diags...)
+		}
+		if skipWait {
+			return append(ResourceK8SClusterRead(ctx, d, m), diags...)
 		}
 
 		_, err = waitCluster(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return append(diag.FromErr(err), diags...)
+			return append(httperrors.Diagnostics(err), diags...)
 		}
 
 		if !strings.Contains(d.Get("type").(string), "multicloud") {
@@ -857,7 +882,7 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 			// But if we are not, we can wait for the pool to be upgraded.
 			_, err = waitClusterPool(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return append(diag.FromErr(err), diags...)
+				return append(httperrors.Diagnostics(err), diags...)
 			}
 		}
 	}
@@ -868,7 +893,7 @@ func ResourceK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, m int
 func ResourceK8SClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, clusterID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	deleteAdditionalResources := d.Get("delete_additional_resources").(bool)
@@ -885,12 +910,12 @@ func ResourceK8SClusterDelete(ctx context.Context, d *schema.ResourceData, m int
 		if httperrors.Is404(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitClusterStatus(ctx, k8sAPI, cluster, k8s.ClusterStatusDeleted, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
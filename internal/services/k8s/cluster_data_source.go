@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -19,6 +20,7 @@ func DataSourceCluster() *schema.Resource {
 	// Set 'Optional' schema elements
 	datasource.AddOptionalFieldsToSchema(dsSchema, "name", "region", "project_id")
 	delete(dsSchema, "delete_additional_resources")
+	delete(dsSchema, "skip_wait")
 
 	dsSchema["name"].ConflictsWith = []string{"cluster_id"}
 	dsSchema["cluster_id"] = &schema.Schema{
@@ -39,7 +41,7 @@ func DataSourceCluster() *schema.Resource {
 func DataSourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	clusterID, ok := d.GetOk("cluster_id")
@@ -51,7 +53,7 @@ func DataSourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m int
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundCluster, err := datasource.FindExact(
@@ -60,7 +62,7 @@ func DataSourceK8SClusterRead(ctx context.Context, d *schema.ResourceData, m int
 			clusterName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		clusterID = foundCluster.ID
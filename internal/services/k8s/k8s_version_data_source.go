@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 )
 
@@ -53,7 +54,7 @@ func DataSourceVersion() *schema.Resource {
 func DataSourceK8SVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	name, ok := d.GetOk("name")
@@ -68,7 +69,7 @@ func DataSourceK8SVersionRead(ctx context.Context, d *schema.ResourceData, m int
 			Region: region,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		if len(res.Versions) == 0 {
 			return diag.FromErr(errors.New("could not find the latest version"))
@@ -81,7 +82,7 @@ func DataSourceK8SVersionRead(ctx context.Context, d *schema.ResourceData, m int
 			VersionName: name.(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		version = res
 	}
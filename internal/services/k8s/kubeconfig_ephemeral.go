@@ -0,0 +1,114 @@
+package k8s
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/framework"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+const kubeconfigEphemeralResourceType = "scaleway_k8s_kubeconfig"
+
+var _ ephemeral.EphemeralResourceWithConfigure = (*KubeconfigEphemeralResource)(nil)
+
+// KubeconfigEphemeralResource reads the kubeconfig of a Kubernetes cluster without storing it in the state.
+type KubeconfigEphemeralResource struct {
+	meta *meta.Meta
+}
+
+func NewKubeconfigEphemeralResource() ephemeral.EphemeralResource { //nolint:ireturn
+	return &KubeconfigEphemeralResource{}
+}
+
+type kubeconfigEphemeralResourceModel struct {
+	ClusterID            types.String `tfsdk:"cluster_id"`
+	Region               types.String `tfsdk:"region"`
+	ConfigFile           types.String `tfsdk:"config_file"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+}
+
+func (r *KubeconfigEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = kubeconfigEphemeralResourceType
+}
+
+func (r *KubeconfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the kubeconfig of a Kubernetes cluster without storing it in the state",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Description: "The ID of the Kubernetes cluster",
+				Required:    true,
+				Validators: []validator.String{
+					framework.ValidateDiagFunc(verify.IsUUIDorUUIDWithLocality()),
+				},
+			},
+			"region": framework.RegionEphemeralAttribute(),
+			"config_file": schema.StringAttribute{
+				Description: "The whole kubeconfig file",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"host": schema.StringAttribute{
+				Description: "The URL of the Kubernetes API server",
+				Computed:    true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Description: "The Kubernetes cluster CA certificate",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "The token to connect to the Kubernetes API server",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *KubeconfigEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	m, diags := framework.ExtractMeta(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.meta = m
+}
+
+func (r *KubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, span := framework.StartOperation(ctx, r.meta, kubeconfigEphemeralResourceType, "open")
+	defer framework.EndOperation(span, &resp.Diagnostics)
+
+	var config kubeconfigEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, clusterID, err := framework.ExtractRegionalID(config.ClusterID, config.Region, r.meta)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+		return
+	}
+
+	kubeconfig, err := flattenKubeconfig(ctx, k8s.NewAPI(r.meta.ScwClient()), region, clusterID)
+	if err != nil {
+		framework.AddError(&resp.Diagnostics, "Cannot read the kubeconfig", err)
+		return
+	}
+
+	config.ClusterID = types.StringValue(regional.NewIDString(region, clusterID))
+	config.Region = types.StringValue(region.String())
+	config.ConfigFile = types.StringValue(kubeconfig["config_file"].(string))
+	config.Host = types.StringValue(kubeconfig["host"].(string))
+	config.ClusterCACertificate = types.StringValue(kubeconfig["cluster_ca_certificate"].(string))
+	config.Token = types.StringValue(kubeconfig["token"].(string))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
@@ -5,14 +5,18 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -23,7 +27,10 @@ func ResourcePool() *schema.Resource {
 		ReadContext:   ResourceK8SPoolRead,
 		UpdateContext: ResourceK8SPoolUpdate,
 		DeleteContext: ResourceK8SPoolDelete,
-		CustomizeDiff: ResourceK8SPoolCustomDiff,
+		CustomizeDiff: customdiff.All(
+			ResourceK8SPoolCustomDiff,
+			cdf.CapacityCheck(customDiffPoolCapacity, "node_type", "size"),
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -104,6 +111,11 @@ func ResourcePool() *schema.Resource {
 				Default:     true,
 				Description: "Whether to wait for the pool to be ready",
 			},
+			"skip_wait": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Return right after the create and update calls instead of waiting for the pool and its cluster to be ready",
+			},
 			"placement_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -230,7 +242,7 @@ func ResourcePool() *schema.Resource {
 func ResourceK8SPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////
@@ -303,33 +315,37 @@ func ResourceK8SPoolCreate(ctx context.Context, d *schema.ResourceData, m interf
 		Region:    region,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if cluster.Status == k8s.ClusterStatusCreating {
 		_, err = waitClusterStatus(ctx, k8sAPI, cluster, k8s.ClusterStatusReady, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	res, err := k8sAPI.CreatePool(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, res.ID))
 
+	if meta.ExtractSkipWait(d, m) {
+		return ResourceK8SPoolRead(ctx, d, m)
+	}
+
 	if d.Get("wait_for_pool_ready").(bool) { // wait for the pool to be ready if specified (including all its nodes)
 		_, err = waitPoolReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	_, err = waitCluster(ctx, k8sAPI, region, cluster.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceK8SPoolRead(ctx, d, m)
@@ -338,7 +354,7 @@ func ResourceK8SPoolCreate(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceK8SPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, poolID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &k8s.GetPoolRequest{
@@ -355,12 +371,12 @@ func ResourceK8SPoolRead(ctx context.Context, d *schema.ResourceData, m interfac
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	nodes, err := getNodes(ctx, k8sAPI, pool)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("cluster_id", regional.NewIDString(region, pool.ClusterID))
@@ -401,7 +417,7 @@ func ResourceK8SPoolRead(ctx context.Context, d *schema.ResourceData, m interfac
 func ResourceK8SPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, poolID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////
@@ -432,7 +448,7 @@ func ResourceK8SPoolUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		updateRequest.Size = scw.Uint32Ptr(uint32(d.Get("size").(int)))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 
@@ -455,13 +471,13 @@ func ResourceK8SPoolUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	res, err := k8sAPI.UpdatePool(updateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
-	if d.Get("wait_for_pool_ready").(bool) { // wait for the pool to be ready if specified (including all its nodes)
+	if d.Get("wait_for_pool_ready").(bool) && !meta.ExtractSkipWait(d, m) { // wait for the pool to be ready if specified (including all its nodes)
 		_, err = waitPoolReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -471,7 +487,7 @@ func ResourceK8SPoolUpdate(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceK8SPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, poolID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////
@@ -485,7 +501,7 @@ func ResourceK8SPoolDelete(ctx context.Context, d *schema.ResourceData, m interf
 	_, err = k8sAPI.DeletePool(req, scw.WithContext(ctx))
 	if err != nil {
 		if !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -495,7 +511,7 @@ func ResourceK8SPoolDelete(ctx context.Context, d *schema.ResourceData, m interf
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -511,3 +527,22 @@ func ResourceK8SPoolCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ i
 	}
 	return nil
 }
+
+// customDiffPoolCapacity checks that the nodes added to a pool can be ordered.
+func customDiffPoolCapacity(ctx context.Context, diff *schema.ResourceDiff, client *scw.Client) error {
+	oldSize, newSize := diff.GetChange("size")
+	requested := newSize.(int)
+	if diff.Id() != "" && !diff.HasChange("node_type") {
+		requested -= oldSize.(int)
+	}
+	if requested <= 0 {
+		return nil
+	}
+
+	zone := scw.Zone(diff.Get("zone").(string))
+	if zone == "" {
+		zone, _ = client.GetDefaultZone()
+	}
+
+	return instance.CheckServerTypeCapacity(ctx, client, zone, diff.Get("node_type").(string), uint64(requested))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -16,6 +17,7 @@ import (
 func DataSourcePool() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasource.SchemaFromResourceSchema(ResourcePool().Schema)
+	delete(dsSchema, "skip_wait")
 
 	// Set 'Optional' schema elements
 	datasource.AddOptionalFieldsToSchema(dsSchema, "name", "region", "cluster_id", "size")
@@ -41,7 +43,7 @@ func DataSourcePool() *schema.Resource {
 func DataSourceK8SPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	k8sAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	poolID, ok := d.GetOk("pool_id")
@@ -54,7 +56,7 @@ func DataSourceK8SPoolRead(ctx context.Context, d *schema.ResourceData, m interf
 			ClusterID: clusterID.ID,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundPool, err := datasource.FindExact(
@@ -63,7 +65,7 @@ func DataSourceK8SPoolRead(ctx context.Context, d *schema.ResourceData, m interf
 			poolName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		poolID = foundPool.ID
@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_k8s_cluster", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_k8s_cluster", &resource.Sweeper{
 		Name: "scaleway_k8s_cluster",
 		F:    testSweepK8SCluster,
 	})
@@ -28,27 +28,36 @@ func testSweepK8SCluster(_ string) error {
 		}
 
 		for _, cluster := range listClusters.Clusters {
-			// remove pools
-			listPools, err := k8sAPI.ListPools(&k8sSDK.ListPoolsRequest{
-				Region:    region,
-				ClusterID: cluster.ID,
-			}, scw.WithAllPages())
-			if err != nil {
-				return fmt.Errorf("error listing pool in (%s) in sweeper: %s", region, err)
-			}
-
-			for _, pool := range listPools.Pools {
-				_, err := k8sAPI.DeletePool(&k8sSDK.DeletePoolRequest{
-					Region: region,
-					PoolID: pool.ID,
-				})
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_k8s_cluster",
+				Locality:  region.String(),
+				ID:        cluster.ID,
+				Name:      cluster.Name,
+				CreatedAt: cluster.CreatedAt,
+			}, func() error {
+				// remove pools
+				listPools, err := k8sAPI.ListPools(&k8sSDK.ListPoolsRequest{
+					Region:    region,
+					ClusterID: cluster.ID,
+				}, scw.WithAllPages())
 				if err != nil {
-					return fmt.Errorf("error deleting pool in sweeper: %s", err)
+					return fmt.Errorf("error listing pool in (%s) in sweeper: %s", region, err)
 				}
-			}
-			_, err = k8sAPI.DeleteCluster(&k8sSDK.DeleteClusterRequest{
-				Region:    region,
-				ClusterID: cluster.ID,
+
+				for _, pool := range listPools.Pools {
+					_, err := k8sAPI.DeletePool(&k8sSDK.DeletePoolRequest{
+						Region: region,
+						PoolID: pool.ID,
+					})
+					if err != nil {
+						return fmt.Errorf("error deleting pool in sweeper: %s", err)
+					}
+				}
+				_, err = k8sAPI.DeleteCluster(&k8sSDK.DeleteClusterRequest{
+					Region:    region,
+					ClusterID: cluster.ID,
+				})
+				return err
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting cluster in sweeper: %s", err)
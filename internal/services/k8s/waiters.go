@@ -8,52 +8,105 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitCluster(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string, timeout time.Duration) (*k8s.Cluster, error) {
-	retryInterval := defaultK8SRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitCluster(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string, timeout time.Duration) (_ *k8s.Cluster, err error) {
+	ctx, span := tracing.Start(ctx, "waitCluster",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(clusterID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[k8s.ClusterStatus]struct{}{
+		k8s.ClusterStatusReady:        {},
+		k8s.ClusterStatusLocked:       {},
+		k8s.ClusterStatusDeleted:      {},
+		k8s.ClusterStatusPoolRequired: {},
 	}
 
-	cluster, err := k8sAPI.WaitForCluster(&k8s.WaitForClusterRequest{
-		ClusterID:     clusterID,
-		Region:        region,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	cluster, err := transport.Poll(ctx, defaultK8SRetryInterval, timeout, func(ctx context.Context) (*k8s.Cluster, bool, error) {
+		cluster, err := k8sAPI.GetCluster(&k8s.GetClusterRequest{
+			ClusterID: clusterID,
+			Region:    region,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[cluster.Status]
+		return cluster, isTerminal, nil
+	})
 
 	return cluster, err
 }
 
-func waitClusterPool(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string, timeout time.Duration) (*k8s.Cluster, error) {
-	retryInterval := defaultK8SRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitClusterPool(ctx context.Context, k8sAPI *k8s.API, region scw.Region, clusterID string, timeout time.Duration) (_ *k8s.Cluster, err error) {
+	ctx, span := tracing.Start(ctx, "waitClusterPool",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(clusterID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalClusterStatus := map[k8s.ClusterStatus]struct{}{
+		k8s.ClusterStatusPoolRequired: {},
+		k8s.ClusterStatusReady:        {},
+	}
+	terminalPoolStatus := map[k8s.PoolStatus]struct{}{
+		k8s.PoolStatusReady:   {},
+		k8s.PoolStatusWarning: {},
 	}
 
-	return k8sAPI.WaitForClusterPool(&k8s.WaitForClusterRequest{
-		ClusterID:     clusterID,
-		Region:        region,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, defaultK8SRetryInterval, timeout, func(ctx context.Context) (*k8s.Cluster, bool, error) {
+		cluster, err := k8sAPI.GetCluster(&k8s.GetClusterRequest{
+			ClusterID: clusterID,
+			Region:    region,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		if _, isTerminal := terminalClusterStatus[cluster.Status]; !isTerminal {
+			return cluster, false, nil
+		}
+
+		pools, err := k8sAPI.ListPools(&k8s.ListPoolsRequest{
+			Region:    region,
+			ClusterID: clusterID,
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return nil, false, err
+		}
+		for _, pool := range pools.Pools {
+			if _, isTerminal := terminalPoolStatus[pool.Status]; !isTerminal {
+				return cluster, false, nil
+			}
+		}
+		return cluster, true, nil
+	})
 }
 
-func waitClusterStatus(ctx context.Context, k8sAPI *k8s.API, cluster *k8s.Cluster, status k8s.ClusterStatus, timeout time.Duration) (*k8s.Cluster, error) {
-	retryInterval := defaultK8SRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitClusterStatus(ctx context.Context, k8sAPI *k8s.API, cluster *k8s.Cluster, status k8s.ClusterStatus, timeout time.Duration) (_ *k8s.Cluster, err error) {
+	ctx, span := tracing.Start(ctx, "waitClusterStatus")
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[k8s.ClusterStatus]struct{}{
+		k8s.ClusterStatusReady:        {},
+		k8s.ClusterStatusLocked:       {},
+		k8s.ClusterStatusDeleted:      {},
+		k8s.ClusterStatusPoolRequired: {},
 	}
 
-	cluster, err := k8sAPI.WaitForCluster(&k8s.WaitForClusterRequest{
-		ClusterID:     cluster.ID,
-		Region:        cluster.Region,
-		Status:        status,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	cluster, err = transport.Poll(ctx, defaultK8SRetryInterval, timeout, func(ctx context.Context) (*k8s.Cluster, bool, error) {
+		cluster, err := k8sAPI.GetCluster(&k8s.GetClusterRequest{
+			ClusterID: cluster.ID,
+			Region:    cluster.Region,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[cluster.Status]
+		return cluster, isTerminal, nil
+	})
 	if err != nil {
 		if status == k8s.ClusterStatusDeleted && httperrors.Is404(err) {
 			return cluster, nil
@@ -64,18 +117,29 @@ func waitClusterStatus(ctx context.Context, k8sAPI *k8s.API, cluster *k8s.Cluste
 	return cluster, nil
 }
 
-func waitPoolReady(ctx context.Context, k8sAPI *k8s.API, region scw.Region, poolID string, timeout time.Duration) (*k8s.Pool, error) {
-	retryInterval := defaultK8SRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitPoolReady(ctx context.Context, k8sAPI *k8s.API, region scw.Region, poolID string, timeout time.Duration) (_ *k8s.Pool, err error) {
+	ctx, span := tracing.Start(ctx, "waitPoolReady",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(poolID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[k8s.PoolStatus]struct{}{
+		k8s.PoolStatusReady:   {},
+		k8s.PoolStatusWarning: {},
 	}
 
-	pool, err := k8sAPI.WaitForPool(&k8s.WaitForPoolRequest{
-		PoolID:        poolID,
-		Region:        region,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	pool, err := transport.Poll(ctx, defaultK8SRetryInterval, timeout, func(ctx context.Context) (*k8s.Pool, bool, error) {
+		pool, err := k8sAPI.GetPool(&k8s.GetPoolRequest{
+			PoolID: poolID,
+			Region: region,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[pool.Status]
+		return pool, isTerminal, nil
+	})
 	if err != nil {
 		return nil, err
 	}
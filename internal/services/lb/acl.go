@@ -156,12 +156,12 @@ func ResourceACL() *schema.Resource {
 func resourceLbACLCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, _, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	frontZone, frontID, err := zonal.ParseID(d.Get("frontend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &lbSDK.ZonedAPICreateACLRequest{
@@ -176,7 +176,7 @@ func resourceLbACLCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	res, err := lbAPI.CreateACL(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(frontZone, res.ID))
@@ -187,7 +187,7 @@ func resourceLbACLCreate(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceLbACLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	acl, err := lbAPI.GetACL(&lbSDK.ZonedAPIGetACLRequest{
@@ -199,7 +199,7 @@ func resourceLbACLRead(ctx context.Context, d *schema.ResourceData, m interface{
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("frontend_id", zonal.NewIDString(zone, acl.Frontend.ID))
@@ -220,7 +220,7 @@ func resourceLbACLRead(ctx context.Context, d *schema.ResourceData, m interface{
 func resourceLbACLUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &lbSDK.ZonedAPIUpdateACLRequest{
@@ -235,7 +235,7 @@ func resourceLbACLUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	_, err = lbAPI.UpdateACL(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return resourceLbACLRead(ctx, d, m)
@@ -244,7 +244,7 @@ func resourceLbACLUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceLbACLDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = lbAPI.DeleteACL(&lbSDK.ZonedAPIDeleteACLRequest{
@@ -252,7 +252,7 @@ func resourceLbACLDelete(ctx context.Context, d *schema.ResourceData, m interfac
 		ACLID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
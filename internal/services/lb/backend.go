@@ -323,12 +323,12 @@ E.g. 'failover-website.s3-website.fr-par.scw.cloud' if your bucket website URL i
 func resourceLbBackendCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, _, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	// parse lb_id. It will be forced to a zoned lb
 	zone, lbID, err := zonal.ParseID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	healthCheckPort := d.Get("health_check_port").(int)
@@ -342,28 +342,28 @@ func resourceLbBackendCreate(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	healthCheckoutTimeout, err := types.ExpandDuration(d.Get("health_check_timeout"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	healthCheckDelay, err := types.ExpandDuration(d.Get("health_check_delay"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	timeoutServer, err := types.ExpandDuration(d.Get("timeout_server"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	timeoutConnect, err := types.ExpandDuration(d.Get("timeout_connect"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	timeoutTunnel, err := types.ExpandDuration(d.Get("timeout_tunnel"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	createReq := &lbSDK.ZonedAPICreateBackendRequest{
 		Zone:                     zone,
@@ -401,7 +401,7 @@ func resourceLbBackendCreate(ctx context.Context, d *schema.ResourceData, m inte
 	if timeoutQueue, ok := d.GetOk("timeout_queue"); ok {
 		timeout, err := time.ParseDuration(timeoutQueue.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		createReq.TimeoutQueue = &scw.Duration{Seconds: int64(timeout.Seconds())}
 	}
@@ -414,7 +414,7 @@ func resourceLbBackendCreate(ctx context.Context, d *schema.ResourceData, m inte
 	if healthCheckTransientDelay, ok := d.GetOk("health_check_transient_delay"); ok {
 		timeout, err := time.ParseDuration(healthCheckTransientDelay.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		createReq.HealthCheck.TransientCheckDelay = &scw.Duration{Seconds: int64(timeout.Seconds()), Nanos: int32(timeout.Nanoseconds())}
 	}
@@ -424,7 +424,7 @@ func resourceLbBackendCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	res, err := lbAPI.CreateBackend(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, res.LB.ID, d.Timeout(schema.TimeoutCreate))
@@ -433,7 +433,7 @@ func resourceLbBackendCreate(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.ID))
@@ -444,7 +444,7 @@ func resourceLbBackendCreate(ctx context.Context, d *schema.ResourceData, m inte
 func resourceLbBackendRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	backend, err := lbAPI.GetBackend(&lbSDK.ZonedAPIGetBackendRequest{
@@ -456,7 +456,7 @@ func resourceLbBackendRead(ctx context.Context, d *schema.ResourceData, m interf
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("lb_id", zonal.NewIDString(zone, backend.LB.ID))
@@ -498,7 +498,7 @@ func resourceLbBackendRead(ctx context.Context, d *schema.ResourceData, m interf
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -508,12 +508,12 @@ func resourceLbBackendRead(ctx context.Context, d *schema.ResourceData, m interf
 func resourceLbBackendUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, lbID, err := zonal.ParseID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutUpdate))
@@ -522,20 +522,20 @@ func resourceLbBackendUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	timeoutServer, err := types.ExpandDuration(d.Get("timeout_server"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	timeoutConnect, err := types.ExpandDuration(d.Get("timeout_connect"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	timeoutTunnel, err := types.ExpandDuration(d.Get("timeout_tunnel"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &lbSDK.ZonedAPIUpdateBackendRequest{
@@ -563,7 +563,7 @@ func resourceLbBackendUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	if timeoutQueue, ok := d.GetOk("timeout_queue"); ok {
 		timeoutQueueParsed, err := time.ParseDuration(timeoutQueue.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		req.TimeoutQueue = &scw.Duration{Seconds: int64(timeoutQueueParsed.Seconds())}
 	}
@@ -573,16 +573,16 @@ func resourceLbBackendUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	_, err = lbAPI.UpdateBackend(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	healthCheckoutTimeout, err := types.ExpandDuration(d.Get("health_check_timeout"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	healthCheckDelay, err := types.ExpandDuration(d.Get("health_check_delay"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	// Update Health Check
 	updateHCRequest := &lbSDK.ZonedAPIUpdateHealthCheckRequest{
@@ -599,7 +599,7 @@ func resourceLbBackendUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	if healthCheckTransientDelay, ok := d.GetOk("health_check_transient_delay"); ok {
 		timeout, err := time.ParseDuration(healthCheckTransientDelay.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		updateHCRequest.TransientCheckDelay = &scw.Duration{Seconds: int64(timeout.Seconds()), Nanos: int32(timeout.Nanoseconds())}
 	}
@@ -616,7 +616,7 @@ func resourceLbBackendUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	_, err = lbAPI.UpdateHealthCheck(updateHCRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// Update Backend servers
@@ -626,7 +626,7 @@ func resourceLbBackendUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		ServerIP:  types.ExpandStrings(d.Get("server_ips")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutUpdate))
@@ -635,7 +635,7 @@ func resourceLbBackendUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return resourceLbBackendRead(ctx, d, m)
@@ -644,12 +644,12 @@ func resourceLbBackendUpdate(ctx context.Context, d *schema.ResourceData, m inte
 func resourceLbBackendDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, lbID, err := zonal.ParseID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutDelete))
@@ -658,7 +658,7 @@ func resourceLbBackendDelete(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = lbAPI.DeleteBackend(&lbSDK.ZonedAPIDeleteBackendRequest{
@@ -667,7 +667,7 @@ func resourceLbBackendDelete(ctx context.Context, d *schema.ResourceData, m inte
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutDelete))
@@ -676,7 +676,7 @@ func resourceLbBackendDelete(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -133,12 +133,12 @@ func ResourceCertificate() *schema.Resource {
 func resourceLbCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	zone, lbID, err := zonal.ParseID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	lbAPI, _, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	createReq := &lbSDK.ZonedAPICreateCertificateRequest{
@@ -158,12 +158,12 @@ func resourceLbCertificateCreate(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	certificate, err := lbAPI.CreateCertificate(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, certificate.ID))
@@ -174,7 +174,7 @@ func resourceLbCertificateCreate(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return resourceLbCertificateRead(ctx, d, m)
@@ -183,7 +183,7 @@ func resourceLbCertificateCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceLbCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	certificate, err := lbAPI.GetCertificate(&lbSDK.ZonedAPIGetCertificateRequest{
@@ -195,7 +195,7 @@ func resourceLbCertificateRead(ctx context.Context, d *schema.ResourceData, m in
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("lb_id", zonal.NewIDString(zone, certificate.LB.ID))
@@ -226,7 +226,7 @@ func resourceLbCertificateRead(ctx context.Context, d *schema.ResourceData, m in
 func resourceLbCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChange("name") {
@@ -238,19 +238,19 @@ func resourceLbCertificateUpdate(ctx context.Context, d *schema.ResourceData, m
 
 		_, err = lbAPI.UpdateCertificate(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitForCertificate(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		if err != nil {
 			if httperrors.Is403(err) {
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -260,12 +260,12 @@ func resourceLbCertificateUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceLbCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForCertificate(ctx, lbAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = lbAPI.DeleteCertificate(&lbSDK.ZonedAPIDeleteCertificateRequest{
@@ -273,12 +273,12 @@ func resourceLbCertificateDelete(ctx context.Context, d *schema.ResourceData, m
 		CertificateID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForCertificate(ctx, lbAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is403(err) && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
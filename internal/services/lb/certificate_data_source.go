@@ -8,6 +8,7 @@ import (
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -38,7 +39,7 @@ func DataSourceCertificate() *schema.Resource {
 func DataSourceLbCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	crtID, ok := d.GetOk("certificate_id")
@@ -50,7 +51,7 @@ func DataSourceLbCertificateRead(ctx context.Context, d *schema.ResourceData, m
 			LBID: locality.ExpandID(d.Get("lb_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundCertificate, err := datasource.FindExact(
@@ -59,7 +60,7 @@ func DataSourceLbCertificateRead(ctx context.Context, d *schema.ResourceData, m
 			certificateName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		crtID = foundCertificate.ID
@@ -68,7 +69,7 @@ func DataSourceLbCertificateRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(zonedID)
 	err = d.Set("certificate_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	return resourceLbCertificateRead(ctx, d, m)
 }
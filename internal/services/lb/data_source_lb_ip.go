@@ -9,6 +9,7 @@ import (
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -46,7 +47,7 @@ func DataSourceIP() *schema.Resource {
 func DataSourceLbIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ipID, ok := d.GetOk("ip_id")
@@ -57,7 +58,7 @@ func DataSourceLbIPRead(ctx context.Context, d *schema.ResourceData, m interface
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		if len(res.IPs) == 0 {
 			return diag.FromErr(fmt.Errorf("no ips found with the address %s", d.Get("ip_address")))
@@ -72,7 +73,7 @@ func DataSourceLbIPRead(ctx context.Context, d *schema.ResourceData, m interface
 	d.SetId(zoneID)
 	err = d.Set("ip_id", zoneID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	return resourceLbIPRead(ctx, d, m)
 }
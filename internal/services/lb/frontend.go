@@ -225,7 +225,7 @@ func ResourceFrontend() *schema.Resource {
 func resourceLbFrontendCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, _, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	lbID := locality.ExpandID(d.Get("lb_id"))
@@ -236,12 +236,12 @@ func resourceLbFrontendCreate(ctx context.Context, d *schema.ResourceData, m int
 	// parse lb_id. It will be forced to a zoned lb
 	zone, _, err := zonal.ParseID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	backZone, _, err := zonal.ParseID(d.Get("backend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if zone != backZone {
@@ -254,12 +254,12 @@ func resourceLbFrontendCreate(ctx context.Context, d *schema.ResourceData, m int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	timeoutClient, err := types.ExpandDuration(d.Get("timeout_client"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	createFrontendRequest := &lbSDK.ZonedAPICreateFrontendRequest{
@@ -279,7 +279,7 @@ func resourceLbFrontendCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	frontend, err := lbAPI.CreateFrontend(createFrontendRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, frontend.ID))
@@ -294,7 +294,7 @@ func resourceLbFrontendCreate(ctx context.Context, d *schema.ResourceData, m int
 func resourceLbFrontendRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	frontend, err := lbAPI.GetFrontend(&lbSDK.ZonedAPIGetFrontendRequest{
@@ -306,7 +306,7 @@ func resourceLbFrontendRead(ctx context.Context, d *schema.ResourceData, m inter
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("lb_id", zonal.NewIDString(zone, frontend.LB.ID))
@@ -333,7 +333,7 @@ func resourceLbFrontendRead(ctx context.Context, d *schema.ResourceData, m inter
 			FrontendID: ID,
 		}, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_ = d.Set("acl", flattenLBACLs(resACL.ACLs))
@@ -360,7 +360,7 @@ func resourceLbFrontendUpdateACL(ctx context.Context, d *schema.ResourceData, lb
 		FrontendID: frontendID,
 	}, scw.WithAllPages(), scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	apiACLs := make(map[int32]*lbSDK.ACL)
 	for _, acl := range resACL.ACLs {
@@ -394,7 +394,7 @@ func resourceLbFrontendUpdateACL(ctx context.Context, d *schema.ResourceData, lb
 				Index:  key,
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			continue
 		}
@@ -408,7 +408,7 @@ func resourceLbFrontendUpdateACL(ctx context.Context, d *schema.ResourceData, lb
 			Index:      key,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 	// we've finished with all new acl, delete any remaining old one which were not dealt with yet
@@ -418,7 +418,7 @@ func resourceLbFrontendUpdateACL(ctx context.Context, d *schema.ResourceData, lb
 			ACLID: acl.ID,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 	return nil
@@ -436,12 +436,12 @@ func expandsLBACLs(raw interface{}) []*lbSDK.ACL {
 func resourceLbFrontendUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, lbID, err := zonal.ParseID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// check err waiting process
@@ -451,12 +451,12 @@ func resourceLbFrontendUpdate(ctx context.Context, d *schema.ResourceData, m int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	timeoutClient, err := types.ExpandDuration(d.Get("timeout_client"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	req := &lbSDK.ZonedAPIUpdateFrontendRequest{
 		Zone:           zone,
@@ -471,7 +471,7 @@ func resourceLbFrontendUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 	_, err = lbAPI.UpdateFrontend(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diagnostics := resourceLbFrontendUpdateACL(ctx, d, lbAPI, zone, ID)
@@ -485,12 +485,12 @@ func resourceLbFrontendUpdate(ctx context.Context, d *schema.ResourceData, m int
 func resourceLbFrontendDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, lbID, err := zonal.ParseID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = lbAPI.DeleteFrontend(&lbSDK.ZonedAPIDeleteFrontendRequest{
@@ -498,12 +498,12 @@ func resourceLbFrontendDelete(ctx context.Context, d *schema.ResourceData, m int
 		FrontendID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
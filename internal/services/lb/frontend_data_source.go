@@ -8,6 +8,7 @@ import (
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -38,7 +39,7 @@ func DataSourceFrontend() *schema.Resource {
 func DataSourceLbFrontendRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	frontID, ok := d.GetOk("frontend_id")
@@ -50,7 +51,7 @@ func DataSourceLbFrontendRead(ctx context.Context, d *schema.ResourceData, m int
 			LBID: locality.ExpandID(d.Get("lb_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundFront, err := datasource.FindExact(
@@ -59,7 +60,7 @@ func DataSourceLbFrontendRead(ctx context.Context, d *schema.ResourceData, m int
 			frontName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		frontID = foundFront.ID
@@ -68,7 +69,7 @@ func DataSourceLbFrontendRead(ctx context.Context, d *schema.ResourceData, m int
 	d.SetId(zonedID)
 	err = d.Set("frontend_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	return resourceLbFrontendRead(ctx, d, m)
 }
@@ -79,7 +79,7 @@ func ResourceIP() *schema.Resource {
 func resourceLbIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	zoneAttribute, ok := d.GetOk("zone")
@@ -97,7 +97,7 @@ func resourceLbIPCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 	res, err := lbAPI.CreateIP(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.ID))
@@ -108,7 +108,7 @@ func resourceLbIPCreate(ctx context.Context, d *schema.ResourceData, m interface
 func resourceLbIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ip, err := lbAPI.GetIP(&lbSDK.ZonedAPIGetIPRequest{
@@ -120,7 +120,7 @@ func resourceLbIPRead(ctx context.Context, d *schema.ResourceData, m interface{}
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// check lb state if it is attached
@@ -131,14 +131,14 @@ func resourceLbIPRead(ctx context.Context, d *schema.ResourceData, m interface{}
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	// set the region from zone
 	region, err := zone.Region()
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("region", string(region))
@@ -165,7 +165,7 @@ func resourceLbIPRead(ctx context.Context, d *schema.ResourceData, m interface{}
 func resourceLbIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var ip *lbSDK.IP
@@ -189,7 +189,7 @@ func resourceLbIPUpdate(ctx context.Context, d *schema.ResourceData, m interface
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if ip.LBID != nil {
@@ -199,7 +199,7 @@ func resourceLbIPUpdate(ctx context.Context, d *schema.ResourceData, m interface
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -215,7 +215,7 @@ func resourceLbIPUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 		hasChanged = true
 	}
@@ -223,7 +223,7 @@ func resourceLbIPUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	if hasChanged {
 		_, err = lbAPI.UpdateIP(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -234,7 +234,7 @@ func resourceLbIPUpdate(ctx context.Context, d *schema.ResourceData, m interface
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -245,7 +245,7 @@ func resourceLbIPUpdate(ctx context.Context, d *schema.ResourceData, m interface
 func resourceLbIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var ip *lbSDK.IP
@@ -265,7 +265,7 @@ func resourceLbIPDelete(ctx context.Context, d *schema.ResourceData, m interface
 		return nil
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// check lb state
@@ -276,7 +276,7 @@ func resourceLbIPDelete(ctx context.Context, d *schema.ResourceData, m interface
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -286,7 +286,7 @@ func resourceLbIPDelete(ctx context.Context, d *schema.ResourceData, m interface
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// check lb state
@@ -297,7 +297,7 @@ func resourceLbIPDelete(ctx context.Context, d *schema.ResourceData, m interface
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -210,7 +210,7 @@ func ResourceLb() *schema.Resource {
 func resourceLbCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	createReq := &lbSDK.ZonedAPICreateLBRequest{
@@ -234,7 +234,7 @@ func resourceLbCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	lb, err := lbAPI.CreateLB(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, lb.ID))
@@ -242,7 +242,7 @@ func resourceLbCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 	// check err waiting process
 	_, err = waitForLB(ctx, lbAPI, zone, lb.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// attach private network
@@ -250,17 +250,17 @@ func resourceLbCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 	if pnExist {
 		pnConfigs, err := expandPrivateNetworks(pnConfigs)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = attachLBPrivateNetworks(ctx, lbAPI, zone, pnConfigs, lb.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitForLB(ctx, lbAPI, zone, lb.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -270,7 +270,7 @@ func resourceLbCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 func resourceLbRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	lb, err := waitForInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutRead))
@@ -279,12 +279,12 @@ func resourceLbRead(ctx context.Context, d *schema.ResourceData, m interface{})
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	// set the region from zone
 	region, err := zone.Region()
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("release_ip", false)
@@ -322,7 +322,7 @@ func resourceLbRead(ctx context.Context, d *schema.ResourceData, m interface{})
 		if httperrors.Is404(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("private_network", flattenPrivateNetworkConfigs(privateNetworks))
 	return nil
@@ -332,7 +332,7 @@ func resourceLbRead(ctx context.Context, d *schema.ResourceData, m interface{})
 func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &lbSDK.ZonedAPIUpdateLBRequest{
@@ -346,18 +346,18 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	_, err = lbAPI.UpdateLB(req, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChange("type") {
 		_, err = waitForInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		lbType := d.Get("type").(string)
@@ -369,12 +369,12 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 		lb, err := lbAPI.MigrateLB(migrateReq, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("couldn't migrate load balancer on type %s: %w", migrateReq.Type, err))
+			return httperrors.Diagnostics(fmt.Errorf("couldn't migrate load balancer on type %s: %w", migrateReq.Type, err))
 		}
 
 		_, err = waitForLB(ctx, lbAPI, zone, lb.ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -409,7 +409,7 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 				IPID: locality.ExpandID(ipv6ID),
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			_, err = lbAPI.UpdateIP(&lbSDK.ZonedAPIUpdateIPRequest{
@@ -418,12 +418,12 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 				LBID: types.ExpandStringPtr(ID),
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -439,7 +439,7 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 			res, err := lbAPI.CreateIP(createReq, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			updateReq := &lbSDK.ZonedAPIUpdateIPRequest{
@@ -450,12 +450,12 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 			_, err = lbAPI.UpdateIP(updateReq, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -467,23 +467,23 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 		// check current lb stability state
 		_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		// check that pns are in a stable state
 		_, err := waitForPrivateNetworks(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		oldPNs, newPNs := d.GetChange("private_network")
 		oldPNConfigs, err := expandPrivateNetworks(oldPNs)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		newPNConfigs, err := expandPrivateNetworks(newPNs)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		toDetach, toAttach := PrivateNetworksCompare(oldPNConfigs, newPNConfigs)
@@ -496,29 +496,29 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 				PrivateNetworkID: pn.PrivateNetworkID,
 			}, scw.WithContext(ctx))
 			if err != nil && !httperrors.Is404(err) {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 
 		_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitForPrivateNetworks(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		// attach private networks
 		_, err = attachLBPrivateNetworks(ctx, lbAPI, zone, toAttach, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		privateNetworks, err := waitForPrivateNetworks(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		for _, pn := range privateNetworks {
@@ -530,7 +530,7 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 					PrivateNetworkID: pn.PrivateNetworkID,
 				}, scw.WithContext(ctx))
 				if err != nil && !httperrors.Is404(err) {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 				return diag.Errorf("attaching private network with id: %s on error state. please check your config", pn.PrivateNetworkID)
 			}
@@ -543,13 +543,13 @@ func resourceLbUpdate(ctx context.Context, d *schema.ResourceData, m interface{}
 func resourceLbDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// check if current lb is on stable state
 	currentLB, err := waitForInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if currentLB.PrivateNetworkCount != 0 {
@@ -558,7 +558,7 @@ func resourceLbDelete(ctx context.Context, d *schema.ResourceData, m interface{}
 			LBID: ID,
 		}, scw.WithContext(ctx))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		// detach private networks
@@ -569,13 +569,13 @@ func resourceLbDelete(ctx context.Context, d *schema.ResourceData, m interface{}
 				PrivateNetworkID: pn.PrivateNetworkID,
 			}, scw.WithContext(ctx))
 			if err != nil && !httperrors.Is404(err) {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 
 		_, err = waitForInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -585,17 +585,17 @@ func resourceLbDelete(ctx context.Context, d *schema.ResourceData, m interface{}
 		ReleaseIP: false,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
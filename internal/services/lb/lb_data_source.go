@@ -8,6 +8,7 @@ import (
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -43,7 +44,7 @@ func DataSourceLb() *schema.Resource {
 func DataSourceLbRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	lbID, ok := d.GetOk("lb_id")
@@ -55,7 +56,7 @@ func DataSourceLbRead(ctx context.Context, d *schema.ResourceData, m interface{}
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundLB, err := datasource.FindExact(
@@ -64,7 +65,7 @@ func DataSourceLbRead(ctx context.Context, d *schema.ResourceData, m interface{}
 			lbName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		lbID = foundLB.ID
@@ -72,13 +73,13 @@ func DataSourceLbRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	err = d.Set("release_ip", false)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	zonedID := datasource.NewZonedID(lbID, zone)
 	d.SetId(zonedID)
 	err = d.Set("lb_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	return resourceLbRead(ctx, d, m)
 }
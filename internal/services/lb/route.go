@@ -72,17 +72,17 @@ func ResourceRoute() *schema.Resource {
 func resourceLbRouteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, _, err := lbAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	frontZone, frontID, err := zonal.ParseID(d.Get("frontend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	backZone, backID, err := zonal.ParseID(d.Get("backend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if frontZone != backZone {
@@ -101,7 +101,7 @@ func resourceLbRouteCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	route, err := lbAPI.CreateRoute(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(frontZone, route.ID))
@@ -112,7 +112,7 @@ func resourceLbRouteCreate(ctx context.Context, d *schema.ResourceData, m interf
 func resourceLbRouteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	route, err := lbAPI.GetRoute(&lbSDK.ZonedAPIGetRouteRequest{
@@ -124,7 +124,7 @@ func resourceLbRouteRead(ctx context.Context, d *schema.ResourceData, m interfac
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("frontend_id", zonal.NewIDString(zone, route.FrontendID))
@@ -140,12 +140,12 @@ func resourceLbRouteRead(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceLbRouteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	backZone, backID, err := zonal.ParseID(d.Get("backend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if zone != backZone {
@@ -164,7 +164,7 @@ func resourceLbRouteUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	_, err = lbAPI.UpdateRoute(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return resourceLbRouteRead(ctx, d, m)
@@ -173,7 +173,7 @@ func resourceLbRouteUpdate(ctx context.Context, d *schema.ResourceData, m interf
 func resourceLbRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = lbAPI.DeleteRoute(&lbSDK.ZonedAPIDeleteRouteRequest{
@@ -182,7 +182,7 @@ func resourceLbRouteDelete(ctx context.Context, d *schema.ResourceData, m interf
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -14,11 +14,12 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_lb_ip", &resource.Sweeper{
-		Name: "scaleway_lb_ip",
-		F:    testSweepIP,
+	acctest.AddTestSweepers("scaleway_lb_ip", &resource.Sweeper{
+		Name:         "scaleway_lb_ip",
+		Dependencies: []string{"scaleway_lb"},
+		F:            testSweepIP,
 	})
-	resource.AddTestSweepers("scaleway_lb", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_lb", &resource.Sweeper{
 		Name: "scaleway_lb",
 		F:    testSweepLB,
 	})
@@ -37,25 +38,34 @@ func testSweepLB(_ string) error {
 		}
 
 		for _, l := range listLBs.LBs {
-			retryInterval := lb.DefaultWaitLBRetryInterval
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_lb",
+				Locality:  zone.String(),
+				ID:        l.ID,
+				Name:      l.Name,
+				CreatedAt: l.CreatedAt,
+			}, func() error {
+				retryInterval := lb.DefaultWaitLBRetryInterval
 
-			if transport.DefaultWaitRetryInterval != nil {
-				retryInterval = *transport.DefaultWaitRetryInterval
-			}
+				if transport.DefaultWaitRetryInterval != nil {
+					retryInterval = *transport.DefaultWaitRetryInterval
+				}
 
-			_, err := lbAPI.WaitForLbInstances(&lbSDK.ZonedAPIWaitForLBInstancesRequest{
-				Zone:          zone,
-				LBID:          l.ID,
-				Timeout:       scw.TimeDurationPtr(instance.DefaultInstanceServerWaitTimeout),
-				RetryInterval: &retryInterval,
-			})
-			if err != nil {
-				return fmt.Errorf("error waiting for lb in sweeper: %s", err)
-			}
-			err = lbAPI.DeleteLB(&lbSDK.ZonedAPIDeleteLBRequest{
-				LBID:      l.ID,
-				ReleaseIP: true,
-				Zone:      zone,
+				_, err := lbAPI.WaitForLbInstances(&lbSDK.ZonedAPIWaitForLBInstancesRequest{
+					Zone:          zone,
+					LBID:          l.ID,
+					Timeout:       scw.TimeDurationPtr(instance.DefaultInstanceServerWaitTimeout),
+					RetryInterval: &retryInterval,
+				})
+				if err != nil {
+					return fmt.Errorf("error waiting for lb in sweeper: %s", err)
+				}
+
+				return lbAPI.DeleteLB(&lbSDK.ZonedAPIDeleteLBRequest{
+					LBID:      l.ID,
+					ReleaseIP: true,
+					Zone:      zone,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting lb in sweeper: %s", err)
@@ -78,9 +88,16 @@ func testSweepIP(_ string) error {
 
 		for _, ip := range listIPs.IPs {
 			if ip.LBID == nil {
-				err := lbAPI.ReleaseIP(&lbSDK.ZonedAPIReleaseIPRequest{
-					Zone: zone,
-					IPID: ip.ID,
+				err := acctest.SweepResource(acctest.SweptResource{
+					Type:         "scaleway_lb_ip",
+					Locality:     zone.String(),
+					ID:           ip.ID,
+					SweepUnnamed: true,
+				}, func() error {
+					return lbAPI.ReleaseIP(&lbSDK.ZonedAPIReleaseIPRequest{
+						Zone: zone,
+						IPID: ip.ID,
+					})
 				})
 				if err != nil {
 					return fmt.Errorf("error deleting lb ip in sweeper: %s", err)
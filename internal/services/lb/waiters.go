@@ -6,69 +6,122 @@ import (
 
 	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitForLB(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lbID string, timeout time.Duration) (*lb.LB, error) {
-	retryInterval := DefaultWaitLBRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForLB(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lbID string, timeout time.Duration) (_ *lb.LB, err error) {
+	ctx, span := tracing.Start(ctx, "waitForLB",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(lbID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[lb.LBStatus]struct{}{
+		lb.LBStatusReady:   {},
+		lb.LBStatusStopped: {},
+		lb.LBStatusError:   {},
+		lb.LBStatusLocked:  {},
 	}
 
-	loadBalancer, err := lbAPI.WaitForLb(&lb.ZonedAPIWaitForLBRequest{
-		LBID:          lbID,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	loadBalancer, err := transport.Poll(ctx, DefaultWaitLBRetryInterval, timeout, func(ctx context.Context) (*lb.LB, bool, error) {
+		res, err := lbAPI.GetLB(&lb.ZonedAPIGetLBRequest{
+			LBID: lbID,
+			Zone: zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return loadBalancer, err
 }
 
-func waitForInstances(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lbID string, timeout time.Duration) (*lb.LB, error) {
-	retryInterval := DefaultWaitLBRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForInstances(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lbID string, timeout time.Duration) (_ *lb.LB, err error) {
+	ctx, span := tracing.Start(ctx, "waitForInstances",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(lbID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[lb.LBStatus]struct{}{
+		lb.LBStatusReady:   {},
+		lb.LBStatusStopped: {},
+		lb.LBStatusError:   {},
+		lb.LBStatusLocked:  {},
 	}
 
-	loadBalancer, err := lbAPI.WaitForLbInstances(&lb.ZonedAPIWaitForLBInstancesRequest{
-		Zone:          zone,
-		LBID:          lbID,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	loadBalancer, err := transport.Poll(ctx, DefaultWaitLBRetryInterval, timeout, func(ctx context.Context) (*lb.LB, bool, error) {
+		res, err := lbAPI.GetLB(&lb.ZonedAPIGetLBRequest{
+			Zone: zone,
+			LBID: lbID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return loadBalancer, err
 }
 
-func waitForPrivateNetworks(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lbID string, timeout time.Duration) ([]*lb.PrivateNetwork, error) {
-	retryInterval := DefaultWaitLBRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForPrivateNetworks(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, lbID string, timeout time.Duration) (_ []*lb.PrivateNetwork, err error) {
+	ctx, span := tracing.Start(ctx, "waitForPrivateNetworks",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(lbID),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[lb.PrivateNetworkStatus]struct{}{
+		lb.PrivateNetworkStatusReady: {},
+		lb.PrivateNetworkStatusError: {},
 	}
 
-	privateNetworks, err := lbAPI.WaitForLBPN(&lb.ZonedAPIWaitForLBPNRequest{
-		LBID:          lbID,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	privateNetworks, err := transport.Poll(ctx, DefaultWaitLBRetryInterval, timeout, func(ctx context.Context) ([]*lb.PrivateNetwork, bool, error) {
+		res, err := lbAPI.ListLBPrivateNetworks(&lb.ZonedAPIListLBPrivateNetworksRequest{
+			LBID: lbID,
+			Zone: zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		for _, privateNetwork := range res.PrivateNetwork {
+			if _, isTerminal := terminalStatus[privateNetwork.Status]; !isTerminal {
+				return res.PrivateNetwork, false, nil
+			}
+		}
+		return res.PrivateNetwork, true, nil
+	})
 
 	return privateNetworks, err
 }
 
-func waitForCertificate(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, id string, timeout time.Duration) (*lb.Certificate, error) {
-	retryInterval := DefaultWaitLBRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForCertificate(ctx context.Context, lbAPI *lb.ZonedAPI, zone scw.Zone, id string, timeout time.Duration) (_ *lb.Certificate, err error) {
+	ctx, span := tracing.Start(ctx, "waitForCertificate",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[lb.CertificateStatus]struct{}{
+		lb.CertificateStatusError: {},
+		lb.CertificateStatusReady: {},
 	}
 
-	certificate, err := lbAPI.WaitForLBCertificate(&lb.ZonedAPIWaitForLBCertificateRequest{
-		CertID:        id,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	certificate, err := transport.Poll(ctx, DefaultWaitLBRetryInterval, timeout, func(ctx context.Context) (*lb.Certificate, bool, error) {
+		res, err := lbAPI.GetCertificate(&lb.ZonedAPIGetCertificateRequest{
+			CertificateID: id,
+			Zone:          zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return certificate, err
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/nats-io/nats.go"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
 
@@ -94,7 +95,7 @@ func NATSClientWithRegion( //nolint:ireturn,nolintlint
 
 	endpoint := d.Get("endpoint").(string)
 	creds := d.Get("credentials").(string)
-	js, err := newNATSJetStreamClient(region.String(), endpoint, creds)
+	js, err := newNATSJetStreamClient(region.String(), endpoint, creds, meta.ExtractNetworkOptions(m))
 	if err != nil {
 		return nil, "", err
 	}
@@ -106,13 +107,22 @@ func newNATSJetStreamClient( //nolint:ireturn
 	region string,
 	endpoint string,
 	credentials string,
+	networkOptions transport.NetworkOptions,
 ) (nats.JetStreamContext, error) {
 	jwt, seed, err := splitNATSJWTAndSeed(credentials)
 	if err != nil {
 		return nil, err
 	}
 
-	nc, err := nats.Connect(strings.ReplaceAll(endpoint, "{region}", region), nats.UserJWTAndSeed(jwt, seed))
+	options := []nats.Option{nats.UserJWTAndSeed(jwt, seed)}
+	if networkOptions.TLSConfig != nil {
+		options = append(options, nats.Secure(networkOptions.TLSConfig.Clone()))
+	}
+	if networkOptions.ProxyURL != nil {
+		options = append(options, nats.SetCustomDialer(transport.NewProxyDialer(networkOptions.ProxyURL, networkOptions.TLSConfig)))
+	}
+
+	nc, err := nats.Connect(strings.ReplaceAll(endpoint, "{region}", region), options...)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +44,7 @@ func ResourceNatsAccount() *schema.Resource {
 func ResourceMNQNatsAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newMNQNatsAPI(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	account, err := api.CreateNatsAccount(&mnq.NatsAPICreateNatsAccountRequest{
@@ -53,7 +53,7 @@ func ResourceMNQNatsAccountCreate(ctx context.Context, d *schema.ResourceData, m
 		Name:      types.ExpandOrGenerateString(d.Get("name").(string), "nats-account"),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, account.ID))
@@ -64,7 +64,7 @@ func ResourceMNQNatsAccountCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceMNQNatsAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewNatsAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	account, err := api.GetNatsAccount(&mnq.NatsAPIGetNatsAccountRequest{
@@ -76,7 +76,7 @@ func ResourceMNQNatsAccountRead(ctx context.Context, d *schema.ResourceData, m i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", account.Name)
@@ -90,7 +90,7 @@ func ResourceMNQNatsAccountRead(ctx context.Context, d *schema.ResourceData, m i
 func ResourceMNQNatsAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewNatsAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &mnq.NatsAPIUpdateNatsAccountRequest{
@@ -103,7 +103,7 @@ func ResourceMNQNatsAccountUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if _, err := api.UpdateNatsAccount(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceMNQNatsAccountRead(ctx, d, m)
@@ -112,7 +112,7 @@ func ResourceMNQNatsAccountUpdate(ctx context.Context, d *schema.ResourceData, m
 func ResourceMNQNatsAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewNatsAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteNatsAccount(&mnq.NatsAPIDeleteNatsAccountRequest{
@@ -120,7 +120,7 @@ func ResourceMNQNatsAccountDelete(ctx context.Context, d *schema.ResourceData, m
 		NatsAccountID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
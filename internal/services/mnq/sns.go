@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mnq "github.com/scaleway/scaleway-sdk-go/api/mnq/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 )
@@ -35,7 +36,7 @@ func ResourceSNS() *schema.Resource {
 func ResourceMNQSNSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newMNQSNSAPI(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	sns, err := api.ActivateSns(&mnq.SnsAPIActivateSnsRequest{
@@ -43,7 +44,7 @@ func ResourceMNQSNSCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		ProjectID: d.Get("project_id").(string),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, sns.ProjectID))
@@ -54,7 +55,7 @@ func ResourceMNQSNSCreate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceMNQSNSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewSNSAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	sns, err := api.GetSnsInfo(&mnq.SnsAPIGetSnsInfoRequest{
@@ -62,7 +63,7 @@ func ResourceMNQSNSRead(ctx context.Context, d *schema.ResourceData, m interface
 		ProjectID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("endpoint", sns.SnsEndpointURL)
@@ -75,7 +76,7 @@ func ResourceMNQSNSRead(ctx context.Context, d *schema.ResourceData, m interface
 func ResourceMNQSNSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewSNSAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	sns, err := api.GetSnsInfo(&mnq.SnsAPIGetSnsInfoRequest{
@@ -83,7 +84,7 @@ func ResourceMNQSNSDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		ProjectID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if sns.Status == mnq.SnsInfoStatusDisabled {
@@ -96,7 +97,7 @@ func ResourceMNQSNSDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		ProjectID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
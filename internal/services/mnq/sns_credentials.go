@@ -81,7 +81,7 @@ func ResourceSNSCredentials() *schema.Resource {
 func ResourceMNQSNSCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newMNQSNSAPI(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	credentials, err := api.CreateSnsCredentials(&mnq.SnsAPICreateSnsCredentialsRequest{
@@ -95,7 +95,7 @@ func ResourceMNQSNSCredentialsCreate(ctx context.Context, d *schema.ResourceData
 		},
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, credentials.ID))
@@ -109,7 +109,7 @@ func ResourceMNQSNSCredentialsCreate(ctx context.Context, d *schema.ResourceData
 func ResourceMNQSNSCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewSNSAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	credentials, err := api.GetSnsCredentials(&mnq.SnsAPIGetSnsCredentialsRequest{
@@ -121,7 +121,7 @@ func ResourceMNQSNSCredentialsRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", credentials.Name)
@@ -142,7 +142,7 @@ func ResourceMNQSNSCredentialsRead(ctx context.Context, d *schema.ResourceData,
 func ResourceMNQSNSCredentialsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewSNSAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &mnq.SnsAPIUpdateSnsCredentialsRequest{
@@ -171,7 +171,7 @@ func ResourceMNQSNSCredentialsUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if _, err := api.UpdateSnsCredentials(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceMNQSNSCredentialsRead(ctx, d, m)
@@ -180,7 +180,7 @@ func ResourceMNQSNSCredentialsUpdate(ctx context.Context, d *schema.ResourceData
 func ResourceMNQSNSCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewSNSAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteSnsCredentials(&mnq.SnsAPIDeleteSnsCredentialsRequest{
@@ -188,7 +188,7 @@ func ResourceMNQSNSCredentialsDelete(ctx context.Context, d *schema.ResourceData
 		SnsCredentialsID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mnq "github.com/scaleway/scaleway-sdk-go/api/mnq/v1beta1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 )
 
 func DataSourceSNS() *schema.Resource {
@@ -25,7 +26,7 @@ func DataSourceSNS() *schema.Resource {
 func DataSourceMNQSNSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newMNQSNSAPI(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	sns, err := api.GetSnsInfo(&mnq.SnsAPIGetSnsInfoRequest{
@@ -33,7 +34,7 @@ func DataSourceMNQSNSRead(ctx context.Context, d *schema.ResourceData, m interfa
 		ProjectID: d.Get("project_id").(string),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if sns.Status != mnq.SnsInfoStatusEnabled {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mnq "github.com/scaleway/scaleway-sdk-go/api/mnq/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
@@ -91,12 +92,12 @@ func ResourceSNSTopic() *schema.Resource {
 func ResourceMNQSNSTopicCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newMNQSNSAPI(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID, _, err := meta.ExtractProjectID(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	snsInfo, err := api.GetSnsInfo(&mnq.SnsAPIGetSnsInfoRequest{
@@ -109,12 +110,12 @@ func ResourceMNQSNSTopicCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	snsClient, _, err := SNSClientWithRegion(ctx, m, d)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	attributes, err := awsResourceDataToAttributes(d, ResourceSNSTopic().Schema, SNSTopicAttributesToResourceMap)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to get attributes from schema: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to get attributes from schema: %w", err))
 	}
 
 	isFifo := d.Get("fifo_topic").(bool)
@@ -127,7 +128,7 @@ func ResourceMNQSNSTopicCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	output, err := snsClient.CreateTopic(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create SNS Topic: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to create SNS Topic: %w", err))
 	}
 
 	if output.TopicArn == nil {
@@ -142,24 +143,24 @@ func ResourceMNQSNSTopicCreate(ctx context.Context, d *schema.ResourceData, m in
 func ResourceMNQSNSTopicRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	snsClient, _, err := SNSClientWithRegion(ctx, m, d)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	region, projectID, topicName, err := DecomposeMNQID(d.Id())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse id: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to parse id: %w", err))
 	}
 
 	topicAttributes, err := snsClient.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{
 		TopicArn: scw.StringPtr(ComposeSNSARN(region, projectID, topicName)),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	schemaAttributes, err := awsAttributesToResourceData(topicAttributes.Attributes, ResourceSNSTopic().Schema, SNSTopicAttributesToResourceMap)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", topicName)
@@ -175,12 +176,12 @@ func ResourceMNQSNSTopicRead(ctx context.Context, d *schema.ResourceData, m inte
 func ResourceMNQSNSTopicUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	snsClient, _, err := SNSClientWithRegion(ctx, m, d)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	region, projectID, topicName, err := DecomposeMNQID(d.Id())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse id: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to parse id: %w", err))
 	}
 
 	topicARN := ComposeSNSARN(region, projectID, topicName)
@@ -194,7 +195,7 @@ func ResourceMNQSNSTopicUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 	attributes, err := awsResourceDataToAttributes(d, ResourceSNSTopic().Schema, SNSTopicAttributesToResourceMap)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to get attributes from schema: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to get attributes from schema: %w", err))
 	}
 
 	updatedAttributes := map[string]string{}
@@ -211,7 +212,7 @@ func ResourceMNQSNSTopicUpdate(ctx context.Context, d *schema.ResourceData, m in
 				TopicArn:       &topicARN,
 			})
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("failed to set attribute %q: %w", attributeName, err))
+				return httperrors.Diagnostics(fmt.Errorf("failed to set attribute %q: %w", attributeName, err))
 			}
 		}
 	}
@@ -222,19 +223,19 @@ func ResourceMNQSNSTopicUpdate(ctx context.Context, d *schema.ResourceData, m in
 func ResourceMNQSNSTopicDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	snsClient, _, err := SNSClientWithRegion(ctx, m, d)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	region, projectID, topicName, err := DecomposeMNQID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = snsClient.DeleteTopic(ctx, &sns.DeleteTopicInput{
 		TopicArn: scw.StringPtr(ComposeSNSARN(region, projectID, topicName)),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mnq "github.com/scaleway/scaleway-sdk-go/api/mnq/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
@@ -92,12 +93,12 @@ func ResourceSNSTopicSubscription() *schema.Resource {
 func ResourceMNQSNSTopicSubscriptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newMNQSNSAPI(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID, _, err := meta.ExtractProjectID(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	snsInfo, err := api.GetSnsInfo(&mnq.SnsAPIGetSnsInfoRequest{
@@ -110,12 +111,12 @@ func ResourceMNQSNSTopicSubscriptionCreate(ctx context.Context, d *schema.Resour
 
 	snsClient, _, err := SNSClientWithRegion(ctx, m, d)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	attributes, err := awsResourceDataToAttributes(d, ResourceSNSTopic().Schema, SNSTopicSubscriptionAttributesToResourceMap)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to get attributes from schema: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to get attributes from schema: %w", err))
 	}
 
 	// Get topic ARN from either topic_arn or topic_id
@@ -146,7 +147,7 @@ func ResourceMNQSNSTopicSubscriptionCreate(ctx context.Context, d *schema.Resour
 
 	output, err := snsClient.Subscribe(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create SNS Topic: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to create SNS Topic: %w", err))
 	}
 
 	if output.SubscriptionArn == nil {
@@ -155,7 +156,7 @@ func ResourceMNQSNSTopicSubscriptionCreate(ctx context.Context, d *schema.Resour
 
 	arn, err := decomposeARN(*output.SubscriptionArn)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse arn: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to parse arn: %w", err))
 	}
 
 	d.SetId(composeMNQSubscriptionID(arn.Region, arn.ProjectID, arn.ResourceName, arn.ExtraResourceID))
@@ -166,24 +167,24 @@ func ResourceMNQSNSTopicSubscriptionCreate(ctx context.Context, d *schema.Resour
 func ResourceMNQSNSTopicSubscriptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	snsClient, region, err := SNSClientWithRegionFromID(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	arn, err := DecomposeMNQSubscriptionID(d.Id())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse id: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to parse id: %w", err))
 	}
 
 	subAttributes, err := snsClient.GetSubscriptionAttributes(ctx, &sns.GetSubscriptionAttributesInput{
 		SubscriptionArn: scw.StringPtr(arn.String()),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	schemaAttributes, err := awsAttributesToResourceData(subAttributes.Attributes, ResourceSNSTopic().Schema, SNSTopicSubscriptionAttributesToResourceMap)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("region", region)
@@ -199,19 +200,19 @@ func ResourceMNQSNSTopicSubscriptionRead(ctx context.Context, d *schema.Resource
 func ResourceMNQSNSTopicSubscriptionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	snsClient, _, err := SNSClientWithRegionFromID(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	arn, err := DecomposeMNQSubscriptionID(d.Id())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to parse id: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to parse id: %w", err))
 	}
 
 	_, err = snsClient.Unsubscribe(ctx, &sns.UnsubscribeInput{
 		SubscriptionArn: scw.StringPtr(arn.String()),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
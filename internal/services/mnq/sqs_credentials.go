@@ -81,7 +81,7 @@ func ResourceSQSCredentials() *schema.Resource {
 func ResourceMNQSQSCredentialsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newSQSAPI(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	credentials, err := api.CreateSqsCredentials(&mnq.SqsAPICreateSqsCredentialsRequest{
@@ -95,7 +95,7 @@ func ResourceMNQSQSCredentialsCreate(ctx context.Context, d *schema.ResourceData
 		},
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, credentials.ID))
@@ -109,7 +109,7 @@ func ResourceMNQSQSCredentialsCreate(ctx context.Context, d *schema.ResourceData
 func ResourceMNQSQSCredentialsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewSQSAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	credentials, err := api.GetSqsCredentials(&mnq.SqsAPIGetSqsCredentialsRequest{
@@ -121,7 +121,7 @@ func ResourceMNQSQSCredentialsRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", credentials.Name)
@@ -142,7 +142,7 @@ func ResourceMNQSQSCredentialsRead(ctx context.Context, d *schema.ResourceData,
 func ResourceMNQSQSCredentialsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewSQSAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &mnq.SqsAPIUpdateSqsCredentialsRequest{
@@ -171,7 +171,7 @@ func ResourceMNQSQSCredentialsUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if _, err := api.UpdateSqsCredentials(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceMNQSQSCredentialsRead(ctx, d, m)
@@ -180,7 +180,7 @@ func ResourceMNQSQSCredentialsUpdate(ctx context.Context, d *schema.ResourceData
 func ResourceMNQSQSCredentialsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewSQSAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteSqsCredentials(&mnq.SqsAPIDeleteSqsCredentialsRequest{
@@ -188,7 +188,7 @@ func ResourceMNQSQSCredentialsDelete(ctx context.Context, d *schema.ResourceData
 		SqsCredentialsID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	mnq "github.com/scaleway/scaleway-sdk-go/api/mnq/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
@@ -131,12 +132,12 @@ func ResourceSQSQueue() *schema.Resource {
 func ResourceMNQSQSQueueCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newSQSAPI(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	projectID, _, err := meta.ExtractProjectID(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	sqsInfo, err := api.GetSqsInfo(&mnq.SqsAPIGetSqsInfoRequest{
@@ -144,7 +145,7 @@ func ResourceMNQSQSQueueCreate(ctx context.Context, d *schema.ResourceData, m in
 		ProjectID: projectID,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if sqsInfo.Status != mnq.SqsInfoStatusEnabled {
@@ -153,7 +154,7 @@ func ResourceMNQSQSQueueCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	sqsClient, _, err := SQSClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	isFifo := d.Get("fifo_queue").(bool)
@@ -161,7 +162,7 @@ func ResourceMNQSQSQueueCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	attributes, err := awsResourceDataToAttributes(d, ResourceSQSQueue().Schema, SQSAttributesToResourceMap)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	input := &sqs.CreateQueueInput{
@@ -187,12 +188,12 @@ func ResourceMNQSQSQueueCreate(ctx context.Context, d *schema.ResourceData, m in
 func ResourceMNQSQSQueueRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sqsClient, _, err := SQSClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	region, projectID, queueName, err := DecomposeMNQID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	queue, err := transport.RetryWhenAWSErrCodeEquals(ctx, []string{AWSErrNonExistentQueue}, &transport.RetryWhenConfig[*sqs.GetQueueUrlOutput]{
@@ -236,12 +237,12 @@ func ResourceMNQSQSQueueRead(ctx context.Context, d *schema.ResourceData, m inte
 func ResourceMNQSQSQueueUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sqsClient, _, err := SQSClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, _, queueName, err := DecomposeMNQID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	queue, err := transport.RetryWhenAWSErrCodeEquals(ctx, []string{AWSErrNonExistentQueue}, &transport.RetryWhenConfig[*sqs.GetQueueUrlOutput]{
@@ -259,7 +260,7 @@ func ResourceMNQSQSQueueUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 	attributes, err := awsResourceDataToAttributes(d, ResourceSQSQueue().Schema, SQSAttributesToResourceMap)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = sqsClient.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
@@ -276,12 +277,12 @@ func ResourceMNQSQSQueueUpdate(ctx context.Context, d *schema.ResourceData, m in
 func ResourceMNQSQSQueueDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sqsClient, _, err := SQSClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, _, queueName, err := DecomposeMNQID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	queue, err := sqsClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
@@ -13,23 +13,25 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_mnq_nats_account", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mnq_nats_account", &resource.Sweeper{
 		Name: "scaleway_mnq_nats_account",
 		F:    testSweepNatsAccount,
 	})
-	resource.AddTestSweepers("scaleway_mnq_sns", &resource.Sweeper{
-		Name: "scaleway_mnq_sns",
-		F:    testSweepSNS,
+	acctest.AddTestSweepers("scaleway_mnq_sns", &resource.Sweeper{
+		Name:         "scaleway_mnq_sns",
+		Dependencies: []string{"scaleway_mnq_sns_credentials"},
+		F:            testSweepSNS,
 	})
-	resource.AddTestSweepers("scaleway_mnq_sns_credentials", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mnq_sns_credentials", &resource.Sweeper{
 		Name: "scaleway_mnq_sns_credentials",
 		F:    testSweepSNSCredentials,
 	})
-	resource.AddTestSweepers("scaleway_mnq_sqs", &resource.Sweeper{
-		Name: "scaleway_mnq_sqs",
-		F:    testSweepSQS,
+	acctest.AddTestSweepers("scaleway_mnq_sqs", &resource.Sweeper{
+		Name:         "scaleway_mnq_sqs",
+		Dependencies: []string{"scaleway_mnq_sqs_credentials"},
+		F:            testSweepSQS,
 	})
-	resource.AddTestSweepers("scaleway_mnq_sqs_credentials", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mnq_sqs_credentials", &resource.Sweeper{
 		Name: "scaleway_mnq_sqs_credentials",
 		F:    testSweepSQSCredentials,
 	})
@@ -48,9 +50,17 @@ func testSweepSQSCredentials(_ string) error {
 		}
 
 		for _, credentials := range listSqsCredentials.SqsCredentials {
-			err := mnqAPI.DeleteSqsCredentials(&mnqSDK.SqsAPIDeleteSqsCredentialsRequest{
-				SqsCredentialsID: credentials.ID,
-				Region:           region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_mnq_sqs_credentials",
+				Locality:  region.String(),
+				ID:        credentials.ID,
+				Name:      credentials.Name,
+				CreatedAt: credentials.CreatedAt,
+			}, func() error {
+				return mnqAPI.DeleteSqsCredentials(&mnqSDK.SqsAPIDeleteSqsCredentialsRequest{
+					SqsCredentialsID: credentials.ID,
+					Region:           region,
+				})
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -79,9 +89,17 @@ func testSweepSQS(_ string) error {
 				continue
 			}
 
-			_, err := mnqAPI.DeactivateSqs(&mnqSDK.SqsAPIDeactivateSqsRequest{
-				Region:    region,
-				ProjectID: project.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:     "scaleway_mnq_sqs",
+				Locality: region.String(),
+				ID:       project.ID,
+				Name:     project.Name,
+			}, func() error {
+				_, err := mnqAPI.DeactivateSqs(&mnqSDK.SqsAPIDeactivateSqsRequest{
+					Region:    region,
+					ProjectID: project.ID,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -106,9 +124,17 @@ func testSweepSNSCredentials(_ string) error {
 		}
 
 		for _, credentials := range listSnsCredentials.SnsCredentials {
-			err := mnqAPI.DeleteSnsCredentials(&mnqSDK.SnsAPIDeleteSnsCredentialsRequest{
-				SnsCredentialsID: credentials.ID,
-				Region:           region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_mnq_sns_credentials",
+				Locality:  region.String(),
+				ID:        credentials.ID,
+				Name:      credentials.Name,
+				CreatedAt: credentials.CreatedAt,
+			}, func() error {
+				return mnqAPI.DeleteSnsCredentials(&mnqSDK.SnsAPIDeleteSnsCredentialsRequest{
+					SnsCredentialsID: credentials.ID,
+					Region:           region,
+				})
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -137,9 +163,17 @@ func testSweepSNS(_ string) error {
 				continue
 			}
 
-			_, err := mnqAPI.DeactivateSns(&mnqSDK.SnsAPIDeactivateSnsRequest{
-				Region:    region,
-				ProjectID: project.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:     "scaleway_mnq_sns",
+				Locality: region.String(),
+				ID:       project.ID,
+				Name:     project.Name,
+			}, func() error {
+				_, err := mnqAPI.DeactivateSns(&mnqSDK.SnsAPIDeactivateSnsRequest{
+					Region:    region,
+					ProjectID: project.ID,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -164,9 +198,17 @@ func testSweepNatsAccount(_ string) error {
 		}
 
 		for _, account := range listNatsAccounts.NatsAccounts {
-			err := mnqAPI.DeleteNatsAccount(&mnqSDK.NatsAPIDeleteNatsAccountRequest{
-				NatsAccountID: account.ID,
-				Region:        region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_mnq_nats_account",
+				Locality:  region.String(),
+				ID:        account.ID,
+				Name:      account.Name,
+				CreatedAt: account.CreatedAt,
+			}, func() error {
+				return mnqAPI.DeleteNatsAccount(&mnqSDK.NatsAPIDeleteNatsAccountRequest{
+					NatsAccountID: account.ID,
+					Region:        region,
+				})
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
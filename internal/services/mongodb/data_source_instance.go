@@ -9,6 +9,7 @@ import (
 	mongodb "github.com/scaleway/scaleway-sdk-go/api/mongodb/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -16,6 +17,8 @@ import (
 
 func DataSourceInstance() *schema.Resource {
 	dsSchema := datasource.SchemaFromResourceSchema(ResourceInstance().Schema)
+	delete(dsSchema, "password_wo")
+	delete(dsSchema, "password_wo_version")
 
 	datasource.AddOptionalFieldsToSchema(dsSchema, "name", "region", "project_id")
 
@@ -37,7 +40,7 @@ func DataSourceInstance() *schema.Resource {
 func DataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	mongodbAPI, zone, region, err := newAPIWithZoneAndRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	instanceID, ok := d.GetOk("instance_id")
@@ -49,7 +52,7 @@ func DataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundInstance, err := datasource.FindExact(
@@ -58,7 +61,7 @@ func DataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 			instanceName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		instanceID = foundInstance.ID
@@ -68,7 +71,7 @@ func DataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 	d.SetId(zonedID)
 	err = d.Set("instance_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	getReq := &mongodb.GetInstanceRequest{
@@ -77,7 +80,7 @@ func DataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 	instance, err := mongodbAPI.GetInstance(getReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", instance.Name)
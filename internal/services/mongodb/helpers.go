@@ -77,29 +77,41 @@ func NewAPIWithRegionAndID(m interface{}, id string) (*mongodb.API, scw.Region,
 }
 
 func waitForInstance(ctx context.Context, api *mongodb.API, region scw.Region, id string, timeout time.Duration) (*mongodb.Instance, error) {
-	retryInterval := defaultWaitMongodbInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	terminalStatus := map[mongodb.InstanceStatus]struct{}{
+		mongodb.InstanceStatusReady:  {},
+		mongodb.InstanceStatusLocked: {},
+		mongodb.InstanceStatusError:  {},
 	}
-	return api.WaitForInstance(&mongodb.WaitForInstanceRequest{
-		Timeout:       scw.TimeDurationPtr(timeout),
-		InstanceID:    id,
-		Region:        region,
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+
+	return transport.Poll(ctx, defaultWaitMongodbInstanceRetryInterval, timeout, func(ctx context.Context) (*mongodb.Instance, bool, error) {
+		res, err := api.GetInstance(&mongodb.GetInstanceRequest{
+			Region:     region,
+			InstanceID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
 
 func waitForSnapshot(ctx context.Context, api *mongodb.API, region scw.Region, instanceID string, snapshotID string, timeout time.Duration) (*mongodb.Snapshot, error) {
-	retryInterval := defaultWaitMongodbInstanceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	terminalStatus := map[mongodb.SnapshotStatus]struct{}{
+		mongodb.SnapshotStatusReady:  {},
+		mongodb.SnapshotStatusError:  {},
+		mongodb.SnapshotStatusLocked: {},
 	}
 
-	return api.WaitForSnapshot(&mongodb.WaitForSnapshotRequest{
-		Timeout:       scw.TimeDurationPtr(timeout),
-		InstanceID:    instanceID,
-		SnapshotID:    snapshotID,
-		Region:        region,
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, defaultWaitMongodbInstanceRetryInterval, timeout, func(ctx context.Context) (*mongodb.Snapshot, bool, error) {
+		res, err := api.GetSnapshot(&mongodb.GetSnapshotRequest{
+			Region:     region,
+			SnapshotID: snapshotID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 )
@@ -77,8 +78,24 @@ func ResourceInstance() *schema.Resource {
 				Description: "Password of the user",
 				ConflictsWith: []string{
 					"snapshot_id",
+					"password_wo",
 				},
 			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				Description:   "Write-only password of the user, sent to the API but never stored in the state. Requires Terraform 1.11 or later",
+				ConflictsWith: []string{"password", "snapshot_id"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Version of the write-only password. Changing it rotates the password to the current value of password_wo",
+				RequiredWith: []string{"password_wo"},
+			},
 			// volume
 			"volume_type": {
 				Type:        schema.TypeString,
@@ -166,7 +183,7 @@ func ResourceInstance() *schema.Resource {
 func ResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	mongodbAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	nodeNumber := scw.Uint32Ptr(uint32(d.Get("node_number").(int)))
@@ -187,7 +204,7 @@ func ResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 		}
 		res, err = mongodbAPI.RestoreSnapshot(restoreSnapshotRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	} else {
 		createReq := &mongodb.CreateInstanceRequest{
@@ -197,7 +214,7 @@ func ResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 			NodeType:   d.Get("node_type").(string),
 			NodeNumber: *nodeNumber,
 			UserName:   d.Get("user_name").(string),
-			Password:   d.Get("password").(string),
+			Password:   meta.GetWriteOnlyOrString(d, "password_wo", "password"),
 		}
 
 		volumeRequestDetails := &mongodb.CreateInstanceRequestVolumeDetails{
@@ -223,13 +240,13 @@ func ResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 		res, err = mongodbAPI.CreateInstance(createReq, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 	d.SetId(zonal.NewIDString(zone, res.ID))
 	_, err = waitForInstance(ctx, mongodbAPI, res.Region, res.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceInstanceRead(ctx, d, m)
@@ -238,7 +255,7 @@ func ResourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	mongodbAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	getReq := &mongodb.GetInstanceRequest{
@@ -252,7 +269,7 @@ func ResourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interfa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", instance.Name)
@@ -288,7 +305,7 @@ func ResourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	mongodbAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////////////////////
@@ -316,11 +333,11 @@ func ResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 		_, err = mongodbAPI.UpgradeInstance(&upgradeInstanceRequests, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		_, err = waitForInstance(ctx, mongodbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -339,7 +356,7 @@ func ResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		shouldUpdateInstance = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if tags := types.ExpandUpdatedStringsPtr(d.Get("tags")); tags != nil {
 			req.Tags = tags
 			shouldUpdateInstance = true
@@ -349,7 +366,7 @@ func ResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	if shouldUpdateInstance {
 		_, err = mongodbAPI.UpdateInstance(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -376,8 +393,8 @@ func ResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		})
 	}
 
-	if d.HasChange("password") {
-		password := d.Get("password").(string)
+	if d.HasChanges("password", "password_wo_version") {
+		password := meta.GetWriteOnlyOrString(d, "password_wo", "password")
 		updateUserRequest.Password = &password
 		shouldUpdateUser = true
 	}
@@ -385,13 +402,13 @@ func ResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	if shouldUpdateUser {
 		_, err = mongodbAPI.UpdateUser(&updateUserRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	_, err = waitForInstance(ctx, mongodbAPI, region, ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	return append(diags, ResourceInstanceRead(ctx, d, m)...)
 }
@@ -399,12 +416,12 @@ func ResourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceInstanceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	mongodbAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForInstance(ctx, mongodbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = mongodbAPI.DeleteInstance(&mongodb.DeleteInstanceRequest{
@@ -412,12 +429,12 @@ func ResourceInstanceDelete(ctx context.Context, d *schema.ResourceData, m inter
 		InstanceID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForInstance(ctx, mongodbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId("")
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	mongodb "github.com/scaleway/scaleway-sdk-go/api/mongodb/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
@@ -88,7 +89,7 @@ func ResourceSnapshot() *schema.Resource {
 func ResourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	mongodbAPI, zone, region, err := newAPIWithZoneAndRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	instanceID := locality.ExpandID(d.Get("instance_id").(string))
 	createReq := &mongodb.CreateSnapshotRequest{
@@ -100,13 +101,13 @@ func ResourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	snapshot, err := mongodbAPI.CreateSnapshot(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	if snapshot != nil {
 		d.SetId(zonal.NewIDString(zone, snapshot.ID))
 		_, err = waitForSnapshot(ctx, mongodbAPI, region, instanceID, snapshot.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -116,17 +117,17 @@ func ResourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceSnapshotRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	mongodbAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	zone, snapshotID, err := zonal.ParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	instanceID := locality.ExpandID(d.Get("instance_id").(string))
 	snapshot, err := waitForSnapshot(ctx, mongodbAPI, region, instanceID, snapshotID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("instance_id", zonal.NewIDString(zone, snapshot.InstanceID))
 	_ = d.Set("name", snapshot.Name)
@@ -145,11 +146,11 @@ func ResourceSnapshotRead(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	mongodbAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_, snapshotID, err := zonal.ParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	updateReq := &mongodb.UpdateSnapshotRequest{
@@ -173,7 +174,7 @@ func ResourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	if hasChanged {
 		_, err = mongodbAPI.UpdateSnapshot(updateReq)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -181,7 +182,7 @@ func ResourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	_, err = waitForSnapshot(ctx, mongodbAPI, region, instanceID, snapshotID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceSnapshotRead(ctx, d, m)
@@ -190,11 +191,11 @@ func ResourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceSnapshotDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	mongodbAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_, snapshotID, err := zonal.ParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	deleteReq := &mongodb.DeleteSnapshotRequest{
@@ -204,7 +205,7 @@ func ResourceSnapshotDelete(_ context.Context, d *schema.ResourceData, m interfa
 
 	_, err = mongodbAPI.DeleteSnapshot(deleteReq)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId("")
@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_mongodb_instance", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_mongodb_instance", &resource.Sweeper{
 		Name: "scaleway_mongodb_instance",
 		F:    testSweepMongodbInstance,
 	})
@@ -33,9 +33,18 @@ func testSweepMongodbInstance(_ string) error {
 		}
 
 		for _, instance := range listInstance.Instances {
-			_, err := mongodbAPI.DeleteInstance(&mongodb.DeleteInstanceRequest{
-				Region:     extractRegion,
-				InstanceID: instance.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_mongodb_instance",
+				Locality:  extractRegion.String(),
+				ID:        instance.ID,
+				Name:      instance.Name,
+				CreatedAt: instance.CreatedAt,
+			}, func() error {
+				_, err := mongodbAPI.DeleteInstance(&mongodb.DeleteInstanceRequest{
+					Region:     extractRegion,
+					InstanceID: instance.ID,
+				})
+				return err
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting mongodb instance in sweeper: %w", err)
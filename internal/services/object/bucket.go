@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 )
@@ -228,7 +229,7 @@ func resourceObjectBucketCreate(ctx context.Context, d *schema.ResourceData, m i
 	bucketName := d.Get("name").(string)
 	s3Client, region, err := s3ClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &s3.CreateBucketInput{
@@ -249,7 +250,7 @@ func resourceObjectBucketCreate(ctx context.Context, d *schema.ResourceData, m i
 		_, err = s3Client.CreateBucket(ctx, req)
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, bucketName))
@@ -264,7 +265,7 @@ func resourceObjectBucketCreate(ctx context.Context, d *schema.ResourceData, m i
 			},
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -274,7 +275,7 @@ func resourceObjectBucketCreate(ctx context.Context, d *schema.ResourceData, m i
 func resourceObjectBucketUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, _, bucketName, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChange("acl") {
@@ -286,7 +287,7 @@ func resourceObjectBucketUpdate(ctx context.Context, d *schema.ResourceData, m i
 		})
 		if err != nil {
 			tflog.Error(ctx, fmt.Sprintf("Couldn't update bucket ACL: %s", err))
-			return diag.FromErr(fmt.Errorf("couldn't update bucket ACL: %s", err))
+			return httperrors.Diagnostics(fmt.Errorf("couldn't update bucket ACL: %w", err))
 		}
 	}
 
@@ -294,11 +295,11 @@ func resourceObjectBucketUpdate(ctx context.Context, d *schema.ResourceData, m i
 	objectLockEnabled := d.Get("object_lock_enabled").(bool)
 	if !objectLockEnabled && d.HasChange("versioning") {
 		if err := resourceObjectBucketVersioningUpdate(ctx, s3Client, d); err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsSet := ExpandObjectBucketTags(d.Get("tags"))
 
 		if len(tagsSet) > 0 {
@@ -314,19 +315,19 @@ func resourceObjectBucketUpdate(ctx context.Context, d *schema.ResourceData, m i
 			})
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	if d.HasChange("cors_rule") {
 		if err := resourceS3BucketCorsUpdate(ctx, s3Client, d); err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	if d.HasChange("lifecycle_rule") {
 		if err := resourceBucketLifecycleUpdate(ctx, s3Client, d); err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -458,7 +459,7 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.Client, d *sche
 func resourceObjectBucketRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, region, bucketName, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var diags diag.Diagnostics
@@ -538,7 +539,7 @@ func resourceObjectBucketRead(ctx context.Context, d *schema.ResourceData, m int
 	})
 
 	if err != nil && !IsS3Err(err, ErrCodeNoSuchCORSConfiguration, "The CORS configuration does not exist") {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("cors_rule", flattenBucketCORS(corsResponse))
@@ -660,7 +661,7 @@ func resourceObjectBucketDelete(ctx context.Context, d *schema.ResourceData, m i
 	s3Client, _, bucketName, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	var nObjectDeleted int64
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = s3Client.DeleteBucket(ctx, &s3.DeleteBucketInput{
@@ -676,7 +677,7 @@ func resourceObjectBucketDelete(ctx context.Context, d *schema.ResourceData, m i
 			if d.Get("force_destroy").(bool) {
 				nObjectDeleted, err = emptyBucket(ctx, s3Client, bucketName, true)
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("error S3 bucket force_destroy: %s", err))
+					return httperrors.Diagnostics(fmt.Errorf("error S3 bucket force_destroy: %w", err))
 				}
 				log.Printf("[DEBUG] Deleted %d S3 objects", nObjectDeleted)
 
@@ -686,7 +687,7 @@ func resourceObjectBucketDelete(ctx context.Context, d *schema.ResourceData, m i
 	}
 
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
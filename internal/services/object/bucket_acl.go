@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
@@ -151,7 +152,7 @@ func ResourceBucketACL() *schema.Resource {
 func resourceBucketACLCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, region, err := s3ClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	regionalID := regional.ExpandID(d.Get("bucket"))
@@ -161,7 +162,7 @@ func resourceBucketACLCreate(ctx context.Context, d *schema.ResourceData, m inte
 	if bucketRegion != "" && bucketRegion != region {
 		conn, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		region = bucketRegion
 	}
@@ -187,7 +188,7 @@ func resourceBucketACLCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	out, err := conn.PutBucketAcl(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error putting Object Storage ACL: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("error putting Object Storage ACL: %w", err))
 	}
 	tflog.Debug(ctx, fmt.Sprintf("output: %v", out))
 
@@ -376,7 +377,7 @@ func resourceBucketACLRead(ctx context.Context, d *schema.ResourceData, m interf
 	expectedBucketOwner := d.Get("expected_bucket_owner")
 	conn, region, bucket, acl, err := s3ClientWithRegionWithNameACL(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	input := &s3.GetBucketAclInput{
@@ -396,7 +397,7 @@ func resourceBucketACLRead(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting object storage bucket ACL (%s): %w", d.Id(), err))
+		return httperrors.Diagnostics(fmt.Errorf("error getting object storage bucket ACL (%s): %w", d.Id(), err))
 	}
 
 	if output == nil {
@@ -406,7 +407,7 @@ func resourceBucketACLRead(ctx context.Context, d *schema.ResourceData, m interf
 	_ = d.Set("acl", acl)
 	_ = d.Set("expected_bucket_owner", expectedBucketOwner)
 	if err := d.Set("access_control_policy", flattenBucketACLAccessControlPolicy(output)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting access_control_policy: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("error setting access_control_policy: %w", err))
 	}
 	_ = d.Set("region", region)
 	_ = d.Set("project_id", NormalizeOwnerID(output.Owner.ID))
@@ -427,7 +428,7 @@ func BucketACLCreateResourceID(region scw.Region, bucket, acl string) string {
 func resourceBucketACLUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, region, bucket, acl, err := s3ClientWithRegionWithNameACL(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	input := &s3.PutBucketAclInput{
@@ -449,7 +450,7 @@ func resourceBucketACLUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	_, err = conn.PutBucketAcl(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating object bucket ACL (%s): %w", d.Id(), err))
+		return httperrors.Diagnostics(fmt.Errorf("error updating object bucket ACL (%s): %w", d.Id(), err))
 	}
 
 	if d.HasChange("acl") {
@@ -463,7 +464,7 @@ func resourceBucketACLUpdate(ctx context.Context, d *schema.ResourceData, m inte
 func resourceBucketACLDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, _, bucket, _, err := s3ClientWithRegionWithNameACL(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = conn.PutBucketAcl(ctx, &s3.PutBucketAclInput{
@@ -471,7 +472,7 @@ func resourceBucketACLDelete(ctx context.Context, d *schema.ResourceData, m inte
 		ACL:    s3Types.BucketCannedACL(s3Types.ObjectCannedACLPrivate),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error putting bucket ACL: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("error putting bucket ACL: %w", err))
 	}
 
 	return diag.Diagnostics{
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 )
@@ -84,7 +85,7 @@ func ResourceLockConfiguration() *schema.Resource {
 func resourceObjectLockConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, region, err := s3ClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	regionalID := regional.ExpandID(d.Get("bucket"))
@@ -94,7 +95,7 @@ func resourceObjectLockConfigurationCreate(ctx context.Context, d *schema.Resour
 	if bucketRegion != "" && bucketRegion != region {
 		conn, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		region = bucketRegion
 	}
@@ -109,7 +110,7 @@ func resourceObjectLockConfigurationCreate(ctx context.Context, d *schema.Resour
 
 	_, err = conn.PutObjectLockConfiguration(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating object bucket (%s) lock configuration: %w", bucket, err))
+		return httperrors.Diagnostics(fmt.Errorf("error creating object bucket (%s) lock configuration: %w", bucket, err))
 	}
 
 	d.SetId(regional.NewIDString(region, bucket))
@@ -120,7 +121,7 @@ func resourceObjectLockConfigurationCreate(ctx context.Context, d *schema.Resour
 func resourceObjectLockConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	input := &s3.GetObjectLockConfigurationInput{
@@ -148,7 +149,7 @@ func resourceObjectLockConfigurationRead(ctx context.Context, d *schema.Resource
 		Bucket: aws.String(bucket),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't read bucket acl: %w", err))
 	}
 	_ = d.Set("project_id", NormalizeOwnerID(acl.Owner.ID))
 
@@ -165,7 +166,7 @@ func resourceObjectLockConfigurationRead(ctx context.Context, d *schema.Resource
 func resourceObjectLockConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	lockConfig := &s3Types.ObjectLockConfiguration{
@@ -180,7 +181,7 @@ func resourceObjectLockConfigurationUpdate(ctx context.Context, d *schema.Resour
 
 	_, err = conn.PutObjectLockConfiguration(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Object bucket lock configuration (%s): %w", d.Id(), err))
+		return httperrors.Diagnostics(fmt.Errorf("error updating Object bucket lock configuration (%s): %w", d.Id(), err))
 	}
 
 	return resourceObjectLockConfigurationRead(ctx, d, m)
@@ -189,7 +190,7 @@ func resourceObjectLockConfigurationUpdate(ctx context.Context, d *schema.Resour
 func resourceObjectLockConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	input := &s3.PutObjectLockConfigurationInput{
@@ -206,7 +207,7 @@ func resourceObjectLockConfigurationDelete(ctx context.Context, d *schema.Resour
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Object bucket lock configuration (%s): %w", d.Id(), err))
+		return httperrors.Diagnostics(fmt.Errorf("error deleting Object bucket lock configuration (%s): %w", d.Id(), err))
 	}
 
 	return nil
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 )
@@ -53,7 +54,7 @@ func ResourceBucketPolicy() *schema.Resource {
 func resourceObjectBucketPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, region, err := s3ClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	regionalID := regional.ExpandID(d.Get("bucket"))
@@ -64,14 +65,14 @@ func resourceObjectBucketPolicyCreate(ctx context.Context, d *schema.ResourceDat
 	if bucketRegion != "" && bucketRegion != region {
 		s3Client, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		region = bucketRegion
 	}
 
 	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("policy (%s) is an invalid JSON: %w", policy, err))
+		return httperrors.Diagnostics(fmt.Errorf("policy (%s) is an invalid JSON: %w", policy, err))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] SCW bucket: %s, put policy: %s", bucket, policy))
@@ -96,7 +97,7 @@ func resourceObjectBucketPolicyCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error putting SCW bucket policy: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("error putting SCW bucket policy: %w", err))
 	}
 
 	d.SetId(regional.NewIDString(region, bucket))
@@ -108,7 +109,7 @@ func resourceObjectBucketPolicyCreate(ctx context.Context, d *schema.ResourceDat
 func resourceObjectBucketPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, region, _, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	regionalID := regional.ExpandID(d.Id())
@@ -134,20 +135,20 @@ func resourceObjectBucketPolicyRead(ctx context.Context, d *schema.ResourceData,
 
 	policyToSet, err := SecondJSONUnlessEquivalent(d.Get("policy").(string), v)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("while setting policy (%s), encountered: %w", policyToSet, err))
+		return httperrors.Diagnostics(fmt.Errorf("while setting policy (%s), encountered: %w", policyToSet, err))
 	}
 
 	policyToSet, err = structure.NormalizeJsonString(policyToSet)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("policy (%s) is an invalid JSON: %w", policyToSet, err))
+		return httperrors.Diagnostics(fmt.Errorf("policy (%s) is an invalid JSON: %w", policyToSet, err))
 	}
 
 	if err := d.Set("policy", policyToSet); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if err := d.Set("bucket", regionalID.String()); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var diags diag.Diagnostics
@@ -168,7 +169,7 @@ func resourceObjectBucketPolicyRead(ctx context.Context, d *schema.ResourceData,
 func resourceObjectBucketPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, _, bucketName, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("scw object bucket: %s, delete policy", bucketName))
@@ -181,7 +182,7 @@ func resourceObjectBucketPolicyDelete(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting SCW Object policy: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("error deleting SCW Object policy: %w", err))
 	}
 
 	return nil
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 )
@@ -84,7 +85,7 @@ func ResourceBucketWebsiteConfiguration() *schema.Resource {
 func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, region, err := s3ClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	regionalID := regional.ExpandID(d.Get("bucket"))
@@ -94,7 +95,7 @@ func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.Res
 	if bucketRegion != "" && bucketRegion != region {
 		conn, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		region = bucketRegion
 	}
@@ -111,7 +112,7 @@ func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.Res
 		Bucket: scw.StringPtr(bucket),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't read bucket: %w", err))
 	}
 
 	input := &s3.PutBucketWebsiteInput{
@@ -121,7 +122,7 @@ func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.Res
 
 	_, err = conn.PutBucketWebsite(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating object bucket (%s) website configuration: %w", bucket, err))
+		return httperrors.Diagnostics(fmt.Errorf("error creating object bucket (%s) website configuration: %w", bucket, err))
 	}
 
 	d.SetId(regional.NewIDString(region, bucket))
@@ -132,7 +133,7 @@ func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.Res
 func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, region, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	input := &s3.GetBucketWebsiteInput{
@@ -150,7 +151,7 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("couldn't read bucket: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't read bucket: %w", err))
 	}
 
 	output, err := conn.GetBucketWebsite(ctx, input)
@@ -173,7 +174,7 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 	_ = d.Set("index_document", flattenBucketWebsiteConfigurationIndexDocument(output.IndexDocument))
 
 	if err := d.Set("error_document", flattenBucketWebsiteConfigurationErrorDocument(output.ErrorDocument)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting error_document: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("error setting error_document: %w", err))
 	}
 
 	websiteEndpoint := WebsiteEndpoint(bucket, region)
@@ -187,7 +188,7 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 		Bucket: aws.String(bucket),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't read bucket acl: %w", err))
 	}
 	_ = d.Set("project_id", NormalizeOwnerID(acl.Owner.ID))
 
@@ -197,7 +198,7 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 func resourceBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	websiteConfig := &s3Types.WebsiteConfiguration{
@@ -215,7 +216,7 @@ func resourceBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.Res
 
 	_, err = conn.PutBucketWebsite(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Object bucket website configuration (%s): %w", d.Id(), err))
+		return httperrors.Diagnostics(fmt.Errorf("error updating Object bucket website configuration (%s): %w", d.Id(), err))
 	}
 
 	return resourceBucketWebsiteConfigurationRead(ctx, d, m)
@@ -224,7 +225,7 @@ func resourceBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.Res
 func resourceBucketWebsiteConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	conn, _, bucket, err := s3ClientWithRegionAndName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	input := &s3.DeleteBucketWebsiteInput{
@@ -238,7 +239,7 @@ func resourceBucketWebsiteConfigurationDelete(ctx context.Context, d *schema.Res
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Object bucket website configuration (%s): %w", d.Id(), err))
+		return httperrors.Diagnostics(fmt.Errorf("error deleting Object bucket website configuration (%s): %w", d.Id(), err))
 	}
 
 	return nil
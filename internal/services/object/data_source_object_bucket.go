@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 )
 
@@ -29,7 +30,7 @@ func DataSourceBucket() *schema.Resource {
 func DataSourceObjectStorageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, region, err := s3ClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	regionalID := regional.ExpandID(d.Get("name"))
@@ -39,7 +40,7 @@ func DataSourceObjectStorageRead(ctx context.Context, d *schema.ResourceData, m
 	if bucketRegion != "" && bucketRegion != region {
 		s3Client, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		region = bucketRegion
 	}
@@ -51,14 +52,14 @@ func DataSourceObjectStorageRead(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[DEBUG] Reading Object Storage bucket: %s", bucket)
 	_, err = s3Client.HeadBucket(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed getting Object Storage bucket (%s): %w", bucket, err))
+		return httperrors.Diagnostics(fmt.Errorf("failed getting Object Storage bucket (%s): %w", bucket, err))
 	}
 
 	acl, err := s3Client.GetBucketAcl(ctx, &s3.GetBucketAclInput{
 		Bucket: aws.String(bucket),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't read bucket acl: %w", err))
 	}
 	_ = d.Set("project_id", NormalizeOwnerID(acl.Owner.ID))
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 )
 
@@ -31,7 +32,7 @@ func DataSourceBucketPolicy() *schema.Resource {
 func DataSourceObjectBucketPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, region, err := s3ClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	regionalID := regional.ExpandID(d.Get("bucket"))
@@ -42,7 +43,7 @@ func DataSourceObjectBucketPolicyRead(ctx context.Context, d *schema.ResourceDat
 	if bucketRegion != "" && bucketRegion != region {
 		s3Client, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		region = bucketRegion
 	}
@@ -57,7 +58,7 @@ func DataSourceObjectBucketPolicyRead(ctx context.Context, d *schema.ResourceDat
 			return diag.FromErr(fmt.Errorf("bucket %s doesn't exist or has no policy", bucket))
 		}
 
-		return diag.FromErr(fmt.Errorf("couldn't read bucket %s policy: %s", bucket, err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't read bucket %s policy: %w", bucket, err))
 	}
 
 	policyString := "{}"
@@ -67,7 +68,7 @@ func DataSourceObjectBucketPolicyRead(ctx context.Context, d *schema.ResourceDat
 
 	policyJSON, err := structure.NormalizeJsonString(policyString)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("policy (%s) is an invalid JSON: %w", policyString, err))
+		return httperrors.Diagnostics(fmt.Errorf("policy (%s) is an invalid JSON: %w", policyString, err))
 	}
 
 	_ = d.Set("policy", policyJSON)
@@ -76,7 +77,7 @@ func DataSourceObjectBucketPolicyRead(ctx context.Context, d *schema.ResourceDat
 		Bucket: aws.String(bucket),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+		return httperrors.Diagnostics(fmt.Errorf("couldn't read bucket acl: %w", err))
 	}
 	_ = d.Set("project_id", NormalizeOwnerID(acl.Owner.ID))
 
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/dsf"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -123,7 +124,7 @@ func ResourceObject() *schema.Resource {
 func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, region, err := s3ClientWithRegion(ctx, d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
@@ -136,7 +137,7 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	if bucketRegion != "" && bucketRegion != region {
 		s3Client, err = s3ClientForceRegion(ctx, d, m, bucketRegion.String())
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		region = bucketRegion
 	}
@@ -160,7 +161,7 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	if encryptionKeyStr, ok := d.GetOk("sse_customer_key"); ok {
 		digestMD5, encryption, err := EncryptCustomerKey(encryptionKeyStr.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		req.SSECustomerAlgorithm = scw.StringPtr("AES256")
 		req.SSECustomerKeyMD5 = &digestMD5
@@ -170,7 +171,7 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	if filePath, hasFile := d.GetOk("file"); hasFile {
 		file, err := os.Open(filePath.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		req.Body = file
 	} else if content, hasContent := d.GetOk("content"); hasContent {
@@ -181,7 +182,7 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		decoded := make([]byte, base64.StdEncoding.DecodedLen(len(contentString)))
 		_, err = base64.StdEncoding.Decode(decoded, contentString)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		req.Body = bytes.NewReader(decoded)
 	} else {
@@ -190,7 +191,7 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	_, err = s3Client.PutObject(ctx, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if rawTags, hasTags := d.GetOk("tags"); hasTags {
@@ -202,7 +203,7 @@ func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, m interfa
 			},
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -227,7 +228,7 @@ func EncryptCustomerKey(encryptionKeyStr string) (string, *string, error) {
 func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, region, key, bucket, err := s3ClientWithRegionAndNestedName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
@@ -247,7 +248,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		if encryptionKey, ok := d.GetOk("sse_customer_key"); ok {
 			digestMD5, encryption, err := EncryptCustomerKey(encryptionKey.(string))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			req.SSECustomerAlgorithm = scw.StringPtr("AES256")
 			req.SSECustomerKeyMD5 = &digestMD5
@@ -256,7 +257,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		if filePath, hasFile := d.GetOk("file"); hasFile {
 			file, err := os.Open(filePath.(string))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			req.Body = file
 		} else {
@@ -275,7 +276,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		if encryptionKey, ok := d.GetOk("sse_customer_key"); ok {
 			digestMD5, encryption, err := EncryptCustomerKey(encryptionKey.(string))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			req.CopySourceSSECustomerAlgorithm = scw.StringPtr("AES256")
 			req.CopySourceSSECustomerKeyMD5 = &digestMD5
@@ -284,7 +285,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		_, err = s3Client.CopyObject(ctx, req)
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChanges("key", "bucket") {
@@ -293,11 +294,11 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			Bucket: scw.StringPtr(bucket),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		_, err := s3Client.PutObjectTagging(ctx, &s3.PutObjectTaggingInput{
 			Bucket: types.ExpandStringPtr(bucketUpdated),
 			Key:    types.ExpandStringPtr(key),
@@ -306,7 +307,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			},
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -318,7 +319,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 func resourceObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, region, key, bucket, err := s3ClientWithRegionAndNestedName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
@@ -336,7 +337,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	obj, err := s3Client.HeadObject(ctx, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("region", region)
@@ -356,7 +357,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, m interface
 		Key:    types.ExpandStringPtr(key),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("tags", flattenObjectBucketTags(tags.TagSet))
@@ -366,7 +367,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, m interface
 		Key:    types.ExpandStringPtr(key),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if objectIsPublic(acl) {
@@ -381,7 +382,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, m interface
 func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	s3Client, _, key, bucket, err := s3ClientWithRegionAndNestedName(ctx, d, m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
@@ -394,7 +395,7 @@ func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, m interfa
 
 	_, err = s3Client.DeleteObject(ctx, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -13,7 +13,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_object_bucket", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_object_bucket", &resource.Sweeper{
 		Name: "scaleway_object_bucket",
 		F:    testSweepStorageObjectBucket,
 	})
@@ -34,13 +34,20 @@ func testSweepStorageObjectBucket(_ string) error {
 
 		for _, bucket := range listBucketResponse.Buckets {
 			logging.L.Debugf("Deleting %q bucket", *bucket.Name)
-			if acctest.IsTestResource(*bucket.Name) {
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_object_bucket",
+				Locality:  region.String(),
+				ID:        *bucket.Name,
+				Name:      *bucket.Name,
+				CreatedAt: bucket.CreationDate,
+			}, func() error {
 				_, err := s3client.DeleteBucket(ctx, &s3.DeleteBucketInput{
 					Bucket: bucket.Name,
 				})
-				if err != nil {
-					return fmt.Errorf("error deleting bucket in Sweeper: %s", err)
-				}
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("error deleting bucket in Sweeper: %s", err)
 			}
 		}
 
@@ -77,18 +77,18 @@ func ResourceACL() *schema.Resource {
 func ResourceACLCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
 	_, err = waitForRDBInstance(ctx, api, region, locality.ExpandID(instanceID), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	aclRules, err := rdbACLExpand(d.Get("acl_rules").([]interface{}))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	createReq := &rdb.SetInstanceACLRulesRequest{
 		Region:     region,
@@ -98,7 +98,7 @@ func ResourceACLCreate(ctx context.Context, d *schema.ResourceData, m interface{
 
 	_, err = api.SetInstanceACLRules(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(instanceID)
@@ -109,12 +109,12 @@ func ResourceACLCreate(ctx context.Context, d *schema.ResourceData, m interface{
 func ResourceRdbACLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, instanceID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutRead))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := rdbAPI.ListInstanceACLRules(&rdb.ListInstanceACLRulesRequest{
@@ -126,7 +126,7 @@ func ResourceRdbACLRead(ctx context.Context, d *schema.ResourceData, m interface
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	id := regional.NewID(region, instanceID).String()
@@ -159,23 +159,23 @@ func ResourceRdbACLRead(ctx context.Context, d *schema.ResourceData, m interface
 func ResourceACLUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, instanceID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChange("acl_rules") {
 		_, err := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		aclRules, err := rdbACLExpand(d.Get("acl_rules").([]interface{}))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		req := &rdb.SetInstanceACLRulesRequest{
 			Region:     region,
@@ -185,7 +185,7 @@ func ResourceACLUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 
 		_, err = rdbAPI.SetInstanceACLRules(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -195,12 +195,12 @@ func ResourceACLUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 func ResourceACLDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, instanceID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	aclRuleIPs := make([]string, 0)
 	aclRules, err := rdbACLExpand(d.Get("acl_rules").([]interface{}))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	for _, acl := range aclRules {
 		aclRuleIPs = append(aclRuleIPs, acl.IP.String())
@@ -208,7 +208,7 @@ func ResourceACLDelete(ctx context.Context, d *schema.ResourceData, m interface{
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = rdbAPI.DeleteInstanceACLRules(&rdb.DeleteInstanceACLRulesRequest{
@@ -217,12 +217,12 @@ func ResourceACLDelete(ctx context.Context, d *schema.ResourceData, m interface{
 		ACLRuleIPs: aclRuleIPs,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 )
 
@@ -27,7 +28,7 @@ func DataSourceACL() *schema.Resource {
 func DataSourceRDBACLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	_, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	instanceID, _ := d.GetOk("instance_id")
 
@@ -40,7 +41,7 @@ func DataSourceRDBACLRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.SetId(regionalID.(string))
 	err = d.Set("instance_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	return ResourceRdbACLRead(ctx, d, m)
 }
@@ -0,0 +1,105 @@
+package rdb
+
+import (
+	"context"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/framework"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+const certificateEphemeralResourceType = "scaleway_rdb_certificate"
+
+var _ ephemeral.EphemeralResourceWithConfigure = (*CertificateEphemeralResource)(nil)
+
+// CertificateEphemeralResource reads the TLS certificate of a database instance without storing it in the state.
+type CertificateEphemeralResource struct {
+	meta *meta.Meta
+}
+
+func NewCertificateEphemeralResource() ephemeral.EphemeralResource { //nolint:ireturn
+	return &CertificateEphemeralResource{}
+}
+
+type certificateEphemeralResourceModel struct {
+	InstanceID  types.String `tfsdk:"instance_id"`
+	Region      types.String `tfsdk:"region"`
+	Certificate types.String `tfsdk:"certificate"`
+}
+
+func (r *CertificateEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = certificateEphemeralResourceType
+}
+
+func (r *CertificateEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the TLS certificate of a database instance without storing it in the state",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Description: "The ID of the database instance",
+				Required:    true,
+				Validators: []validator.String{
+					framework.ValidateDiagFunc(verify.IsUUIDorUUIDWithLocality()),
+				},
+			},
+			"region": framework.RegionEphemeralAttribute(),
+			"certificate": schema.StringAttribute{
+				Description: "Certificate of the database instance, in PEM format",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *CertificateEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	m, diags := framework.ExtractMeta(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.meta = m
+}
+
+func (r *CertificateEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, span := framework.StartOperation(ctx, r.meta, certificateEphemeralResourceType, "open")
+	defer framework.EndOperation(span, &resp.Diagnostics)
+
+	var config certificateEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, instanceID, err := framework.ExtractRegionalID(config.InstanceID, config.Region, r.meta)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+		return
+	}
+
+	cert, err := rdb.NewAPI(r.meta.ScwClient()).GetInstanceCertificate(&rdb.GetInstanceCertificateRequest{
+		Region:     region,
+		InstanceID: instanceID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		framework.AddError(&resp.Diagnostics, "Cannot get the certificate of the database instance", err)
+		return
+	}
+	certContent, err := io.ReadAll(cert.Content)
+	if err != nil {
+		framework.AddError(&resp.Diagnostics, "Cannot read the certificate of the database instance", err)
+		return
+	}
+
+	config.InstanceID = types.StringValue(regional.NewIDString(region, instanceID))
+	config.Region = types.StringValue(region.String())
+	config.Certificate = types.StringValue(string(certContent))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -42,7 +43,7 @@ func DataSourceDatabaseBackup() *schema.Resource {
 func DataSourceRDBDatabaseBackupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	backupID, backupIDExists := d.GetOk("backup_id")
@@ -55,7 +56,7 @@ func DataSourceRDBDatabaseBackupRead(ctx context.Context, d *schema.ResourceData
 			ProjectID:  types.ExpandStringPtr(d.Get("project_id")),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundBackup, err := datasource.FindExact(
@@ -64,7 +65,7 @@ func DataSourceRDBDatabaseBackupRead(ctx context.Context, d *schema.ResourceData
 			backupName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		backupID = foundBackup.ID
@@ -74,7 +75,7 @@ func DataSourceRDBDatabaseBackupRead(ctx context.Context, d *schema.ResourceData
 	d.SetId(regionID)
 	err = d.Set("backup_id", regionID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := ResourceRdbDatabaseBackupRead(ctx, d, m)
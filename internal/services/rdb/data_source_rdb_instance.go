@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -15,6 +16,9 @@ import (
 func DataSourceInstance() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasource.SchemaFromResourceSchema(ResourceInstance().Schema)
+	delete(dsSchema, "skip_wait")
+	delete(dsSchema, "password_wo")
+	delete(dsSchema, "password_wo_version")
 	// Set 'Optional' schema elements
 	datasource.AddOptionalFieldsToSchema(dsSchema, "name", "region", "project_id")
 
@@ -36,7 +40,7 @@ func DataSourceInstance() *schema.Resource {
 func DataSourceRDBInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	instanceID, ok := d.GetOk("instance_id")
@@ -48,7 +52,7 @@ func DataSourceRDBInstanceRead(ctx context.Context, d *schema.ResourceData, m in
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundInstance, err := datasource.FindExact(
@@ -57,7 +61,7 @@ func DataSourceRDBInstanceRead(ctx context.Context, d *schema.ResourceData, m in
 			instanceName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		instanceID = foundInstance.ID
@@ -67,7 +71,7 @@ func DataSourceRDBInstanceRead(ctx context.Context, d *schema.ResourceData, m in
 	d.SetId(regionalID)
 	err = d.Set("instance_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	return ResourceRdbInstanceRead(ctx, d, m)
 }
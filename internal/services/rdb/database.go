@@ -86,12 +86,12 @@ func ResourceRdbDatabaseCreate(ctx context.Context, d *schema.ResourceData, m in
 	rdbAPI := newAPI(m)
 	region, instanceID, err := regional.ParseID(d.Get("instance_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	createReq := &rdb.CreateDatabaseRequest{
@@ -116,12 +116,12 @@ func ResourceRdbDatabaseCreate(ctx context.Context, d *schema.ResourceData, m in
 		return nil
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(ResourceRdbDatabaseID(region, instanceID, db.Name))
@@ -151,7 +151,7 @@ func ResourceRdbDatabaseRead(ctx context.Context, d *schema.ResourceData, m inte
 	rdbAPI := newAPI(m)
 	region, instanceID, databaseName, err := ResourceRdbDatabaseParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	database, err := getDatabase(ctx, rdbAPI, region, instanceID, databaseName)
@@ -160,7 +160,7 @@ func ResourceRdbDatabaseRead(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(ResourceRdbDatabaseID(region, instanceID, database.Name))
@@ -178,12 +178,12 @@ func ResourceRdbDatabaseDelete(ctx context.Context, d *schema.ResourceData, m in
 	rdbAPI := newAPI(m)
 	region, instanceID, databaseName, err := ResourceRdbDatabaseParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = rdbAPI.DeleteDatabase(&rdb.DeleteDatabaseRequest{
@@ -192,12 +192,12 @@ func ResourceRdbDatabaseDelete(ctx context.Context, d *schema.ResourceData, m in
 		Name:       databaseName,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
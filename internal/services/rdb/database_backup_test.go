@@ -7,46 +7,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	rdbSDK "github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
-	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
-	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/rdb"
 	rdbchecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/rdb/testfuncs"
 )
 
-func init() {
-	resource.AddTestSweepers("scaleway_rdb_database_backup", &resource.Sweeper{
-		Name: "scaleway_rdb_database_backup",
-		F:    testSweepDatabaseBackup,
-	})
-}
-
-func testSweepDatabaseBackup(_ string) error {
-	return acctest.SweepRegions(scw.AllRegions, func(scwClient *scw.Client, region scw.Region) error {
-		rdbAPI := rdbSDK.NewAPI(scwClient)
-		logging.L.Debugf("sweeper: destroying the rdb database backups in (%s)", region)
-		listBackups, err := rdbAPI.ListDatabaseBackups(&rdbSDK.ListDatabaseBackupsRequest{
-			Region: region,
-		})
-		if err != nil {
-			return fmt.Errorf("error listing rdb database backups in (%s) in sweeper: %s", region, err)
-		}
-
-		for _, backup := range listBackups.DatabaseBackups {
-			_, err := rdbAPI.DeleteDatabaseBackup(&rdbSDK.DeleteDatabaseBackupRequest{
-				Region:           region,
-				DatabaseBackupID: backup.ID,
-			})
-			if err != nil && !httperrors.Is404(err) {
-				return fmt.Errorf("error deleting rdb database backup in sweeper: %s", err)
-			}
-		}
-
-		return nil
-	})
-}
-
 func TestAccDatabaseBackup_Basic(t *testing.T) {
 	tt := acctest.NewTestTools(t)
 	defer tt.Cleanup()
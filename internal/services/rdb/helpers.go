@@ -3,6 +3,7 @@ package rdb
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/cdf"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -100,3 +102,51 @@ func getIPConfigUpdate(d *schema.ResourceData, ipFieldName string) (ipamConfig *
 	}
 	return ipamConfig, staticConfig
 }
+
+// customDiffInstanceCapacity checks that the node type of a new or resized instance is in stock
+// and that the organization quota, which counts the instances of all regions, allows a new instance.
+func customDiffInstanceCapacity(ctx context.Context, diff *schema.ResourceDiff, client *scw.Client) error {
+	api := rdb.NewAPI(client)
+	nodeType := diff.Get("node_type").(string)
+
+	region := scw.Region(diff.Get("region").(string))
+	if region == "" {
+		region, _ = client.GetDefaultRegion()
+	}
+
+	nodeTypes, err := api.ListNodeTypes(&rdb.ListNodeTypesRequest{
+		Region:               region,
+		IncludeDisabledTypes: true,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return err
+	}
+	for _, nt := range nodeTypes.NodeTypes {
+		if strings.EqualFold(nt.Name, nodeType) && (nt.Disabled || nt.StockStatus == rdb.NodeTypeStockOutOfStock) {
+			return cdf.OutOfStockError(fmt.Sprintf("node type %s in %s", nt.Name, region), 1)
+		}
+	}
+
+	if diff.Id() != "" {
+		return nil
+	}
+
+	return cdf.CheckQuota(ctx, client, 1, cdf.Quota{
+		Name: "rdb_instances",
+		Used: func() (uint64, error) {
+			organizationID, _ := client.GetDefaultOrganizationID()
+			used := uint64(0)
+			for _, region := range api.Regions() {
+				instances, err := api.ListInstances(&rdb.ListInstancesRequest{
+					Region:         region,
+					OrganizationID: &organizationID,
+				}, scw.WithContext(ctx))
+				if err != nil {
+					return 0, err
+				}
+				used += uint64(instances.TotalCount)
+			}
+			return used, nil
+		},
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
@@ -17,6 +18,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -97,10 +99,26 @@ func ResourceInstance() *schema.Resource {
 				Description: "Identifier for the first user of the database instance",
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Sensitive:   true,
-				Optional:    true,
-				Description: "Password for the first user of the database instance",
+				Type:          schema.TypeString,
+				Sensitive:     true,
+				Optional:      true,
+				Description:   "Password for the first user of the database instance",
+				ConflictsWith: []string{"password_wo"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				Description:   "Write-only password for the first user of the database instance, sent to the API but never stored in the state. Requires Terraform 1.11 or later",
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Version of the write-only password. Changing it rotates the password to the current value of password_wo",
+				RequiredWith: []string{"password_wo"},
 			},
 			"settings": {
 				Type: schema.TypeMap,
@@ -309,19 +327,32 @@ func ResourceInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Enable or disable encryption at rest for the database instance",
 			},
+			"skip_wait": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Return right after the create and update calls instead of waiting for the database instance to be ready",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the database instance",
+			},
 			// Common
 			"region":          regional.Schema(),
 			"organization_id": account.OrganizationIDSchema(),
 			"project_id":      account.ProjectIDSchema(),
 		},
-		CustomizeDiff: cdf.LocalityCheck("private_network.#.pn_id"),
+		CustomizeDiff: customdiff.All(
+			cdf.LocalityCheck("private_network.#.pn_id"),
+			cdf.CapacityCheck(customDiffInstanceCapacity, "node_type"),
+		),
 	}
 }
 
 func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	createReq := &rdb.CreateInstanceRequest{
@@ -333,7 +364,7 @@ func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m in
 		IsHaCluster:   d.Get("is_ha_cluster").(bool),
 		DisableBackup: d.Get("disable_backup").(bool),
 		UserName:      d.Get("user_name").(string),
-		Password:      d.Get("password").(string),
+		Password:      meta.GetWriteOnlyOrString(d, "password_wo", "password"),
 		VolumeType:    rdb.VolumeType(d.Get("volume_type").(string)),
 		Encryption: &rdb.EncryptionAtRest{
 			Enabled: d.Get("encryption_at_rest").(bool),
@@ -374,7 +405,7 @@ func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m in
 
 	res, err := rdbAPI.CreateInstance(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, res.ID))
@@ -407,18 +438,18 @@ func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m in
 	if mustUpdate {
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		_, err = rdbAPI.UpdateInstance(updateReq, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 	// Configure Instance settings
 	if settings, ok := d.GetOk("settings"); ok {
 		res, err = waitForRDBInstance(ctx, rdbAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err := rdbAPI.SetInstanceSettings(&rdb.SetInstanceSettingsRequest{
@@ -427,7 +458,7 @@ func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m in
 			Settings:   expandInstanceSettings(settings),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -437,19 +468,28 @@ func ResourceRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, m in
 func ResourceRdbInstanceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
-	// verify resource is ready
-	res, err := waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
+	// verify resource is ready, unless the transitional status must be reported
+	var res *rdb.Instance
+	if meta.ExtractSkipWait(d, m) {
+		res, err = rdbAPI.GetInstance(&rdb.GetInstanceRequest{
+			Region:     region,
+			InstanceID: ID,
+		}, scw.WithContext(ctx))
+	} else {
+		res, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
+	}
 	if err != nil {
 		if httperrors.Is404(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("name", res.Name)
+	_ = d.Set("status", res.Status.String())
 	_ = d.Set("node_type", res.NodeType)
 	_ = d.Set("engine", res.Engine)
 	_ = d.Set("is_ha_cluster", res.IsHaCluster)
@@ -504,7 +544,7 @@ func ResourceRdbInstanceRead(ctx context.Context, d *schema.ResourceData, m inte
 			InstanceID: res.ID,
 		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		for _, u := range users.Users {
 			if u.IsAdmin {
@@ -521,11 +561,11 @@ func ResourceRdbInstanceRead(ctx context.Context, d *schema.ResourceData, m inte
 		InstanceID: ID,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	certContent, err := io.ReadAll(cert.Content)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("certificate", string(certContent))
 
@@ -550,7 +590,7 @@ func ResourceRdbInstanceRead(ctx context.Context, d *schema.ResourceData, m inte
 func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////////////////////
@@ -563,7 +603,7 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 		InstanceID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	diskIsFull := rdbInstance.Status == rdb.InstanceStatusDiskFull
 	volType := rdb.VolumeType(d.Get("volume_type").(string))
@@ -680,17 +720,17 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 	for i := range upgradeInstanceRequests {
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = rdbAPI.UpgradeInstance(&upgradeInstanceRequests[i], scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -717,7 +757,7 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 	if d.HasChange("backup_same_region") {
 		req.BackupSameRegion = types.ExpandBoolPtr(d.Get("backup_same_region"))
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 
@@ -730,12 +770,12 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = rdbAPI.UpdateInstance(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	////////////////////
@@ -744,7 +784,7 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 	if d.HasChange("settings") {
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		_, err := rdbAPI.SetInstanceSettings(&rdb.SetInstanceSettingsRequest{
 			InstanceID: ID,
@@ -752,29 +792,29 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 			Settings:   expandInstanceSettings(d.Get("settings")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	////////////////////
 	// Update user
 	////////////////////
-	if d.HasChange("password") {
+	if d.HasChanges("password", "password_wo_version") {
 		_, err := waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		req := &rdb.UpdateUserRequest{
 			Region:     region,
 			InstanceID: ID,
 			Name:       d.Get("user_name").(string),
-			Password:   types.ExpandStringPtr(d.Get("password")),
+			Password:   types.ExpandStringPtr(meta.GetWriteOnlyOrString(d, "password_wo", "password")),
 		}
 
 		_, err = rdbAPI.UpdateUser(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -785,7 +825,7 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 		// retrieve state
 		res, err := waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		// delete old endpoint
@@ -797,14 +837,14 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 					},
 					scw.WithContext(ctx))
 				if err != nil {
-					diag.FromErr(err)
+					httperrors.Diagnostics(err)
 				}
 			}
 		}
 		// retrieve state
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		// set new endpoint
 		pn, pnExist := d.GetOk("private_network")
@@ -822,7 +862,7 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 					&rdb.CreateEndpointRequest{Region: region, InstanceID: ID, EndpointSpec: e},
 					scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 			}
 		}
@@ -831,7 +871,7 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 		// retrieve state
 		res, err := waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		// delete old endpoint
 		for _, e := range res.Endpoints {
@@ -841,14 +881,14 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 					Region:     region,
 				}, scw.WithContext(ctx))
 				if err != nil {
-					diag.FromErr(err)
+					httperrors.Diagnostics(err)
 				}
 			}
 		}
 		// retrieve state
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		// set new endpoint
 		if _, lbExists := d.GetOk("load_balancer"); lbExists {
@@ -858,7 +898,7 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 				EndpointSpec: expandLoadBalancer(),
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -869,13 +909,13 @@ func ResourceRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, m in
 func ResourceRdbInstanceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// We first wait in case the instance is in a transient state
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = rdbAPI.DeleteInstance(&rdb.DeleteInstanceRequest{
@@ -883,13 +923,13 @@ func ResourceRdbInstanceDelete(ctx context.Context, d *schema.ResourceData, m in
 		InstanceID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// Lastly wait in case the instance is in a transient state
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -71,13 +71,13 @@ func ResourcePrivilege() *schema.Resource {
 func ResourceRdbPrivilegeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	instanceID := locality.ExpandID(d.Get("instance_id").(string))
 	_, err = waitForRDBInstance(ctx, api, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	userName, _ := d.Get("user_name").(string)
@@ -106,12 +106,12 @@ func ResourceRdbPrivilegeCreate(ctx context.Context, d *schema.ResourceData, m i
 		return nil
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, api, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(ResourceRdbUserPrivilegeID(region, locality.ExpandID(instanceID), databaseName, userName))
@@ -124,7 +124,7 @@ func ResourceRdbPrivilegeRead(ctx context.Context, d *schema.ResourceData, m int
 
 	region, instanceID, databaseName, userName, err := ResourceRdbUserPrivilegeParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, api, region, instanceID, d.Timeout(schema.TimeoutRead))
@@ -133,7 +133,7 @@ func ResourceRdbPrivilegeRead(ctx context.Context, d *schema.ResourceData, m int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	listUsers, err := api.ListUsers(&rdb.ListUsersRequest{
@@ -146,7 +146,7 @@ func ResourceRdbPrivilegeRead(ctx context.Context, d *schema.ResourceData, m int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if listUsers == nil || len(listUsers.Users) == 0 {
@@ -165,7 +165,7 @@ func ResourceRdbPrivilegeRead(ctx context.Context, d *schema.ResourceData, m int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if len(res.Privileges) == 0 {
@@ -185,11 +185,11 @@ func ResourceRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceData, m i
 	rdbAPI := newAPI(m)
 	region, instanceID, databaseName, userName, err := ResourceRdbUserPrivilegeParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	listUsers, err := rdbAPI.ListUsers(&rdb.ListUsersRequest{
@@ -202,7 +202,7 @@ func ResourceRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceData, m i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if listUsers == nil || len(listUsers.Users) == 0 {
@@ -234,12 +234,12 @@ func ResourceRdbPrivilegeUpdate(ctx context.Context, d *schema.ResourceData, m i
 		return nil
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -250,12 +250,12 @@ func ResourceRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceData, m i
 	rdbAPI := newAPI(m)
 	region, instanceID, databaseName, userName, err := ResourceRdbUserPrivilegeParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("permission", rdb.PermissionNone)
@@ -269,7 +269,7 @@ func ResourceRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceData, m i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if listUsers != nil && len(listUsers.Users) == 0 {
@@ -319,12 +319,12 @@ func ResourceRdbPrivilegeDelete(ctx context.Context, d *schema.ResourceData, m i
 		return nil
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
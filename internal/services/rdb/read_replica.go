@@ -158,7 +158,7 @@ func ResourceReadReplica() *schema.Resource {
 func ResourceRdbReadReplicaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	endpointSpecs := []*rdb.ReadReplicaEndpointSpec(nil)
@@ -184,14 +184,14 @@ func ResourceRdbReadReplicaCreate(ctx context.Context, d *schema.ResourceData, m
 		SameZone:     types.ExpandBoolPtr(d.Get("same_zone")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create read-replica: %w", err))
+		return httperrors.Diagnostics(fmt.Errorf("failed to create read-replica: %w", err))
 	}
 
 	d.SetId(regional.NewIDString(region, rr.ID))
 
 	_, err = waitForRDBReadReplica(ctx, rdbAPI, region, rr.ID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceRdbReadReplicaRead(ctx, d, m)
@@ -200,7 +200,7 @@ func ResourceRdbReadReplicaCreate(ctx context.Context, d *schema.ResourceData, m
 func ResourceRdbReadReplicaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	rr, err := waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
@@ -209,7 +209,7 @@ func ResourceRdbReadReplicaRead(ctx context.Context, d *schema.ResourceData, m i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	directAccess, privateNetwork := flattenReadReplicaEndpoints(rr.Endpoints)
@@ -228,7 +228,7 @@ func ResourceRdbReadReplicaRead(ctx context.Context, d *schema.ResourceData, m i
 func ResourceRdbReadReplicaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// verify resource is ready
@@ -238,7 +238,7 @@ func ResourceRdbReadReplicaUpdate(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	newEndpoints := []*rdb.ReadReplicaEndpointSpec(nil)
@@ -252,14 +252,14 @@ func ResourceRdbReadReplicaUpdate(ctx context.Context, d *schema.ResourceData, m
 					EndpointID: e.ID,
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 			}
 		}
 		// retrieve state
 		rr, err = waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		// create a new one if defined
 		if directAccess, directAccessExists := d.GetOk("direct_access"); directAccessExists {
@@ -276,14 +276,14 @@ func ResourceRdbReadReplicaUpdate(ctx context.Context, d *schema.ResourceData, m
 					EndpointID: e.ID,
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return httperrors.Diagnostics(err)
 				}
 			}
 		}
 		// retrieve state
 		_, err = waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		// create a new one if defined
 		if pn, pnExists := d.GetOk("private_network"); pnExists {
@@ -302,7 +302,7 @@ func ResourceRdbReadReplicaUpdate(ctx context.Context, d *schema.ResourceData, m
 	if len(newEndpoints) > 0 {
 		_, err := waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		_, err = rdbAPI.CreateReadReplicaEndpoint(&rdb.CreateReadReplicaEndpointRequest{
 			Region:        region,
@@ -310,13 +310,13 @@ func ResourceRdbReadReplicaUpdate(ctx context.Context, d *schema.ResourceData, m
 			EndpointSpec:  newEndpoints,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	_, err = waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceRdbReadReplicaRead(ctx, d, m)
@@ -325,13 +325,13 @@ func ResourceRdbReadReplicaUpdate(ctx context.Context, d *schema.ResourceData, m
 func ResourceRdbReadReplicaDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// We first wait in case the instance is in a transient state
 	_, err = waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = rdbAPI.DeleteReadReplica(&rdb.DeleteReadReplicaRequest{
@@ -339,13 +339,13 @@ func ResourceRdbReadReplicaDelete(ctx context.Context, d *schema.ResourceData, m
 		ReadReplicaID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// Lastly wait in case the instance is in a transient state
 	_, err = waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
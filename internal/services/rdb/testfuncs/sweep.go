@@ -7,14 +7,19 @@ import (
 	rdbSDK "github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_rdb_instance", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_rdb_instance", &resource.Sweeper{
 		Name: "scaleway_rdb_instance",
 		F:    testSweepInstance,
 	})
+	acctest.AddTestSweepers("scaleway_rdb_database_backup", &resource.Sweeper{
+		Name: "scaleway_rdb_database_backup",
+		F:    testSweepDatabaseBackup,
+	})
 }
 
 func testSweepInstance(_ string) error {
@@ -29,9 +34,18 @@ func testSweepInstance(_ string) error {
 		}
 
 		for _, instance := range listInstances.Instances {
-			_, err := rdbAPI.DeleteInstance(&rdbSDK.DeleteInstanceRequest{
-				Region:     region,
-				InstanceID: instance.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_rdb_instance",
+				Locality:  region.String(),
+				ID:        instance.ID,
+				Name:      instance.Name,
+				CreatedAt: instance.CreatedAt,
+			}, func() error {
+				_, err := rdbAPI.DeleteInstance(&rdbSDK.DeleteInstanceRequest{
+					Region:     region,
+					InstanceID: instance.ID,
+				})
+				return err
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting rdb instance in sweeper: %s", err)
@@ -41,3 +55,40 @@ func testSweepInstance(_ string) error {
 		return nil
 	})
 }
+
+func testSweepDatabaseBackup(_ string) error {
+	return acctest.SweepRegions(scw.AllRegions, func(scwClient *scw.Client, region scw.Region) error {
+		rdbAPI := rdbSDK.NewAPI(scwClient)
+		logging.L.Debugf("sweeper: destroying the rdb database backups in (%s)", region)
+		listBackups, err := rdbAPI.ListDatabaseBackups(&rdbSDK.ListDatabaseBackupsRequest{
+			Region: region,
+		})
+		if err != nil {
+			return fmt.Errorf("error listing rdb database backups in (%s) in sweeper: %s", region, err)
+		}
+
+		for _, backup := range listBackups.DatabaseBackups {
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_rdb_database_backup",
+				Locality:  region.String(),
+				ID:        backup.ID,
+				Name:      backup.Name,
+				CreatedAt: backup.CreatedAt,
+			}, func() error {
+				_, err := rdbAPI.DeleteDatabaseBackup(&rdbSDK.DeleteDatabaseBackupRequest{
+					Region:           region,
+					DatabaseBackupID: backup.ID,
+				})
+				if httperrors.Is404(err) {
+					return nil
+				}
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("error deleting rdb database backup in sweeper: %s", err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -52,7 +53,7 @@ func expandPrivateNetwork(data interface{}, exist bool, ipamConfig *bool, static
 		if staticConfig != nil {
 			ip, err := types.ExpandIPNet(*staticConfig)
 			if err != nil {
-				return nil, append(diags, diag.FromErr(fmt.Errorf("failed to parse private_network ip_net (%s): %s", r["ip_net"], err))...)
+				return nil, append(diags, httperrors.Diagnostics(fmt.Errorf("failed to parse private_network ip_net (%s): %w", r["ip_net"], err))...)
 			}
 			spec.PrivateNetwork.ServiceIP = &ip
 			spec.PrivateNetwork.IpamConfig = nil
@@ -84,7 +85,7 @@ func flattenPrivateNetwork(endpoints []*rdb.Endpoint) (interface{}, bool) {
 			pn := endpoint.PrivateNetwork
 			fetchRegion, err := pn.Zone.Region()
 			if err != nil {
-				return diag.FromErr(err), false
+				return httperrors.Diagnostics(err), false
 			}
 			pnRegionalID := regional.NewIDString(fetchRegion, pn.PrivateNetworkID)
 			serviceIP, err := types.FlattenIPNet(pn.ServiceIP)
@@ -161,7 +162,7 @@ func expandReadReplicaEndpointsSpecPrivateNetwork(data interface{}, ipamConfig *
 	if staticConfig != nil {
 		ipNet, err := types.ExpandIPNet(*staticConfig)
 		if err != nil {
-			return nil, append(diags, diag.FromErr(fmt.Errorf("failed to parse private_network service_ip (%s): %s", rawEndpoint["service_ip"], err))...)
+			return nil, append(diags, httperrors.Diagnostics(fmt.Errorf("failed to parse private_network service_ip (%s): %w", rawEndpoint["service_ip"], err))...)
 		}
 		endpoint.PrivateNetwork.ServiceIP = &ipNet
 		endpoint.PrivateNetwork.IpamConfig = nil
@@ -194,7 +195,7 @@ func flattenReadReplicaEndpoints(endpoints []*rdb.Endpoint) (directAccess, priva
 		if endpoint.PrivateNetwork != nil {
 			fetchRegion, err := endpoint.PrivateNetwork.Zone.Region()
 			if err != nil {
-				return diag.FromErr(err), false
+				return httperrors.Diagnostics(err), false
 			}
 			pnRegionalID := regional.NewIDString(fetchRegion, endpoint.PrivateNetwork.PrivateNetworkID)
 			enableIpam := false
@@ -15,6 +15,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -51,10 +52,26 @@ func ResourceUser() *schema.Resource {
 				ForceNew:    true,
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "Database user password",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Description:  "Database user password",
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				Description:   "Write-only database user password, sent to the API but never stored in the state. Requires Terraform 1.11 or later",
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Version of the write-only password. Changing it rotates the password to the current value of password_wo",
+				RequiredWith: []string{"password_wo"},
 			},
 			"is_admin": {
 				Type:        schema.TypeBool,
@@ -74,19 +91,19 @@ func ResourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	regionalID := d.Get("instance_id").(string)
 	region, instanceID, err := regional.ParseID(regionalID)
 	if err != nil {
-		diag.FromErr(err)
+		httperrors.Diagnostics(err)
 	}
 
 	ins, err := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	createReq := &rdb.CreateUserRequest{
 		Region:     region,
 		InstanceID: ins.ID,
 		Name:       d.Get("name").(string),
-		Password:   d.Get("password").(string),
+		Password:   meta.GetWriteOnlyOrString(d, "password_wo", "password"),
 		IsAdmin:    d.Get("is_admin").(bool),
 	}
 
@@ -109,7 +126,7 @@ func ResourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 		return nil
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(ResourceUserID(region, locality.ExpandID(instanceID), user.Name))
@@ -121,7 +138,7 @@ func ResourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	rdbAPI := newAPI(m)
 	region, instanceID, userName, err := ResourceUserParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutRead))
@@ -130,7 +147,7 @@ func ResourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := rdbAPI.ListUsers(&rdb.ListUsersRequest{
@@ -143,7 +160,7 @@ func ResourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	if len(res.Users) == 0 {
 		tflog.Warn(ctx, fmt.Sprintf("couldn'd find user with name: [%s]", userName))
@@ -167,12 +184,12 @@ func ResourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	// resource depends on the instance locality
 	region, instanceID, userName, err := ResourceUserParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &rdb.UpdateUserRequest{
@@ -181,8 +198,8 @@ func ResourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		Name:       userName,
 	}
 
-	if d.HasChange("password") {
-		req.Password = types.ExpandStringPtr(d.Get("password"))
+	if d.HasChanges("password", "password_wo_version") {
+		req.Password = types.ExpandStringPtr(meta.GetWriteOnlyOrString(d, "password_wo", "password"))
 	}
 	if d.HasChange("is_admin") {
 		req.IsAdmin = scw.BoolPtr(d.Get("is_admin").(bool))
@@ -190,7 +207,7 @@ func ResourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 	_, err = rdbAPI.UpdateUser(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceUserRead(ctx, d, m)
@@ -201,12 +218,12 @@ func ResourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface
 	// resource depends on the instance locality
 	region, instanceID, userName, err := ResourceUserParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
@@ -230,7 +247,7 @@ func ResourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface
 	})
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
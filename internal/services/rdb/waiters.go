@@ -6,47 +6,82 @@ import (
 
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitForRDBInstance(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (*rdb.Instance, error) {
-	retryInterval := defaultWaitRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForRDBInstance(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (_ *rdb.Instance, err error) {
+	ctx, span := tracing.Start(ctx, "waitForRDBInstance",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[rdb.InstanceStatus]struct{}{
+		rdb.InstanceStatusReady:    {},
+		rdb.InstanceStatusDiskFull: {},
+		rdb.InstanceStatusError:    {},
 	}
 
-	return api.WaitForInstance(&rdb.WaitForInstanceRequest{
-		Region:        region,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		InstanceID:    id,
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, defaultWaitRetryInterval, timeout, func(ctx context.Context) (*rdb.Instance, bool, error) {
+		res, err := api.GetInstance(&rdb.GetInstanceRequest{
+			Region:     region,
+			InstanceID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
 
-func waitForRDBDatabaseBackup(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (*rdb.DatabaseBackup, error) {
-	retryInterval := defaultWaitRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForRDBDatabaseBackup(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (_ *rdb.DatabaseBackup, err error) {
+	ctx, span := tracing.Start(ctx, "waitForRDBDatabaseBackup",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[rdb.DatabaseBackupStatus]struct{}{
+		rdb.DatabaseBackupStatusReady: {},
+		rdb.DatabaseBackupStatusError: {},
 	}
 
-	return api.WaitForDatabaseBackup(&rdb.WaitForDatabaseBackupRequest{
-		Region:           region,
-		Timeout:          scw.TimeDurationPtr(timeout),
-		DatabaseBackupID: id,
-		RetryInterval:    &retryInterval,
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, defaultWaitRetryInterval, timeout, func(ctx context.Context) (*rdb.DatabaseBackup, bool, error) {
+		res, err := api.GetDatabaseBackup(&rdb.GetDatabaseBackupRequest{
+			Region:           region,
+			DatabaseBackupID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
 
-func waitForRDBReadReplica(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (*rdb.ReadReplica, error) {
-	retryInterval := defaultWaitRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForRDBReadReplica(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (_ *rdb.ReadReplica, err error) {
+	ctx, span := tracing.Start(ctx, "waitForRDBReadReplica",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[rdb.ReadReplicaStatus]struct{}{
+		rdb.ReadReplicaStatusReady: {},
+		rdb.ReadReplicaStatusError: {},
 	}
 
-	return api.WaitForReadReplica(&rdb.WaitForReadReplicaRequest{
-		Region:        region,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		ReadReplicaID: id,
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, defaultWaitRetryInterval, timeout, func(ctx context.Context) (*rdb.ReadReplica, bool, error) {
+		res, err := api.GetReadReplica(&rdb.GetReadReplicaRequest{
+			Region:        region,
+			ReadReplicaID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
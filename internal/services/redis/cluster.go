@@ -18,6 +18,7 @@ import (
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -63,10 +64,26 @@ func ResourceCluster() *schema.Resource {
 				Description: "Name of the user created when the cluster is created",
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Sensitive:   true,
-				Required:    true,
-				Description: "Password of the user",
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				Optional:     true,
+				Description:  "Password of the user",
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				Description:   "Write-only password of the user, sent to the API but never stored in the state. Requires Terraform 1.11 or later",
+				ConflictsWith: []string{"password"},
+				RequiredWith:  []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Version of the write-only password. Changing it rotates the password to the current value of password_wo",
+				RequiredWith: []string{"password_wo"},
 			},
 			"tags": {
 				Type:     schema.TypeList,
@@ -235,7 +252,7 @@ func customizeDiffMigrateClusterSize() schema.CustomizeDiffFunc {
 func ResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	redisAPI, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	createReq := &redis.CreateClusterRequest{
@@ -245,7 +262,7 @@ func ResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 		Version:   d.Get("version").(string),
 		NodeType:  d.Get("node_type").(string),
 		UserName:  d.Get("user_name").(string),
-		Password:  d.Get("password").(string),
+		Password:  meta.GetWriteOnlyOrString(d, "password_wo", "password"),
 	}
 
 	tags, tagsExist := d.GetOk("tags")
@@ -264,7 +281,7 @@ func ResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 	if aclRulesExist {
 		rules, err := expandACLSpecs(aclRules)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		createReq.ACLRules = rules
 	}
@@ -277,21 +294,21 @@ func ResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 	if pnExists {
 		pnSpecs, err := expandPrivateNetwork(pn.(*schema.Set).List())
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		createReq.Endpoints = pnSpecs
 	}
 
 	res, err := redisAPI.CreateCluster(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.ID))
 
 	_, err = waitForCluster(ctx, redisAPI, zone, res.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceClusterRead(ctx, d, m)
@@ -300,7 +317,7 @@ func ResourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	redisAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	getReq := &redis.GetClusterRequest{
@@ -313,7 +330,7 @@ func ResourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", cluster.Name)
@@ -346,12 +363,12 @@ func ResourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 			ClusterID: cluster.ID,
 		})
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to fetch cluster certificate: %w", err))
+			return httperrors.Diagnostics(fmt.Errorf("failed to fetch cluster certificate: %w", err))
 		}
 
 		certificateContent, err := io.ReadAll(certificate.Content)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to read cluster certificate: %w", err))
+			return httperrors.Diagnostics(fmt.Errorf("failed to read cluster certificate: %w", err))
 		}
 
 		_ = d.Set("certificate", string(certificateContent))
@@ -365,7 +382,7 @@ func ResourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 func ResourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	redisAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &redis.UpdateClusterRequest{
@@ -379,10 +396,10 @@ func ResourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	if d.HasChange("user_name") {
 		req.UserName = types.ExpandStringPtr(d.Get("user_name"))
 	}
-	if d.HasChange("password") {
-		req.Password = types.ExpandStringPtr(d.Get("password"))
+	if d.HasChanges("password", "password_wo_version") {
+		req.Password = types.ExpandStringPtr(meta.GetWriteOnlyOrString(d, "password_wo", "password"))
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		req.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 	}
 	if d.HasChange("acl") {
@@ -400,12 +417,12 @@ func ResourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	_, err = waitForCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = redisAPI.UpdateCluster(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	migrateClusterRequests := []redis.MigrateClusterRequest(nil)
@@ -433,16 +450,16 @@ func ResourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	for i := range migrateClusterRequests {
 		_, err = waitForCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		_, err = redisAPI.MigrateCluster(&migrateClusterRequests[i], scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitForCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !httperrors.Is404(err) {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -455,7 +472,7 @@ func ResourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	_, err = waitForCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceClusterRead(ctx, d, m)
@@ -464,7 +481,7 @@ func ResourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 func updateACL(ctx context.Context, d *schema.ResourceData, redisAPI *redis.API, zone scw.Zone, clusterID string) diag.Diagnostics {
 	rules, err := expandACLSpecs(d.Get("acl"))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = redisAPI.SetACLRules(&redis.SetACLRulesRequest{
@@ -473,7 +490,7 @@ func updateACL(ctx context.Context, d *schema.ResourceData, redisAPI *redis.API,
 		ACLRules:  rules,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -488,7 +505,7 @@ func updateSettings(ctx context.Context, d *schema.ResourceData, redisAPI *redis
 		Settings:  settings,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -498,14 +515,14 @@ func ResourceClusterUpdateEndpoints(ctx context.Context, d *schema.ResourceData,
 	// retrieve state
 	cluster, err := waitForCluster(ctx, redisAPI, zone, clusterID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// get new desired state of endpoints
 	rawNewEndpoints := d.Get("private_network")
 	newEndpoints, err := expandPrivateNetwork(rawNewEndpoints.(*schema.Set).List())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	if len(newEndpoints) == 0 {
 		newEndpoints = append(newEndpoints, &redis.EndpointSpec{
@@ -519,12 +536,12 @@ func ResourceClusterUpdateEndpoints(ctx context.Context, d *schema.ResourceData,
 		Endpoints: newEndpoints,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForCluster(ctx, redisAPI, zone, clusterID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -533,12 +550,12 @@ func ResourceClusterUpdateEndpoints(ctx context.Context, d *schema.ResourceData,
 func ResourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	redisAPI, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = redisAPI.DeleteCluster(&redis.DeleteClusterRequest{
@@ -546,12 +563,12 @@ func ResourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interf
 		ClusterID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
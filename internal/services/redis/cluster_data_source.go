@@ -9,6 +9,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/redis/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -17,6 +18,8 @@ import (
 func DataSourceCluster() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasource.SchemaFromResourceSchema(ResourceCluster().Schema)
+	delete(dsSchema, "password_wo")
+	delete(dsSchema, "password_wo_version")
 	// Set 'Optional' schema elements
 	datasource.AddOptionalFieldsToSchema(dsSchema, "name", "zone", "project_id")
 
@@ -38,7 +41,7 @@ func DataSourceCluster() *schema.Resource {
 func DataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	clusterID, ok := d.GetOk("cluster_id")
@@ -50,7 +53,7 @@ func DataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interf
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundCluster, err := datasource.FindExact(
@@ -59,7 +62,7 @@ func DataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interf
 			clusterName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		clusterID = foundCluster.ID
@@ -69,7 +72,7 @@ func DataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interf
 	d.SetId(zonedID)
 	err = d.Set("cluster_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// Check if cluster exist as Read will return nil if resource does not exist
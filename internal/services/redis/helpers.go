@@ -46,17 +46,24 @@ func NewAPIWithZoneAndID(m interface{}, id string) (*redis.API, scw.Zone, string
 }
 
 func waitForCluster(ctx context.Context, api *redis.API, zone scw.Zone, id string, timeout time.Duration) (*redis.Cluster, error) {
-	retryInterval := defaultWaitRedisClusterRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	terminalStatus := map[redis.ClusterStatus]struct{}{
+		redis.ClusterStatusReady:     {},
+		redis.ClusterStatusLocked:    {},
+		redis.ClusterStatusError:     {},
+		redis.ClusterStatusSuspended: {},
 	}
 
-	return api.WaitForCluster(&redis.WaitForClusterRequest{
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		ClusterID:     id,
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, defaultWaitRedisClusterRetryInterval, timeout, func(ctx context.Context) (*redis.Cluster, bool, error) {
+		res, err := api.GetCluster(&redis.GetClusterRequest{
+			Zone:      zone,
+			ClusterID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
 
 func privateNetworkSetHash(v interface{}) int {
@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_redis_cluster", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_redis_cluster", &resource.Sweeper{
 		Name: "scaleway_redis_cluster",
 		F:    testSweepRedisCluster,
 	})
@@ -29,9 +29,18 @@ func testSweepRedisCluster(_ string) error {
 		}
 
 		for _, cluster := range listClusters.Clusters {
-			_, err := redisAPI.DeleteCluster(&redisSDK.DeleteClusterRequest{
-				Zone:      zone,
-				ClusterID: cluster.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_redis_cluster",
+				Locality:  zone.String(),
+				ID:        cluster.ID,
+				Name:      cluster.Name,
+				CreatedAt: cluster.CreatedAt,
+			}, func() error {
+				_, err := redisAPI.DeleteCluster(&redisSDK.DeleteClusterRequest{
+					Zone:      zone,
+					ClusterID: cluster.ID,
+				})
+				return err
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting redis cluster in sweeper: %w", err)
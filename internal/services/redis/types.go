@@ -3,10 +3,10 @@ package redis
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/redis/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -105,7 +105,7 @@ func flattenPrivateNetwork(endpoints []*redis.Endpoint) (interface{}, bool) {
 		pn := endpoint.PrivateNetwork
 		fetchRegion, err := pn.Zone.Region()
 		if err != nil {
-			return diag.FromErr(err), false
+			return httperrors.Diagnostics(err), false
 		}
 		pnRegionalID := regional.NewIDString(fetchRegion, pn.ID)
 		serviceIps := []interface{}(nil)
@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/registry/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
@@ -73,7 +74,7 @@ func DataSourceImage() *schema.Resource {
 func DataSourceRegistryImageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := NewAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var image *registry.Image
@@ -91,7 +92,7 @@ func DataSourceRegistryImageRead(ctx context.Context, d *schema.ResourceData, m
 			ProjectID:   types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		foundImage, err := datasource.FindExact(
 			res.Images,
@@ -99,7 +100,7 @@ func DataSourceRegistryImageRead(ctx context.Context, d *schema.ResourceData, m
 			imageName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		image = foundImage
@@ -109,7 +110,7 @@ func DataSourceRegistryImageRead(ctx context.Context, d *schema.ResourceData, m
 			ImageID: locality.ExpandID(imageID),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		image = res
 	}
@@ -62,7 +62,7 @@ func ResourceNamespace() *schema.Resource {
 func ResourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := NewAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ns, err := api.CreateNamespace(&registry.CreateNamespaceRequest{
@@ -73,14 +73,14 @@ func ResourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, m inte
 		IsPublic:    d.Get("is_public").(bool),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, ns.ID))
 
 	_, err = WaitForNamespace(ctx, api, region, ns.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceNamespaceRead(ctx, d, m)
@@ -89,7 +89,7 @@ func ResourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, m inte
 func ResourceNamespaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ns, err := WaitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -98,7 +98,7 @@ func ResourceNamespaceRead(ctx context.Context, d *schema.ResourceData, m interf
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", ns.Name)
@@ -115,7 +115,7 @@ func ResourceNamespaceRead(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = WaitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -124,7 +124,7 @@ func ResourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChanges("description", "is_public") {
@@ -134,7 +134,7 @@ func ResourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			Description: types.ExpandUpdatedStringPtr(d.Get("description")),
 			IsPublic:    scw.BoolPtr(d.Get("is_public").(bool)),
 		}, scw.WithContext(ctx)); err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -144,7 +144,7 @@ func ResourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, m inte
 func ResourceNamespaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = WaitForNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -153,7 +153,7 @@ func ResourceNamespaceDelete(ctx context.Context, d *schema.ResourceData, m inte
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteNamespace(&registry.DeleteNamespaceRequest{
@@ -161,12 +161,12 @@ func ResourceNamespaceDelete(ctx context.Context, d *schema.ResourceData, m inte
 		NamespaceID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForNamespaceDelete(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/registry/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
@@ -69,7 +70,7 @@ func DataSourceImageTag() *schema.Resource {
 func DataSourceImageTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := NewAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var tag *registry.Tag
@@ -82,7 +83,7 @@ func DataSourceImageTagRead(ctx context.Context, d *schema.ResourceData, m inter
 			TagID:  locality.ExpandID(tagID),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		tag = res
 	} else {
@@ -96,14 +97,14 @@ func DataSourceImageTagRead(ctx context.Context, d *schema.ResourceData, m inter
 			ImageID: locality.ExpandID(imageID),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundTag, err := datasource.FindExact(res.Tags, func(s *registry.Tag) bool {
 			return s.Name == tagName.(string)
 		}, tagName.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		tag = foundTag
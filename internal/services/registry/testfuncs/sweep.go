@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_registry_namespace", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_registry_namespace", &resource.Sweeper{
 		Name: "scaleway_registry_namespace",
 		F:    testSweepNamespace,
 	})
@@ -28,9 +28,18 @@ func testSweepNamespace(_ string) error {
 		}
 
 		for _, ns := range listNamespaces.Namespaces {
-			_, err := registryAPI.DeleteNamespace(&registrySDK.DeleteNamespaceRequest{
-				NamespaceID: ns.ID,
-				Region:      region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_registry_namespace",
+				Locality:  region.String(),
+				ID:        ns.ID,
+				Name:      ns.Name,
+				CreatedAt: ns.CreatedAt,
+			}, func() error {
+				_, err := registryAPI.DeleteNamespace(&registrySDK.DeleteNamespaceRequest{
+					NamespaceID: ns.ID,
+					Region:      region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
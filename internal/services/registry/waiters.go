@@ -2,35 +2,37 @@ package registry
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/scaleway/scaleway-sdk-go/api/registry/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func WaitForNamespace(ctx context.Context, api *registry.API, region scw.Region, id string, timeout time.Duration) (*registry.Namespace, error) {
-	retryInterval := defaultNamespaceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
+func WaitForNamespace(ctx context.Context, api *registry.API, region scw.Region, id string, timeout time.Duration) (_ *registry.Namespace, err error) {
+	ctx, span := tracing.Start(ctx, "WaitForNamespace",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
 
-	ns, err := api.WaitForNamespace(&registry.WaitForNamespaceRequest{
-		Region:        region,
-		NamespaceID:   id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	terminalStatus := map[registry.NamespaceStatus]struct{}{
+		registry.NamespaceStatusReady:   {},
+		registry.NamespaceStatusLocked:  {},
+		registry.NamespaceStatusError:   {},
+		registry.NamespaceStatusUnknown: {},
+	}
 
-	return ns, err
+	return pollNamespace(ctx, api, region, id, timeout, terminalStatus)
 }
 
-func waitForNamespaceDelete(ctx context.Context, api *registry.API, region scw.Region, id string, timeout time.Duration) (*registry.Namespace, error) {
-	retryInterval := defaultNamespaceRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
-	}
+func waitForNamespaceDelete(ctx context.Context, api *registry.API, region scw.Region, id string, timeout time.Duration) (_ *registry.Namespace, err error) {
+	ctx, span := tracing.Start(ctx, "waitForNamespaceDelete",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
 
 	terminalStatus := map[registry.NamespaceStatus]struct{}{
 		registry.NamespaceStatusReady:    {},
@@ -40,28 +42,19 @@ func waitForNamespaceDelete(ctx context.Context, api *registry.API, region scw.R
 		registry.NamespaceStatusDeleting: {},
 	}
 
-	start := time.Now()
-	for {
+	return pollNamespace(ctx, api, region, id, timeout, terminalStatus)
+}
+
+func pollNamespace(ctx context.Context, api *registry.API, region scw.Region, id string, timeout time.Duration, terminalStatus map[registry.NamespaceStatus]struct{}) (*registry.Namespace, error) {
+	return transport.Poll(ctx, defaultNamespaceRetryInterval, timeout, func(ctx context.Context) (*registry.Namespace, bool, error) {
 		ns, err := api.GetNamespace(&registry.GetNamespaceRequest{
 			Region:      region,
 			NamespaceID: id,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
-
-		if _, ok := terminalStatus[ns.Status]; ok {
-			return ns, nil
-		}
-
-		if time.Since(start) > timeout {
-			return nil, fmt.Errorf("timeout while waiting for namespace %s to be deleted", id)
-		}
-
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(retryInterval):
-		}
-	}
+		_, isTerminal := terminalStatus[ns.Status]
+		return ns, isTerminal, nil
+	})
 }
@@ -63,7 +63,7 @@ func ResourceDatabase() *schema.Resource {
 func ResourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	database, err := api.CreateDatabase(&sdbSDK.CreateDatabaseRequest{
@@ -75,14 +75,14 @@ func ResourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m inter
 		FromBackupID: nil,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, database.ID))
 
 	_, err = waitForDatabase(ctx, api, region, database.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceDatabaseRead(ctx, d, m)
@@ -91,7 +91,7 @@ func ResourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	database, err := waitForDatabase(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -100,7 +100,7 @@ func ResourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interfa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", database.Name)
@@ -116,7 +116,7 @@ func ResourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	database, err := waitForDatabase(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -125,7 +125,7 @@ func ResourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m inter
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &sdbSDK.UpdateDatabaseRequest{
@@ -141,7 +141,7 @@ func ResourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	if _, err := api.UpdateDatabase(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceDatabaseRead(ctx, d, m)
@@ -150,12 +150,12 @@ func ResourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m inter
 func ResourceDatabaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForDatabase(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.DeleteDatabase(&sdbSDK.DeleteDatabaseRequest{
@@ -163,12 +163,12 @@ func ResourceDatabaseDelete(ctx context.Context, d *schema.ResourceData, m inter
 		DatabaseID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForDatabase(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is403(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
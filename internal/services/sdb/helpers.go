@@ -42,17 +42,23 @@ func NewAPIWithRegionAndID(m interface{}, regionalID string) (*sdbSDK.API, scw.R
 }
 
 func waitForDatabase(ctx context.Context, sdbAPI *sdbSDK.API, region scw.Region, id string, timeout time.Duration) (*sdbSDK.Database, error) {
-	retryInterval := function.DefaultFunctionRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	terminalStatus := map[sdbSDK.DatabaseStatus]struct{}{
+		sdbSDK.DatabaseStatusReady:  {},
+		sdbSDK.DatabaseStatusError:  {},
+		sdbSDK.DatabaseStatusLocked: {},
 	}
 
-	database, err := sdbAPI.WaitForDatabase(&sdbSDK.WaitForDatabaseRequest{
-		Region:        region,
-		DatabaseID:    id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	database, err := transport.Poll(ctx, function.DefaultFunctionRetryInterval, timeout, func(ctx context.Context) (*sdbSDK.Database, bool, error) {
+		res, err := sdbAPI.GetDatabase(&sdbSDK.GetDatabaseRequest{
+			Region:     region,
+			DatabaseID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return database, err
 }
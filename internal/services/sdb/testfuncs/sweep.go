@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_sdb_sql_database", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_sdb_sql_database", &resource.Sweeper{
 		Name: "scaleway_sdb_sql_database",
 		F:    testSweepServerlessSQLDBDatabase,
 	})
@@ -30,9 +30,18 @@ func testSweepServerlessSQLDBDatabase(_ string) error {
 		}
 
 		for _, database := range listServerlessSQLDBDatabases.Databases {
-			_, err := sdbAPI.DeleteDatabase(&sdbSDK.DeleteDatabaseRequest{
-				DatabaseID: database.ID,
-				Region:     region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_sdb_sql_database",
+				Locality:  region.String(),
+				ID:        database.ID,
+				Name:      database.Name,
+				CreatedAt: database.CreatedAt,
+			}, func() error {
+				_, err := sdbAPI.DeleteDatabase(&sdbSDK.DeleteDatabaseRequest{
+					DatabaseID: database.ID,
+					Region:     region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -124,7 +124,7 @@ func ResourceSecret() *schema.Resource {
 func ResourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	secretCreateRequest := &secret.CreateSecretRequest{
@@ -154,13 +154,13 @@ func ResourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	if policyExists {
 		secretCreateRequest.EphemeralPolicy, err = expandEphemeralPolicy(rawEphemeralPolicy)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	secretResponse, err := api.CreateSecret(secretCreateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, secretResponse.ID))
@@ -171,7 +171,7 @@ func ResourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	secretResponse, err := api.GetSecret(&secret.GetSecretRequest{
@@ -183,7 +183,7 @@ func ResourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if len(secretResponse.Tags) > 0 {
@@ -209,7 +209,7 @@ func ResourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface
 func ResourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	updateRequest := &secret.UpdateSecretRequest{
@@ -229,7 +229,7 @@ func ResourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 		hasChanged = true
 	}
@@ -242,7 +242,7 @@ func ResourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	if d.HasChange("ephemeral_policy") {
 		updateRequest.EphemeralPolicy, err = expandEphemeralPolicy(d.Get("ephemeral_policy"))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		hasChanged = true
 	}
@@ -250,14 +250,14 @@ func ResourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	if hasChanged {
 		_, err := api.UpdateSecret(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
 	if d.HasChange("protected") {
 		err = updateSecretProtection(api, region, id, d.Get("protected").(bool))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -267,7 +267,7 @@ func ResourceSecretUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceSecretDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteSecret(&secret.DeleteSecretRequest{
@@ -275,7 +275,7 @@ func ResourceSecretDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		SecretID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -8,6 +8,7 @@ import (
 	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -46,7 +47,7 @@ func DataSourceSecret() *schema.Resource {
 func DataSourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, projectID, err := newAPIWithRegionAndProjectID(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	secretID, ok := d.GetOk("secret_id")
@@ -62,7 +63,7 @@ func DataSourceSecretRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 		res, err := api.ListSecrets(request, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundSecret, err := datasource.FindExact(
@@ -71,7 +72,7 @@ func DataSourceSecretRead(ctx context.Context, d *schema.ResourceData, m interfa
 			secretName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		secretID = foundSecret.ID
@@ -81,7 +82,7 @@ func DataSourceSecretRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.SetId(regionalID)
 	err = d.Set("secret_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := ResourceSecretRead(ctx, d, m)
@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_secret", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_secret", &resource.Sweeper{
 		Name: "scaleway_secret",
 		F:    testSweepSecret,
 	})
@@ -29,9 +29,17 @@ func testSweepSecret(_ string) error {
 		}
 
 		for _, se := range listSecrets.Secrets {
-			err := secretAPI.DeleteSecret(&secretSDK.DeleteSecretRequest{
-				SecretID: se.ID,
-				Region:   region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_secret",
+				Locality:  region.String(),
+				ID:        se.ID,
+				Name:      se.Name,
+				CreatedAt: se.CreatedAt,
+			}, func() error {
+				return secretAPI.DeleteSecret(&secretSDK.DeleteSecretRequest{
+					SecretID: se.ID,
+					Region:   region,
+				})
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -79,7 +79,7 @@ func ResourceVersion() *schema.Resource {
 func ResourceVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	secretID := locality.ExpandID(d.Get("secret_id").(string))
@@ -93,7 +93,7 @@ func ResourceVersionCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	secretResponse, err := api.CreateSecretVersion(secretCreateVersionRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("data", Base64Encoded(payloadSecretRaw))
@@ -106,7 +106,7 @@ func ResourceVersionCreate(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, revision, err := NewVersionAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	secretResponse, err := api.GetSecretVersion(&secret.GetSecretVersionRequest{
@@ -119,7 +119,7 @@ func ResourceVersionRead(ctx context.Context, d *schema.ResourceData, m interfac
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	revisionStr := strconv.Itoa(int(secretResponse.Revision))
@@ -137,7 +137,7 @@ func ResourceVersionRead(ctx context.Context, d *schema.ResourceData, m interfac
 func ResourceVersionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, revision, err := NewVersionAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	updateRequest := &secret.UpdateSecretVersionRequest{
@@ -156,7 +156,7 @@ func ResourceVersionUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	if hasChanged {
 		_, err := api.UpdateSecretVersion(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -166,7 +166,7 @@ func ResourceVersionUpdate(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceVersionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, revision, err := NewVersionAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteSecretVersion(&secret.DeleteSecretVersionRequest{
@@ -175,7 +175,7 @@ func ResourceVersionDelete(ctx context.Context, d *schema.ResourceData, m interf
 		Revision: revision,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -10,6 +10,7 @@ import (
 	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
@@ -60,7 +61,7 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 	secretID, existSecretID := d.GetOk("secret_id")
 	api, region, projectID, err := newAPIWithRegionOptionalProjectIDAndDefault(d, m, regional.ExpandID(secretID).Region)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var secretVersionIDStr string
@@ -75,7 +76,7 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 			OrganizationID: types.ExpandStringPtr(d.Get("organization_id")),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundSecret, err := datasource.FindExact(secrets.Secrets,
@@ -83,7 +84,7 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 			secretName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		res, err := api.AccessSecretVersion(&secret.AccessSecretVersionRequest{
@@ -92,7 +93,7 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 			Revision: d.Get("revision").(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		secretVersionIDStr = regional.NewIDString(region, fmt.Sprintf("%s/%d", res.SecretID, res.Revision))
@@ -107,7 +108,7 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 
 		res, err := api.AccessSecretVersion(request, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		secretVersionIDStr = regional.NewIDString(region, fmt.Sprintf("%s/%d", res.SecretID, res.Revision))
@@ -117,7 +118,7 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 	d.SetId(secretVersionIDStr)
 	err = d.Set("data", base64.StdEncoding.EncodeToString(payloadSecretRaw))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := ResourceVersionRead(ctx, d, m)
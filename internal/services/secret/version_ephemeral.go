@@ -0,0 +1,171 @@
+package secret
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/framework"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
+)
+
+const versionEphemeralResourceType = "scaleway_secret_version"
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = (*VersionEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithValidateConfig = (*VersionEphemeralResource)(nil)
+)
+
+// VersionEphemeralResource reads the payload of a secret version without storing it in the state.
+type VersionEphemeralResource struct {
+	meta *meta.Meta
+}
+
+func NewVersionEphemeralResource() ephemeral.EphemeralResource { //nolint:ireturn
+	return &VersionEphemeralResource{}
+}
+
+type versionEphemeralResourceModel struct {
+	SecretID   types.String `tfsdk:"secret_id"`
+	SecretName types.String `tfsdk:"secret_name"`
+	ProjectID  types.String `tfsdk:"project_id"`
+	Revision   types.String `tfsdk:"revision"`
+	Region     types.String `tfsdk:"region"`
+	Data       types.String `tfsdk:"data"`
+}
+
+func (r *VersionEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = versionEphemeralResourceType
+}
+
+func (r *VersionEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the payload of a secret version without storing it in the state",
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				Description: "The ID of the secret",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					framework.ValidateDiagFunc(verify.IsUUIDorUUIDWithLocality()),
+				},
+			},
+			"secret_name": schema.StringAttribute{
+				Description: "The name of the secret",
+				Optional:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project to filter the secret by name",
+				Optional:    true,
+				Validators: []validator.String{
+					framework.ValidateDiagFunc(verify.IsUUID()),
+				},
+			},
+			"revision": schema.StringAttribute{
+				Description: "The revision of the secret version. It can be a number, `latest` or `latest_enabled`",
+				Optional:    true,
+				Computed:    true,
+			},
+			"region": framework.RegionEphemeralAttribute(),
+			"data": schema.StringAttribute{
+				Description: "The payload of the secret version, encoded in base64",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *VersionEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	m, diags := framework.ExtractMeta(req.ProviderData)
+	resp.Diagnostics.Append(diags...)
+	r.meta = m
+}
+
+// ValidateConfig checks that the secret is referenced either by ID or by name.
+func (r *VersionEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config versionEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.SecretID.IsUnknown() || config.SecretName.IsUnknown() {
+		return
+	}
+
+	if config.SecretID.IsNull() == config.SecretName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("secret_id"), "Invalid secret reference", "exactly one of secret_id and secret_name must be set")
+	}
+}
+
+func (r *VersionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, span := framework.StartOperation(ctx, r.meta, versionEphemeralResourceType, "open")
+	defer framework.EndOperation(span, &resp.Diagnostics)
+
+	var config versionEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region, secretID, err := framework.ExtractRegionalID(config.SecretID, config.Region, r.meta)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+		return
+	}
+
+	api := secret.NewAPI(r.meta.ScwClient())
+
+	if secretID == "" {
+		secretName := config.SecretName.ValueString()
+		secrets, err := api.ListSecrets(&secret.ListSecretsRequest{
+			Region:    region,
+			Name:      &secretName,
+			ProjectID: config.ProjectID.ValueStringPointer(),
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			framework.AddError(&resp.Diagnostics, "Cannot list the secrets", err)
+			return
+		}
+
+		foundSecret, err := datasource.FindExact(secrets.Secrets,
+			func(s *secret.Secret) bool { return s.Name == secretName },
+			secretName,
+		)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("secret_name"), "Cannot find the secret", err.Error())
+			return
+		}
+		secretID = foundSecret.ID
+	}
+
+	revision := config.Revision.ValueString()
+	if revision == "" {
+		revision = "latest"
+	}
+
+	res, err := api.AccessSecretVersion(&secret.AccessSecretVersionRequest{
+		Region:   region,
+		SecretID: secretID,
+		Revision: revision,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		framework.AddError(&resp.Diagnostics, fmt.Sprintf("Cannot access revision %s of secret %s", revision, secretID), err)
+		return
+	}
+
+	config.SecretID = types.StringValue(regional.NewIDString(region, res.SecretID))
+	config.Revision = types.StringValue(strconv.FormatUint(uint64(res.Revision), 10))
+	config.Region = types.StringValue(region.String())
+	config.Data = types.StringValue(base64.StdEncoding.EncodeToString(res.Data))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, config)...)
+}
@@ -0,0 +1,72 @@
+// Package services_test runs the sweepers of all services together, so that the dependencies between
+// the sweepers of different services are honored, e.g. servers are deleted before their private networks.
+package services_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	accounttestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account/testfuncs"
+	applesilicontestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/applesilicon/testfuncs"
+	baremetaltestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/baremetal/testfuncs"
+	blocktestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/block/testfuncs"
+	cockpittestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/cockpit/testfuncs"
+	containertestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/container/testfuncs"
+	flexibleiptestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/flexibleip/testfuncs"
+	functiontestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/function/testfuncs"
+	iamtestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iam/testfuncs"
+	inferencetestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/inference/testfuncs"
+	instancetestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+	iottestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/iot/testfuncs"
+	ipamtestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/ipam/testfuncs"
+	jobstestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/jobs/testfuncs"
+	k8stestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/k8s/testfuncs"
+	lbtestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/lb/testfuncs"
+	mnqtestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/mnq/testfuncs"
+	mongodbtestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/mongodb/testfuncs"
+	objecttestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/object/testfuncs"
+	rdbtestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/rdb/testfuncs"
+	redistestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/redis/testfuncs"
+	registrytestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/registry/testfuncs"
+	sdbtestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/sdb/testfuncs"
+	secrettestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/secret/testfuncs"
+	temtestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/tem/testfuncs"
+	vpctestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpc/testfuncs"
+	vpcgwtestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/vpcgw/testfuncs"
+	webhostingtestfuncs "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/webhosting/testfuncs"
+)
+
+func init() {
+	accounttestfuncs.AddTestSweepers()
+	applesilicontestfuncs.AddTestSweepers()
+	baremetaltestfuncs.AddTestSweepers()
+	blocktestfuncs.AddTestSweepers()
+	cockpittestfuncs.AddTestSweepers()
+	containertestfuncs.AddTestSweepers()
+	flexibleiptestfuncs.AddTestSweepers()
+	functiontestfuncs.AddTestSweepers()
+	iamtestfuncs.AddTestSweepers()
+	inferencetestfuncs.AddTestSweepers()
+	instancetestfuncs.AddTestSweepers()
+	iottestfuncs.AddTestSweepers()
+	ipamtestfuncs.AddTestSweepers()
+	jobstestfuncs.AddTestSweepers()
+	k8stestfuncs.AddTestSweepers()
+	lbtestfuncs.AddTestSweepers()
+	mnqtestfuncs.AddTestSweepers()
+	mongodbtestfuncs.AddTestSweepers()
+	objecttestfuncs.AddTestSweepers()
+	rdbtestfuncs.AddTestSweepers()
+	redistestfuncs.AddTestSweepers()
+	registrytestfuncs.AddTestSweepers()
+	sdbtestfuncs.AddTestSweepers()
+	secrettestfuncs.AddTestSweepers()
+	temtestfuncs.AddTestSweepers()
+	vpctestfuncs.AddTestSweepers()
+	vpcgwtestfuncs.AddTestSweepers()
+	webhostingtestfuncs.AddTestSweepers()
+}
+
+func TestMain(m *testing.M) {
+	acctest.TestMain(m)
+}
@@ -191,7 +191,7 @@ func ResourceDomain() *schema.Resource {
 func ResourceDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := temAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	domain, err := api.CreateDomain(&tem.CreateDomainRequest{
@@ -202,7 +202,7 @@ func ResourceDomainCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		Autoconfig: d.Get("autoconfig").(bool),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, domain.ID))
@@ -213,7 +213,7 @@ func ResourceDomainCreate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	domain, err := api.GetDomain(&tem.GetDomainRequest{
@@ -225,7 +225,7 @@ func ResourceDomainRead(ctx context.Context, d *schema.ResourceData, m interface
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", domain.Name)
@@ -258,7 +258,7 @@ func ResourceDomainRead(ctx context.Context, d *schema.ResourceData, m interface
 func ResourceDomainUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChange("autoconfig") {
@@ -270,7 +270,7 @@ func ResourceDomainUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			Autoconfig: &autoconfig,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -280,7 +280,7 @@ func ResourceDomainUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 func ResourceDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = WaitForDomain(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -290,7 +290,7 @@ func ResourceDomainDelete(ctx context.Context, d *schema.ResourceData, m interfa
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = api.RevokeDomain(&tem.RevokeDomainRequest{
@@ -298,12 +298,12 @@ func ResourceDomainDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		DomainID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = WaitForDomain(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	tem "github.com/scaleway/scaleway-sdk-go/api/tem/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
 )
@@ -39,7 +40,7 @@ func DataSourceDomain() *schema.Resource {
 func DataSourceDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := temAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	domainID, ok := d.GetOk("domain_id")
@@ -50,7 +51,7 @@ func DataSourceDomainRead(ctx context.Context, d *schema.ResourceData, m interfa
 			ProjectID: types.ExpandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		for _, domain := range res.Domains {
@@ -76,7 +77,7 @@ func DataSourceDomainRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.SetId(regionalID)
 	err = d.Set("domain_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := ResourceDomainRead(ctx, d, m)
@@ -56,7 +56,7 @@ func ResourceDomainValidation() *schema.Resource {
 func ResourceDomainValidationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := temAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	d.SetId(d.Get("domain_id").(string))
 	domain, err := api.GetDomain(&tem.GetDomainRequest{
@@ -68,7 +68,7 @@ func ResourceDomainValidationCreate(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	duration := d.Get("timeout").(int)
 	timeout := time.Duration(duration) * time.Second
@@ -89,7 +89,7 @@ func ResourceDomainValidationCreate(ctx context.Context, d *schema.ResourceData,
 func ResourceDomainValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := temAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	domainID := d.Id()
@@ -103,7 +103,7 @@ func ResourceDomainValidationRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("validated", domain.Status == "checked")
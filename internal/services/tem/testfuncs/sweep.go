@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_tem_domain", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_tem_domain", &resource.Sweeper{
 		Name: "scaleway_tem_domain",
 		F:    testSweepDomain,
 	})
@@ -32,9 +32,19 @@ func testSweepDomain(_ string) error {
 				logging.L.Debugf("sweeper: skipping deletion of domain %s", ns.Name)
 				continue
 			}
-			_, err := temAPI.RevokeDomain(&temSDK.RevokeDomainRequest{
-				DomainID: ns.ID,
-				Region:   region,
+			// Domains are named after real domains, they are only filtered by age
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:         "scaleway_tem_domain",
+				Locality:     region.String(),
+				ID:           ns.ID,
+				SweepUnnamed: true,
+				CreatedAt:    ns.CreatedAt,
+			}, func() error {
+				_, err := temAPI.RevokeDomain(&temSDK.RevokeDomainRequest{
+					DomainID: ns.ID,
+					Region:   region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
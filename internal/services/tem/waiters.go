@@ -6,21 +6,37 @@ import (
 
 	tem "github.com/scaleway/scaleway-sdk-go/api/tem/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func WaitForDomain(ctx context.Context, api *tem.API, region scw.Region, id string, timeout time.Duration) (*tem.Domain, error) {
-	retryInterval := defaultDomainRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func WaitForDomain(ctx context.Context, api *tem.API, region scw.Region, id string, timeout time.Duration) (_ *tem.Domain, err error) {
+	ctx, span := tracing.Start(ctx, "WaitForDomain",
+		tracing.AttributeRegion.String(region.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[tem.DomainStatus]struct{}{
+		tem.DomainStatusChecked:   {},
+		tem.DomainStatusUnchecked: {},
+		tem.DomainStatusInvalid:   {},
+		tem.DomainStatusLocked:    {},
+		tem.DomainStatusRevoked:   {},
+		tem.DomainStatusUnknown:   {},
 	}
 
-	domain, err := api.WaitForDomain(&tem.WaitForDomainRequest{
-		Region:        region,
-		DomainID:      id,
-		RetryInterval: &retryInterval,
-		Timeout:       scw.TimeDurationPtr(timeout),
-	}, scw.WithContext(ctx))
+	domain, err := transport.Poll(ctx, defaultDomainRetryInterval, timeout, func(ctx context.Context) (*tem.Domain, bool, error) {
+		res, err := api.GetDomain(&tem.GetDomainRequest{
+			Region:   region,
+			DomainID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return domain, err
 }
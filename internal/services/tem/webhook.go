@@ -73,7 +73,7 @@ func ResourceWebhook() *schema.Resource {
 func ResourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := temAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	eventTypes := expandWebhookEventTypes(d.Get("event_types").([]interface{}))
@@ -87,7 +87,7 @@ func ResourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interf
 		EventTypes: eventTypes,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, webhook.ID))
@@ -98,7 +98,7 @@ func ResourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	webhook, err := api.GetWebhook(&tem.GetWebhookRequest{
@@ -110,7 +110,7 @@ func ResourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interfac
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", webhook.Name)
@@ -128,7 +128,7 @@ func ResourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interfac
 func ResourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &tem.UpdateWebhookRequest{
@@ -155,7 +155,7 @@ func ResourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	_, err = api.UpdateWebhook(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceWebhookRead(ctx, d, m)
@@ -164,7 +164,7 @@ func ResourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 func ResourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteWebhook(&tem.DeleteWebhookRequest{
@@ -173,7 +173,7 @@ func ResourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interf
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
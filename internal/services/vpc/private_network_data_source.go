@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -45,7 +46,7 @@ func DataSourcePrivateNetwork() *schema.Resource {
 func DataSourceVPCPrivateNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	vpcAPI, region, err := vpcAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	privateNetworkID, ok := d.GetOk("private_network_id")
@@ -59,7 +60,7 @@ func DataSourceVPCPrivateNetworkRead(ctx context.Context, d *schema.ResourceData
 				VpcID:     types.ExpandStringPtr(locality.ExpandID(d.Get("vpc_id"))),
 			}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundPN, err := datasource.FindExact(
@@ -68,7 +69,7 @@ func DataSourceVPCPrivateNetworkRead(ctx context.Context, d *schema.ResourceData
 			pnName,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		privateNetworkID = foundPN.ID
@@ -81,17 +81,17 @@ func ResourceRoute() *schema.Resource {
 func ResourceRouteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	vpcAPI, region, err := vpcAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	destination, err := types.ExpandIPNet(d.Get("destination").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	resourceID, err := vpcRouteExpandResourceID(d.Get("nexthop_resource_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &vpc.CreateRouteRequest{
@@ -106,7 +106,7 @@ func ResourceRouteCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	res, err := vpcAPI.CreateRoute(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, res.ID))
@@ -117,7 +117,7 @@ func ResourceRouteCreate(ctx context.Context, d *schema.ResourceData, m interfac
 func ResourceRouteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := vpcAPI.GetRoute(&vpc.GetRouteRequest{
@@ -129,7 +129,7 @@ func ResourceRouteRead(ctx context.Context, d *schema.ResourceData, m interface{
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("description", res.Description)
@@ -142,7 +142,7 @@ func ResourceRouteRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 	destination, err := types.FlattenIPNet(res.Destination)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_ = d.Set("destination", destination)
 
@@ -156,7 +156,7 @@ func ResourceRouteRead(ctx context.Context, d *schema.ResourceData, m interface{
 func ResourceRouteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	hasChanged := false
@@ -174,7 +174,7 @@ func ResourceRouteUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 	if d.HasChange("destination") {
 		destination, err := types.ExpandIPNet(d.Get("destination").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		updateRequest.Destination = &destination
@@ -184,7 +184,7 @@ func ResourceRouteUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 	if d.HasChange("nexthop_resource_id") {
 		resourceID, err := vpcRouteExpandResourceID(d.Get("nexthop_resource_id").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		updateRequest.NexthopResourceID = types.ExpandUpdatedStringPtr(resourceID)
@@ -196,7 +196,7 @@ func ResourceRouteUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 		hasChanged = true
 	}
@@ -204,7 +204,7 @@ func ResourceRouteUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 	if hasChanged {
 		_, err = vpcAPI.UpdateRoute(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -214,7 +214,7 @@ func ResourceRouteUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 func ResourceRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	// issue with route deletion for now, permissions_denied
@@ -223,7 +223,7 @@ func ResourceRouteDelete(ctx context.Context, d *schema.ResourceData, m interfac
 		RouteID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
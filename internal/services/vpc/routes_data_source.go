@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -126,7 +127,7 @@ func DataSourceRoutes() *schema.Resource {
 func DataSourceRoutesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	routesAPI, region, err := routesAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &vpc.RoutesWithNexthopAPIListRoutesWithNexthopRequest{
@@ -145,7 +146,7 @@ func DataSourceRoutesRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	res, err := routesAPI.ListRoutesWithNexthop(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	routes := []interface{}(nil)
@@ -162,7 +163,7 @@ func DataSourceRoutesRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 			destination, err := types.FlattenIPNet(route.Route.Destination)
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			rawRoute["destination"] = destination
 
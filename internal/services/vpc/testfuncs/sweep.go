@@ -11,19 +11,19 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_vpc", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_vpc", &resource.Sweeper{
 		Name:         "scaleway_vpc",
 		F:            testSweepVPC,
-		Dependencies: []string{"scaleway_vpc_private_network"},
+		Dependencies: []string{"scaleway_vpc_private_network", "scaleway_vpc_route"},
 	})
 
-	resource.AddTestSweepers("scaleway_vpc_private_network", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_vpc_private_network", &resource.Sweeper{
 		Name:         "scaleway_vpc_private_network",
 		F:            testSweepVPCPrivateNetwork,
 		Dependencies: []string{"scaleway_ipam_ip"},
 	})
 
-	resource.AddTestSweepers("scaleway_vpc_route", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_vpc_route", &resource.Sweeper{
 		Name: "scaleway_vpc_route",
 		F:    testSweepVPCRoute,
 	})
@@ -44,9 +44,17 @@ func testSweepVPC(_ string) error {
 			if v.IsDefault {
 				continue
 			}
-			err := vpcAPI.DeleteVPC(&vpcSDK.DeleteVPCRequest{
-				VpcID:  v.ID,
-				Region: region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_vpc",
+				Locality:  region.String(),
+				ID:        v.ID,
+				Name:      v.Name,
+				CreatedAt: v.CreatedAt,
+			}, func() error {
+				return vpcAPI.DeleteVPC(&vpcSDK.DeleteVPCRequest{
+					VpcID:  v.ID,
+					Region: region,
+				})
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -73,9 +81,17 @@ func testSweepVPCPrivateNetwork(_ string) error {
 		}
 
 		for _, pn := range listPNResponse.PrivateNetworks {
-			err := vpcAPI.DeletePrivateNetwork(&vpcSDK.DeletePrivateNetworkRequest{
-				Region:           region,
-				PrivateNetworkID: pn.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_vpc_private_network",
+				Locality:  region.String(),
+				ID:        pn.ID,
+				Name:      pn.Name,
+				CreatedAt: pn.CreatedAt,
+			}, func() error {
+				return vpcAPI.DeletePrivateNetwork(&vpcSDK.DeletePrivateNetworkRequest{
+					Region:           region,
+					PrivateNetworkID: pn.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting private network in sweeper: %s", err)
@@ -107,9 +123,17 @@ func testSweepVPCRoute(_ string) error {
 
 		for _, routeWithNexthop := range listRoutesResponse.Routes {
 			if routeWithNexthop.Route != nil {
-				err := vpcAPI.DeleteRoute(&vpcSDK.DeleteRouteRequest{
-					Region:  region,
-					RouteID: routeWithNexthop.Route.ID,
+				err := acctest.SweepResource(acctest.SweptResource{
+					Type:         "scaleway_vpc_route",
+					Locality:     region.String(),
+					ID:           routeWithNexthop.Route.ID,
+					SweepUnnamed: true,
+					CreatedAt:    routeWithNexthop.Route.CreatedAt,
+				}, func() error {
+					return vpcAPI.DeleteRoute(&vpcSDK.DeleteRouteRequest{
+						Region:  region,
+						RouteID: routeWithNexthop.Route.ID,
+					})
 				})
 				if err != nil {
 					return fmt.Errorf("error deleting route in sweeper: %s", err)
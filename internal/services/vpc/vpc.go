@@ -79,7 +79,7 @@ func ResourceVPC() *schema.Resource {
 func ResourceVPCCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	vpcAPI, region, err := vpcAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := vpcAPI.CreateVPC(&vpc.CreateVPCRequest{
@@ -90,7 +90,7 @@ func ResourceVPCCreate(ctx context.Context, d *schema.ResourceData, m interface{
 		Region:        region,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, res.ID))
@@ -101,7 +101,7 @@ func ResourceVPCCreate(ctx context.Context, d *schema.ResourceData, m interface{
 func ResourceVPCRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := vpcAPI.GetVPC(&vpc.GetVPCRequest{
@@ -113,7 +113,7 @@ func ResourceVPCRead(ctx context.Context, d *schema.ResourceData, m interface{})
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("name", res.Name)
@@ -135,7 +135,7 @@ func ResourceVPCRead(ctx context.Context, d *schema.ResourceData, m interface{})
 func ResourceVPCUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	hasChanged := false
@@ -150,7 +150,7 @@ func ResourceVPCUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 		hasChanged = true
 	}
@@ -158,7 +158,7 @@ func ResourceVPCUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 	if hasChanged {
 		_, err = vpcAPI.UpdateVPC(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -170,7 +170,7 @@ func ResourceVPCUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 				VpcID:  ID,
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 		}
 	}
@@ -181,7 +181,7 @@ func ResourceVPCUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 func ResourceVPCDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = vpcAPI.DeleteVPC(&vpc.DeleteVPCRequest{
@@ -189,7 +189,7 @@ func ResourceVPCDelete(ctx context.Context, d *schema.ResourceData, m interface{
 		VpcID:  ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
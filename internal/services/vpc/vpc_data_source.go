@@ -8,6 +8,7 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
@@ -44,7 +45,7 @@ func DataSourceVPC() *schema.Resource {
 func DataSourceVPCRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	vpcAPI, region, err := vpcAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var vpcID interface{}
@@ -59,7 +60,7 @@ func DataSourceVPCRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 		res, err := vpcAPI.ListVPCs(request, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		vpcID = regional.NewIDString(region, res.Vpcs[0].ID)
@@ -76,7 +77,7 @@ func DataSourceVPCRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 			res, err := vpcAPI.ListVPCs(request, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			foundVPC, err := datasource.FindExact(
@@ -85,7 +86,7 @@ func DataSourceVPCRead(ctx context.Context, d *schema.ResourceData, m interface{
 				vpcName,
 			)
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 
 			vpcID = foundVPC.ID
@@ -96,7 +97,7 @@ func DataSourceVPCRead(ctx context.Context, d *schema.ResourceData, m interface{
 	d.SetId(regionalID)
 	err = d.Set("vpc_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := ResourceVPCRead(ctx, d, m)
@@ -133,12 +133,12 @@ func ResourceDHCP() *schema.Resource {
 func ResourceVPCPublicGatewayDHCPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	subnet, err := types.ExpandIPNet(d.Get("subnet").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	req := &vpcgw.CreateDHCPRequest{
 		Zone:      zone,
@@ -188,7 +188,7 @@ func ResourceVPCPublicGatewayDHCPCreate(ctx context.Context, d *schema.ResourceD
 
 	res, err := api.CreateDHCP(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.ID))
@@ -199,7 +199,7 @@ func ResourceVPCPublicGatewayDHCPCreate(ctx context.Context, d *schema.ResourceD
 func ResourceVPCPublicGatewayDHCPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	dhcp, err := api.GetDHCP(&vpcgw.GetDHCPRequest{
@@ -211,7 +211,7 @@ func ResourceVPCPublicGatewayDHCPRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("address", dhcp.Address.String())
@@ -239,7 +239,7 @@ func ResourceVPCPublicGatewayDHCPRead(ctx context.Context, d *schema.ResourceDat
 func ResourceVPCPublicGatewayDHCPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &vpcgw.UpdateDHCPRequest{
@@ -250,7 +250,7 @@ func ResourceVPCPublicGatewayDHCPUpdate(ctx context.Context, d *schema.ResourceD
 	if subnetRaw, ok := d.GetOk("subnet"); ok {
 		subnet, err := types.ExpandIPNet(subnetRaw.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		req.Subnet = &subnet
 	}
@@ -309,7 +309,7 @@ func ResourceVPCPublicGatewayDHCPUpdate(ctx context.Context, d *schema.ResourceD
 
 	_, err = api.UpdateDHCP(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceVPCPublicGatewayDHCPRead(ctx, d, m)
@@ -318,7 +318,7 @@ func ResourceVPCPublicGatewayDHCPUpdate(ctx context.Context, d *schema.ResourceD
 func ResourceVPCPublicGatewayDHCPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteDHCP(&vpcgw.DeleteDHCPRequest{
@@ -327,7 +327,7 @@ func ResourceVPCPublicGatewayDHCPDelete(ctx context.Context, d *schema.ResourceD
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
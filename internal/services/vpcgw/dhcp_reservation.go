@@ -81,7 +81,7 @@ func ResourceDHCPReservation() *schema.Resource {
 func ResourceVPCPublicGatewayDHCPCReservationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ip := net.ParseIP(d.Get("ip_address").(string))
@@ -91,13 +91,13 @@ func ResourceVPCPublicGatewayDHCPCReservationCreate(ctx context.Context, d *sche
 
 	macAddress, err := net.ParseMAC(d.Get("mac_address").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	gatewayNetworkID := locality.ExpandID(d.Get("gateway_network_id"))
 	_, err = waitForVPCGatewayNetwork(ctx, api, zone, gatewayNetworkID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := api.CreateDHCPEntry(&vpcgw.CreateDHCPEntryRequest{
@@ -107,14 +107,14 @@ func ResourceVPCPublicGatewayDHCPCReservationCreate(ctx context.Context, d *sche
 		GatewayNetworkID: gatewayNetworkID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.ID))
 
 	_, err = waitForVPCGatewayNetwork(ctx, api, zone, gatewayNetworkID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceVPCPublicGatewayDHCPReservationRead(ctx, d, m)
@@ -123,7 +123,7 @@ func ResourceVPCPublicGatewayDHCPCReservationCreate(ctx context.Context, d *sche
 func ResourceVPCPublicGatewayDHCPReservationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	entry, err := api.GetDHCPEntry(&vpcgw.GetDHCPEntryRequest{
@@ -135,7 +135,7 @@ func ResourceVPCPublicGatewayDHCPReservationRead(ctx context.Context, d *schema.
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("ip_address", entry.IPAddress.String())
@@ -153,7 +153,7 @@ func ResourceVPCPublicGatewayDHCPReservationRead(ctx context.Context, d *schema.
 func ResourceVPCPublicGatewayDHCPReservationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if d.HasChanges("ip_address") {
@@ -165,7 +165,7 @@ func ResourceVPCPublicGatewayDHCPReservationUpdate(ctx context.Context, d *schem
 		gatewayNetworkID := locality.ExpandID(d.Get("gateway_network_id"))
 		_, err = waitForVPCGatewayNetwork(ctx, api, zone, gatewayNetworkID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		req := &vpcgw.UpdateDHCPEntryRequest{
@@ -176,12 +176,12 @@ func ResourceVPCPublicGatewayDHCPReservationUpdate(ctx context.Context, d *schem
 
 		_, err = api.UpdateDHCPEntry(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		_, err = waitForVPCGatewayNetwork(ctx, api, zone, gatewayNetworkID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -191,13 +191,13 @@ func ResourceVPCPublicGatewayDHCPReservationUpdate(ctx context.Context, d *schem
 func ResourceVPCPublicGatewayDHCPReservationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	gatewayNetworkID := locality.ExpandID(d.Get("gateway_network_id"))
 	_, err = waitForVPCGatewayNetwork(ctx, api, zone, gatewayNetworkID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	err = api.DeleteDHCPEntry(&vpcgw.DeleteDHCPEntryRequest{
@@ -206,12 +206,12 @@ func ResourceVPCPublicGatewayDHCPReservationDelete(ctx context.Context, d *schem
 	}, scw.WithContext(ctx))
 
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForVPCGatewayNetwork(ctx, api, zone, gatewayNetworkID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -65,7 +65,7 @@ func ResourceIP() *schema.Resource {
 func ResourceIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &vpcgw.CreateIPRequest{
@@ -76,7 +76,7 @@ func ResourceIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	res, err := api.CreateIP(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, res.ID))
@@ -91,7 +91,7 @@ func ResourceIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 		}
 		_, err = api.UpdateIP(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -101,7 +101,7 @@ func ResourceIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}
 func ResourceIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	ip, err := api.GetIP(&vpcgw.GetIPRequest{
@@ -113,7 +113,7 @@ func ResourceIPRead(ctx context.Context, d *schema.ResourceData, m interface{})
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("organization_id", ip.OrganizationID)
@@ -131,7 +131,7 @@ func ResourceIPRead(ctx context.Context, d *schema.ResourceData, m interface{})
 func ResourceVPCPublicGatewayIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	updateRequest := &vpcgw.UpdateIPRequest{
@@ -141,7 +141,7 @@ func ResourceVPCPublicGatewayIPUpdate(ctx context.Context, d *schema.ResourceDat
 
 	hasChanged := false
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 		hasChanged = true
 	}
@@ -154,7 +154,7 @@ func ResourceVPCPublicGatewayIPUpdate(ctx context.Context, d *schema.ResourceDat
 	if hasChanged {
 		_, err = api.UpdateIP(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -164,7 +164,7 @@ func ResourceVPCPublicGatewayIPUpdate(ctx context.Context, d *schema.ResourceDat
 func ResourceVPCPublicGatewayIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	var warnings diag.Diagnostics
@@ -179,7 +179,7 @@ func ResourceVPCPublicGatewayIPDelete(ctx context.Context, d *schema.ResourceDat
 				Summary:  err.Error(),
 			})
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -140,14 +140,14 @@ func ResourceNetwork() *schema.Resource {
 func ResourceVPCGatewayNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, err := newAPIWithZone(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	gatewayID := zonal.ExpandID(d.Get("gateway_id").(string)).ID
 
 	gateway, err := waitForVPCPublicGateway(ctx, api, zone, gatewayID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &vpcgw.CreateGatewayNetworkRequest{
@@ -162,7 +162,7 @@ func ResourceVPCGatewayNetworkCreate(ctx context.Context, d *schema.ResourceData
 	if staticAddressExist {
 		address, err := types.ExpandIPNet(staticAddress.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		req.Address = &address
 	}
@@ -180,19 +180,19 @@ func ResourceVPCGatewayNetworkCreate(ctx context.Context, d *schema.ResourceData
 		return waitForVPCPublicGateway(ctx, api, zone, gatewayID, d.Timeout(schema.TimeoutCreate))
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(zonal.NewIDString(zone, gatewayNetwork.ID))
 
 	_, err = waitForVPCPublicGateway(ctx, api, zone, gatewayNetwork.GatewayID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForVPCGatewayNetwork(ctx, api, zone, gatewayNetwork.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceVPCGatewayNetworkRead(ctx, d, m)
@@ -201,7 +201,7 @@ func ResourceVPCGatewayNetworkCreate(ctx context.Context, d *schema.ResourceData
 func ResourceVPCGatewayNetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	gatewayNetwork, err := waitForVPCGatewayNetwork(ctx, api, zone, ID, d.Timeout(schema.TimeoutRead))
@@ -210,11 +210,11 @@ func ResourceVPCGatewayNetworkRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 	_, err = waitForVPCPublicGateway(ctx, api, zone, gatewayNetwork.GatewayID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	if dhcp := gatewayNetwork.DHCP; dhcp != nil {
@@ -224,7 +224,7 @@ func ResourceVPCGatewayNetworkRead(ctx context.Context, d *schema.ResourceData,
 	if staticAddress := gatewayNetwork.Address; staticAddress != nil {
 		staticAddressValue, err := types.FlattenIPNet(*staticAddress)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		_ = d.Set("static_address", staticAddressValue)
 	}
@@ -249,12 +249,12 @@ func ResourceVPCGatewayNetworkRead(ctx context.Context, d *schema.ResourceData,
 
 	gatewayNetwork, err = waitForVPCGatewayNetwork(ctx, api, zone, gatewayNetwork.ID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	fetchRegion, err := zone.Region()
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("gateway_id", zonal.NewID(zone, gatewayNetwork.GatewayID).String())
@@ -272,12 +272,12 @@ func ResourceVPCGatewayNetworkRead(ctx context.Context, d *schema.ResourceData,
 func ResourceVPCGatewayNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, ID, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForVPCGatewayNetwork(ctx, api, zone, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	updateRequest := &vpcgw.UpdateGatewayNetworkRequest{
@@ -303,7 +303,7 @@ func ResourceVPCGatewayNetworkUpdate(ctx context.Context, d *schema.ResourceData
 		if staticAddressExist {
 			address, err := types.ExpandIPNet(staticAddress.(string))
 			if err != nil {
-				return diag.FromErr(err)
+				return httperrors.Diagnostics(err)
 			}
 			updateRequest.Address = &address
 		}
@@ -311,12 +311,12 @@ func ResourceVPCGatewayNetworkUpdate(ctx context.Context, d *schema.ResourceData
 
 	_, err = api.UpdateGatewayNetwork(updateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForVPCGatewayNetwork(ctx, api, zone, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return ResourceVPCGatewayNetworkRead(ctx, d, m)
@@ -325,12 +325,12 @@ func ResourceVPCGatewayNetworkUpdate(ctx context.Context, d *schema.ResourceData
 func ResourceVPCGatewayNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, zone, id, err := NewAPIWithZoneAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	gwNetwork, err := waitForVPCGatewayNetwork(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	req := &vpcgw.DeleteGatewayNetworkRequest{
@@ -340,17 +340,17 @@ func ResourceVPCGatewayNetworkDelete(ctx context.Context, d *schema.ResourceData
 	}
 	err = api.DeleteGatewayNetwork(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForVPCGatewayNetwork(ctx, api, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForVPCPublicGateway(ctx, api, zone, gwNetwork.GatewayID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return nil
@@ -11,21 +11,24 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_vpc_public_gateway_dhcp", &resource.Sweeper{
-		Name: "scaleway_vpc_public_gateway_dhcp",
-		F:    testSweepVPCPublicGatewayDHCP,
+	acctest.AddTestSweepers("scaleway_vpc_public_gateway_dhcp", &resource.Sweeper{
+		Name:         "scaleway_vpc_public_gateway_dhcp",
+		Dependencies: []string{"scaleway_gateway_network"},
+		F:            testSweepVPCPublicGatewayDHCP,
 	})
-	resource.AddTestSweepers("scaleway_vpc_public_gateway_ip", &resource.Sweeper{
-		Name: "scaleway_vpc_public_gateway_ip",
-		F:    testSweepVPCPublicGatewayIP,
+	acctest.AddTestSweepers("scaleway_vpc_public_gateway_ip", &resource.Sweeper{
+		Name:         "scaleway_vpc_public_gateway_ip",
+		Dependencies: []string{"scaleway_vpc_public_gateway"},
+		F:            testSweepVPCPublicGatewayIP,
 	})
-	resource.AddTestSweepers("scaleway_gateway_network", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_gateway_network", &resource.Sweeper{
 		Name: "scaleway_gateway_network",
 		F:    testSweepVPCGatewayNetwork,
 	})
-	resource.AddTestSweepers("scaleway_vpc_public_gateway", &resource.Sweeper{
-		Name: "scaleway_vpc_public_gateway",
-		F:    testSweepVPCPublicGateway,
+	acctest.AddTestSweepers("scaleway_vpc_public_gateway", &resource.Sweeper{
+		Name:         "scaleway_vpc_public_gateway",
+		Dependencies: []string{"scaleway_gateway_network"},
+		F:            testSweepVPCPublicGateway,
 	})
 }
 
@@ -42,9 +45,17 @@ func testSweepVPCPublicGateway(_ string) error {
 		}
 
 		for _, gateway := range listGatewayResponse.Gateways {
-			err := api.DeleteGateway(&vpcgwSDK.DeleteGatewayRequest{
-				Zone:      zone,
-				GatewayID: gateway.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:      "scaleway_vpc_public_gateway",
+				Locality:  zone.String(),
+				ID:        gateway.ID,
+				Name:      gateway.Name,
+				CreatedAt: gateway.CreatedAt,
+			}, func() error {
+				return api.DeleteGateway(&vpcgwSDK.DeleteGatewayRequest{
+					Zone:      zone,
+					GatewayID: gateway.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting public gateway in sweeper: %w", err)
@@ -67,11 +78,19 @@ func testSweepVPCGatewayNetwork(_ string) error {
 		}
 
 		for _, gn := range listPNResponse.GatewayNetworks {
-			err := api.DeleteGatewayNetwork(&vpcgwSDK.DeleteGatewayNetworkRequest{
-				GatewayNetworkID: gn.GatewayID,
-				Zone:             zone,
-				// Cleanup the dhcp resource related. DON'T CALL THE SWEEPER DHCP
-				CleanupDHCP: true,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:         "scaleway_vpc_gateway_network",
+				Locality:     zone.String(),
+				ID:           gn.ID,
+				SweepUnnamed: true,
+				CreatedAt:    gn.CreatedAt,
+			}, func() error {
+				return api.DeleteGatewayNetwork(&vpcgwSDK.DeleteGatewayNetworkRequest{
+					GatewayNetworkID: gn.GatewayID,
+					Zone:             zone,
+					// Cleanup the dhcp resource related. DON'T CALL THE SWEEPER DHCP
+					CleanupDHCP: true,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting gateway network in sweeper: %s", err)
@@ -94,9 +113,17 @@ func testSweepVPCPublicGatewayIP(_ string) error {
 		}
 
 		for _, ip := range listIPResponse.IPs {
-			err := api.DeleteIP(&vpcgwSDK.DeleteIPRequest{
-				Zone: zone,
-				IPID: ip.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:         "scaleway_vpc_public_gateway_ip",
+				Locality:     zone.String(),
+				ID:           ip.ID,
+				SweepUnnamed: true,
+				CreatedAt:    ip.CreatedAt,
+			}, func() error {
+				return api.DeleteIP(&vpcgwSDK.DeleteIPRequest{
+					Zone: zone,
+					IPID: ip.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting public gateway ip in sweeper: %s", err)
@@ -119,9 +146,17 @@ func testSweepVPCPublicGatewayDHCP(_ string) error {
 		}
 
 		for _, dhcp := range listDHCPsResponse.Dhcps {
-			err := api.DeleteDHCP(&vpcgwSDK.DeleteDHCPRequest{
-				Zone:   zone,
-				DHCPID: dhcp.ID,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:         "scaleway_vpc_public_gateway_dhcp",
+				Locality:     zone.String(),
+				ID:           dhcp.ID,
+				SweepUnnamed: true,
+				CreatedAt:    dhcp.CreatedAt,
+			}, func() error {
+				return api.DeleteDHCP(&vpcgwSDK.DeleteDHCPRequest{
+					Zone:   zone,
+					DHCPID: dhcp.ID,
+				})
 			})
 			if err != nil {
 				return fmt.Errorf("error deleting public gateway dhcp in sweeper: %w", err)
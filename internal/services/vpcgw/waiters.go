@@ -6,58 +6,96 @@ import (
 
 	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
 )
 
-func waitForVPCPublicGateway(ctx context.Context, api *vpcgw.API, zone scw.Zone, id string, timeout time.Duration) (*vpcgw.Gateway, error) {
-	retryInterval := defaultRetry
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+func waitForVPCPublicGateway(ctx context.Context, api *vpcgw.API, zone scw.Zone, id string, timeout time.Duration) (_ *vpcgw.Gateway, err error) {
+	ctx, span := tracing.Start(ctx, "waitForVPCPublicGateway",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[vpcgw.GatewayStatus]struct{}{
+		vpcgw.GatewayStatusUnknown: {},
+		vpcgw.GatewayStatusStopped: {},
+		vpcgw.GatewayStatusRunning: {},
+		vpcgw.GatewayStatusFailed:  {},
+		vpcgw.GatewayStatusDeleted: {},
+		vpcgw.GatewayStatusLocked:  {},
 	}
 
-	gateway, err := api.WaitForGateway(&vpcgw.WaitForGatewayRequest{
-		Timeout:       scw.TimeDurationPtr(timeout),
-		GatewayID:     id,
-		RetryInterval: &retryInterval,
-		Zone:          zone,
-	}, scw.WithContext(ctx))
+	gateway, err := transport.Poll(ctx, defaultRetry, timeout, func(ctx context.Context) (*vpcgw.Gateway, bool, error) {
+		res, err := api.GetGateway(&vpcgw.GetGatewayRequest{
+			Zone:      zone,
+			GatewayID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return gateway, err
 }
 
-func waitForVPCGatewayNetwork(ctx context.Context, api *vpcgw.API, zone scw.Zone, id string, timeout time.Duration) (*vpcgw.GatewayNetwork, error) {
-	retryIntervalGWNetwork := defaultRetry
-	if transport.DefaultWaitRetryInterval != nil {
-		retryIntervalGWNetwork = *transport.DefaultWaitRetryInterval
+func waitForVPCGatewayNetwork(ctx context.Context, api *vpcgw.API, zone scw.Zone, id string, timeout time.Duration) (_ *vpcgw.GatewayNetwork, err error) {
+	ctx, span := tracing.Start(ctx, "waitForVPCGatewayNetwork",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(id),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	terminalStatus := map[vpcgw.GatewayNetworkStatus]struct{}{
+		vpcgw.GatewayNetworkStatusReady:   {},
+		vpcgw.GatewayNetworkStatusUnknown: {},
+		vpcgw.GatewayNetworkStatusDeleted: {},
 	}
 
-	gatewayNetwork, err := api.WaitForGatewayNetwork(&vpcgw.WaitForGatewayNetworkRequest{
-		GatewayNetworkID: id,
-		Timeout:          scw.TimeDurationPtr(timeout),
-		RetryInterval:    &retryIntervalGWNetwork,
-		Zone:             zone,
-	}, scw.WithContext(ctx))
+	gatewayNetwork, err := transport.Poll(ctx, defaultRetry, timeout, func(ctx context.Context) (*vpcgw.GatewayNetwork, bool, error) {
+		res, err := api.GetGatewayNetwork(&vpcgw.GetGatewayNetworkRequest{
+			Zone:             zone,
+			GatewayNetworkID: id,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 
 	return gatewayNetwork, err
 }
 
-func waitForDHCPEntries(ctx context.Context, api *vpcgw.API, zone scw.Zone, gatewayID string, macAddress string, timeout time.Duration) (*vpcgw.ListDHCPEntriesResponse, error) {
-	retryIntervalDHCPEntries := defaultRetry
-	if transport.DefaultWaitRetryInterval != nil {
-		retryIntervalDHCPEntries = *transport.DefaultWaitRetryInterval
-	}
+func waitForDHCPEntries(ctx context.Context, api *vpcgw.API, zone scw.Zone, gatewayID string, macAddress string, timeout time.Duration) (_ *vpcgw.ListDHCPEntriesResponse, err error) {
+	ctx, span := tracing.Start(ctx, "waitForDHCPEntries",
+		tracing.AttributeZone.String(zone.String()),
+		tracing.AttributeResourceID.String(gatewayID),
+	)
+	defer func() { tracing.End(span, err) }()
 
-	req := &vpcgw.WaitForDHCPEntriesRequest{
-		MacAddress:    macAddress,
-		Zone:          zone,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryIntervalDHCPEntries,
+	req := &vpcgw.ListDHCPEntriesRequest{
+		MacAddress: &macAddress,
+		Zone:       zone,
 	}
 
 	if gatewayID != "" {
 		req.GatewayNetworkID = &gatewayID
 	}
 
-	dhcpEntries, err := api.WaitForDHCPEntries(req, scw.WithContext(ctx))
+	dhcpEntries, err := transport.Poll(ctx, defaultRetry, timeout, func(ctx context.Context) (*vpcgw.ListDHCPEntriesResponse, bool, error) {
+		res, err := api.ListDHCPEntries(req, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		for _, entry := range res.DHCPEntries {
+			if entry.MacAddress == macAddress {
+				return res, true, nil
+			}
+		}
+		return res, false, nil
+	})
 	return dhcpEntries, err
 }
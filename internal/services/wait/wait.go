@@ -0,0 +1,215 @@
+package wait
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/regional"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/locality/zonal"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+)
+
+const (
+	defaultWaitTimeout       = 30 * time.Minute
+	defaultWaitRetryInterval = 5 * time.Second
+)
+
+// statusFunc returns the status of a resource and true once it is ready.
+type statusFunc func(ctx context.Context, client *scw.Client, id string) (string, bool, error)
+
+// statusFuncs get the status of the resources that can be waited for, by argument.
+var statusFuncs = map[string]statusFunc{
+	"instance_server_id": instanceServerStatus,
+	"k8s_cluster_id":     k8sClusterStatus,
+	"k8s_pool_id":        k8sPoolStatus,
+	"rdb_instance_id":    rdbInstanceStatus,
+}
+
+// waitedResources are the arguments of the resources that can be waited for.
+var waitedResources = []string{"instance_server_id", "k8s_cluster_id", "k8s_pool_id", "rdb_instance_id"}
+
+// ResourceWait blocks until a resource created or updated with skip_wait is ready.
+// It only waits when it is created, which happens again when the ID of the waited resource changes:
+// unlike a data source, it does not block every plan and refresh.
+func ResourceWait() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceWaitCreate,
+		ReadContext:   ResourceWaitRead,
+		DeleteContext: ResourceWaitDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultWaitTimeout),
+			Default: schema.DefaultTimeout(defaultWaitTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The ID of the instance server to wait for",
+				ExactlyOneOf: waitedResources,
+			},
+			"k8s_cluster_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The ID of the kubernetes cluster to wait for",
+				ExactlyOneOf: waitedResources,
+			},
+			"k8s_pool_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The ID of the kubernetes pool to wait for",
+				ExactlyOneOf: waitedResources,
+			},
+			"rdb_instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The ID of the database instance to wait for",
+				ExactlyOneOf: waitedResources,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the resource, as it was once ready and then on each refresh",
+			},
+		},
+	}
+}
+
+// waitedResource returns the argument of the resource waited for and its ID.
+func waitedResource(d *schema.ResourceData) (string, string) {
+	for _, argument := range waitedResources {
+		if id := d.Get(argument).(string); id != "" {
+			return argument, id
+		}
+	}
+	return "", ""
+}
+
+func ResourceWaitCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := meta.ExtractScwClient(m)
+	argument, id := waitedResource(d)
+	getStatus := statusFuncs[argument]
+
+	status, err := transport.Poll(ctx, defaultWaitRetryInterval, d.Timeout(schema.TimeoutCreate), func(ctx context.Context) (string, bool, error) {
+		return getStatus(ctx, client, id)
+	})
+	if err != nil {
+		return httperrors.Diagnostics(err)
+	}
+
+	d.SetId(id)
+	_ = d.Set("status", status)
+
+	return nil
+}
+
+// ResourceWaitRead refreshes the status of the resource without waiting for it.
+func ResourceWaitRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	argument, id := waitedResource(d)
+	status, _, err := statusFuncs[argument](ctx, meta.ExtractScwClient(m), id)
+	if err != nil {
+		if httperrors.Is404(err) {
+			d.SetId("")
+			return nil
+		}
+		return httperrors.Diagnostics(err)
+	}
+
+	_ = d.Set("status", status)
+
+	return nil
+}
+
+// ResourceWaitDelete only removes the resource from the state, the resource waited for is left untouched.
+func ResourceWaitDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func instanceServerStatus(ctx context.Context, client *scw.Client, id string) (string, bool, error) {
+	terminalStatus := map[instance.ServerState]struct{}{
+		instance.ServerStateStopped:        {},
+		instance.ServerStateStoppedInPlace: {},
+		instance.ServerStateLocked:         {},
+		instance.ServerStateRunning:        {},
+	}
+
+	zonalID := zonal.ExpandID(id)
+	res, err := instance.NewAPI(client).GetServer(&instance.GetServerRequest{
+		Zone:     zonalID.Zone,
+		ServerID: zonalID.ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", false, err
+	}
+	_, isTerminal := terminalStatus[res.Server.State]
+	return res.Server.State.String(), isTerminal, nil
+}
+
+func k8sClusterStatus(ctx context.Context, client *scw.Client, id string) (string, bool, error) {
+	terminalStatus := map[k8s.ClusterStatus]struct{}{
+		k8s.ClusterStatusReady:        {},
+		k8s.ClusterStatusLocked:       {},
+		k8s.ClusterStatusDeleted:      {},
+		k8s.ClusterStatusPoolRequired: {},
+	}
+
+	regionalID := regional.ExpandID(id)
+	cluster, err := k8s.NewAPI(client).GetCluster(&k8s.GetClusterRequest{
+		Region:    regionalID.Region,
+		ClusterID: regionalID.ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", false, err
+	}
+	_, isTerminal := terminalStatus[cluster.Status]
+	return cluster.Status.String(), isTerminal, nil
+}
+
+func k8sPoolStatus(ctx context.Context, client *scw.Client, id string) (string, bool, error) {
+	terminalStatus := map[k8s.PoolStatus]struct{}{
+		k8s.PoolStatusReady:   {},
+		k8s.PoolStatusWarning: {},
+	}
+
+	regionalID := regional.ExpandID(id)
+	pool, err := k8s.NewAPI(client).GetPool(&k8s.GetPoolRequest{
+		Region: regionalID.Region,
+		PoolID: regionalID.ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", false, err
+	}
+	_, isTerminal := terminalStatus[pool.Status]
+	return pool.Status.String(), isTerminal, nil
+}
+
+func rdbInstanceStatus(ctx context.Context, client *scw.Client, id string) (string, bool, error) {
+	terminalStatus := map[rdb.InstanceStatus]struct{}{
+		rdb.InstanceStatusReady:    {},
+		rdb.InstanceStatusDiskFull: {},
+		rdb.InstanceStatusError:    {},
+	}
+
+	regionalID := regional.ExpandID(id)
+	rdbInstance, err := rdb.NewAPI(client).GetInstance(&rdb.GetInstanceRequest{
+		Region:     regionalID.Region,
+		InstanceID: regionalID.ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return "", false, err
+	}
+	_, isTerminal := terminalStatus[rdbInstance.Status]
+	return rdbInstance.Status.String(), isTerminal, nil
+}
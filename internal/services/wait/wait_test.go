@@ -0,0 +1,122 @@
+package wait_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/acctest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/meta"
+	instancechecks "github.com/scaleway/terraform-provider-scaleway/v2/internal/services/instance/testfuncs"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/wait"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTestID = "11111111-1111-1111-1111-111111111111"
+
+func TestAccWait_Basic(t *testing.T) {
+	tt := acctest.NewFakeTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      instancechecks.IsServerDestroyed(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						name      = "tf-tests-wait"
+						type      = "DEV1-S"
+						image     = "ubuntu_jammy"
+						state     = "started"
+						skip_wait = true
+					}
+
+					resource "scaleway_wait" "server" {
+						instance_server_id = scaleway_instance_server.main.id
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("scaleway_wait.server", "id", "scaleway_instance_server.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_wait.server", "status", "running"),
+				),
+			},
+		},
+	})
+}
+
+// statusMeta returns a meta whose API returns the statuses of path in turn, then the last one.
+func statusMeta(t *testing.T, path string, statuses ...string) (*meta.Meta, func() int) {
+	t.Helper()
+	mu := sync.Mutex{}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != path {
+			http.NotFound(w, r)
+			return
+		}
+		mu.Lock()
+		status := statuses[min(requests, len(statuses)-1)]
+		requests++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": waitTestID, "status": status})
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("SCW_API_URL", server.URL)
+	m, err := meta.NewMeta(context.Background(), &meta.Config{
+		TerraformVersion: "terraform-tests",
+		ForceAccessKey:   "SCWXXXXXXXXXXXXXXXXX",
+		ForceSecretKey:   waitTestID,
+	})
+	require.NoError(t, err)
+
+	return m, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+func TestResourceWaitCreate(t *testing.T) {
+	interval := time.Millisecond
+	ctx := transport.ContextWithWaitOptions(context.Background(), transport.WaitOptions{Backoff: true, InitialInterval: &interval})
+
+	tests := []struct {
+		argument string
+		path     string
+		statuses []string
+	}{
+		{"k8s_cluster_id", "/k8s/v1/regions/fr-par/clusters/" + waitTestID, []string{"creating", "creating", "pool_required"}},
+		{"k8s_pool_id", "/k8s/v1/regions/fr-par/pools/" + waitTestID, []string{"scaling", "ready"}},
+		{"rdb_instance_id", "/rdb/v1/regions/fr-par/instances/" + waitTestID, []string{"provisioning", "initializing", "ready"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.argument, func(t *testing.T) {
+			m, requests := statusMeta(t, tt.path, tt.statuses...)
+			d := schema.TestResourceDataRaw(t, wait.ResourceWait().Schema, map[string]interface{}{
+				tt.argument: "fr-par/" + waitTestID,
+			})
+
+			diags := wait.ResourceWaitCreate(ctx, d, m)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, "fr-par/"+waitTestID, d.Id())
+			assert.Equal(t, tt.statuses[len(tt.statuses)-1], d.Get("status"))
+			assert.Equal(t, len(tt.statuses), requests())
+
+			// Reads only refresh the status, without waiting
+			diags = wait.ResourceWaitRead(ctx, d, m)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, len(tt.statuses)+1, requests())
+		})
+	}
+}
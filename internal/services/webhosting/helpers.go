@@ -40,15 +40,22 @@ func NewAPIWithRegionAndID(m interface{}, id string) (*webhosting.API, scw.Regio
 }
 
 func waitForHosting(ctx context.Context, api *webhosting.API, region scw.Region, hostingID string, timeout time.Duration) (*webhosting.Hosting, error) {
-	retryInterval := hostingRetryInterval
-	if transport.DefaultWaitRetryInterval != nil {
-		retryInterval = *transport.DefaultWaitRetryInterval
+	terminalStatus := map[webhosting.HostingStatus]struct{}{
+		webhosting.HostingStatusReady:         {},
+		webhosting.HostingStatusError:         {},
+		webhosting.HostingStatusUnknownStatus: {},
+		webhosting.HostingStatusLocked:        {},
 	}
 
-	return api.WaitForHosting(&webhosting.WaitForHostingRequest{
-		HostingID:     hostingID,
-		Region:        region,
-		Timeout:       scw.TimeDurationPtr(timeout),
-		RetryInterval: &retryInterval,
-	}, scw.WithContext(ctx))
+	return transport.Poll(ctx, hostingRetryInterval, timeout, func(ctx context.Context) (*webhosting.Hosting, bool, error) {
+		res, err := api.GetHosting(&webhosting.GetHostingRequest{
+			Region:    region,
+			HostingID: hostingID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, false, err
+		}
+		_, isTerminal := terminalStatus[res.Status]
+		return res, isTerminal, nil
+	})
 }
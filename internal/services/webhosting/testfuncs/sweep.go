@@ -11,7 +11,7 @@ import (
 )
 
 func AddTestSweepers() {
-	resource.AddTestSweepers("scaleway_webhosting", &resource.Sweeper{
+	acctest.AddTestSweepers("scaleway_webhosting", &resource.Sweeper{
 		Name: "scaleway_webhosting",
 		F:    testSweepWebhosting,
 	})
@@ -29,9 +29,18 @@ func testSweepWebhosting(_ string) error {
 		}
 
 		for _, hosting := range listHostings.Hostings {
-			_, err := webhsotingAPI.DeleteHosting(&webhostingSDK.DeleteHostingRequest{
-				HostingID: hosting.ID,
-				Region:    region,
+			err := acctest.SweepResource(acctest.SweptResource{
+				Type:         "scaleway_webhosting",
+				Locality:     region.String(),
+				ID:           hosting.ID,
+				SweepUnnamed: true,
+				CreatedAt:    hosting.CreatedAt,
+			}, func() error {
+				_, err := webhsotingAPI.DeleteHosting(&webhostingSDK.DeleteHostingRequest{
+					HostingID: hosting.ID,
+					Region:    region,
+				})
+				return err
 			})
 			if err != nil {
 				logging.L.Debugf("sweeper: error (%s)", err)
@@ -164,12 +164,12 @@ func ResourceWebhosting() *schema.Resource {
 func resourceWebhostingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, offerID, err := regional.ParseID(d.Get("offer_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	hostingCreateRequest := &webhosting.CreateHostingRequest{
@@ -197,14 +197,14 @@ func resourceWebhostingCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	hostingResponse, err := api.CreateHosting(hostingCreateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	d.SetId(regional.NewIDString(region, hostingResponse.ID))
 
 	_, err = waitForHosting(ctx, api, region, hostingResponse.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	return resourceWebhostingRead(ctx, d, m)
@@ -213,7 +213,7 @@ func resourceWebhostingCreate(ctx context.Context, d *schema.ResourceData, m int
 func resourceWebhostingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	webhostingResponse, err := waitForHosting(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -222,7 +222,7 @@ func resourceWebhostingRead(ctx context.Context, d *schema.ResourceData, m inter
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_ = d.Set("tags", webhostingResponse.Tags)
@@ -248,12 +248,12 @@ func resourceWebhostingRead(ctx context.Context, d *schema.ResourceData, m inter
 func resourceWebhostingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	res, err := waitForHosting(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	updateRequest := &webhosting.UpdateHostingRequest{
@@ -271,7 +271,7 @@ func resourceWebhostingUpdate(ctx context.Context, d *schema.ResourceData, m int
 	if d.HasChange("offer_id") {
 		_, offerID, err := regional.ParseID(d.Get("offer_id").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 		updateRequest.OfferID = types.ExpandUpdatedStringPtr(offerID)
 		hasChanged = true
@@ -282,7 +282,7 @@ func resourceWebhostingUpdate(ctx context.Context, d *schema.ResourceData, m int
 		hasChanged = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateRequest.Tags = types.ExpandUpdatedStringsPtr(d.Get("tags"))
 		hasChanged = true
 	}
@@ -290,7 +290,7 @@ func resourceWebhostingUpdate(ctx context.Context, d *schema.ResourceData, m int
 	if hasChanged {
 		_, err := api.UpdateHosting(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 	}
 
@@ -300,7 +300,7 @@ func resourceWebhostingUpdate(ctx context.Context, d *schema.ResourceData, m int
 func resourceHostingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, id, err := NewAPIWithRegionAndID(m, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForHosting(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -313,7 +313,7 @@ func resourceHostingDelete(ctx context.Context, d *schema.ResourceData, m interf
 		HostingID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !httperrors.Is404(err) {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	_, err = waitForHosting(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
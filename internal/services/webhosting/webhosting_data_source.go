@@ -8,6 +8,7 @@ import (
 	webhosting "github.com/scaleway/scaleway-sdk-go/api/webhosting/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/datasource"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/httperrors"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/services/account"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/verify"
@@ -43,7 +44,7 @@ func DataSourceWebhosting() *schema.Resource {
 func DataSourceWebhostingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	api, region, err := newAPIWithRegion(d, m)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	webhostingID, ok := d.GetOk("webhosting_id")
@@ -56,7 +57,7 @@ func DataSourceWebhostingRead(ctx context.Context, d *schema.ResourceData, m int
 			OrganizationID: types.ExpandStringPtr(d.Get("organization_id")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		foundDomain, err := datasource.FindExact(
@@ -65,7 +66,7 @@ func DataSourceWebhostingRead(ctx context.Context, d *schema.ResourceData, m int
 			hostingDomain,
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return httperrors.Diagnostics(err)
 		}
 
 		webhostingID = foundDomain.ID
@@ -75,7 +76,7 @@ func DataSourceWebhostingRead(ctx context.Context, d *schema.ResourceData, m int
 	d.SetId(regionalID)
 	err = d.Set("webhosting_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return httperrors.Diagnostics(err)
 	}
 
 	diags := resourceWebhostingRead(ctx, d, m)
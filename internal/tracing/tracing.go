@@ -0,0 +1,98 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TraceFileEnvVar is the path of a file where spans are written as OTLP JSON lines
+	TraceFileEnvVar = "SCW_TRACE_FILE"
+
+	otlpEndpointEnvVar       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	otlpTracesEndpointEnvVar = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	tracerName = "github.com/scaleway/terraform-provider-scaleway"
+)
+
+// Attributes set on the spans of the provider
+const (
+	AttributeResourceType = attribute.Key("scaleway.resource.type")
+	AttributeResourceID   = attribute.Key("scaleway.resource.id")
+	AttributeOperation    = attribute.Key("scaleway.operation")
+	AttributeZone         = attribute.Key("scaleway.zone")
+	AttributeRegion       = attribute.Key("scaleway.region")
+	AttributeRequestID    = attribute.Key("scaleway.request_id")
+	AttributeTargetStatus = attribute.Key("scaleway.target_status")
+)
+
+// Init configures the export of the spans of the provider. Tracing is enabled by environment variables:
+//   - OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT exports spans with OTLP over HTTP
+//   - SCW_TRACE_FILE writes spans to a local file as OTLP JSON lines
+//
+// The returned function flushes the pending spans and must be called before the provider exits.
+func Init(ctx context.Context) (func(context.Context) error, error) {
+	options := []sdktrace.TracerProviderOption(nil)
+
+	if os.Getenv(otlpEndpointEnvVar) != "" || os.Getenv(otlpTracesEndpointEnvVar) != "" {
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+	}
+
+	if traceFile := os.Getenv(TraceFileEnvVar); traceFile != "" {
+		exporter, err := otlptrace.New(ctx, newFileClient(traceFile))
+		if err != nil {
+			return nil, err
+		}
+		// Spans are written synchronously as the provider process may be killed at the end of an operation
+		options = append(options, sdktrace.WithSyncer(exporter))
+	}
+
+	if len(options) == 0 {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName("terraform-provider-scaleway"),
+		semconv.ServiceVersion(version.Version),
+	))
+	if err != nil && !errors.Is(err, resource.ErrSchemaURLConflict) {
+		return nil, err
+	}
+	options = append(options, sdktrace.WithResource(res))
+
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Start creates a span as a child of the span of the context, if any.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End records the error if any, then ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
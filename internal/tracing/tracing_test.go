@@ -0,0 +1,36 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit_TraceFile(t *testing.T) {
+	traceFile := filepath.Join(t.TempDir(), "traces.json")
+	t.Setenv(tracing.TraceFileEnvVar, traceFile)
+
+	ctx := context.Background()
+	shutdown, err := tracing.Init(ctx)
+	require.NoError(t, err)
+
+	ctx, span := tracing.Start(ctx, "create scaleway_instance_server", tracing.AttributeResourceType.String("scaleway_instance_server"))
+	_, child := tracing.Start(ctx, "waitForServer")
+	tracing.End(child, errors.New("timeout"))
+	span.End()
+
+	require.NoError(t, shutdown(ctx))
+
+	traces, err := os.ReadFile(traceFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(traces), `"name":"create scaleway_instance_server"`)
+	assert.Contains(t, string(traces), `"name":"waitForServer"`)
+	assert.Contains(t, string(traces), `"stringValue":"scaleway_instance_server"`)
+	assert.Contains(t, string(traces), `"stringValue":"terraform-provider-scaleway"`)
+}
@@ -0,0 +1,244 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// CachedTransport coalesces identical concurrent GET requests sent to the Scaleway APIs
+// and caches their successful responses for a short duration.
+//
+// Any mutating request invalidates the cached responses of the same resource path, which is the first resource of
+// the URL in its API (e.g. api.scaleway.com/lb/v1/zones/fr-par-1/lbs/{id}), and the listings of its collection.
+// Creating a backend of a load balancer thus invalidates the load balancer, its sub-resources and the list of
+// load balancers, but not the other resources of the API, which may be stale until the ttl expires.
+//
+// The requests of the waiters, see ContextWithoutCache, are always sent so that they see the changes of the
+// operation they wait for, and their responses replace the cached ones.
+//
+// The coalesced request is sent without the cancellation of the caller that started it,
+// so that cancelling one caller does not fail the others waiting for the same response.
+type CachedTransport struct {
+	transport http.RoundTripper
+	ttl       time.Duration
+	group     singleflight.Group
+
+	mu sync.Mutex
+	// generations is incremented for a resource path on each mutating request
+	generations map[string]uint64
+	entries     map[string]*cachedResponse
+}
+
+type withoutCacheContextKey struct{}
+
+// ContextWithoutCache returns a context whose GET requests are sent even if their response is cached.
+func ContextWithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutCacheContextKey{}, true)
+}
+
+func skipsCache(ctx context.Context) bool {
+	skip, _ := ctx.Value(withoutCacheContextKey{}).(bool)
+	return skip
+}
+
+type cachedResponse struct {
+	proto      string
+	status     string
+	statusCode int
+	header     http.Header
+	body       []byte
+	expiresAt  time.Time
+}
+
+// NewCachedTransport creates a http transport caching GET responses during ttl.
+// A ttl of 0 only coalesces concurrent requests.
+func NewCachedTransport(defaultTransport http.RoundTripper, ttl time.Duration) *CachedTransport {
+	return &CachedTransport{
+		transport:   defaultTransport,
+		ttl:         ttl,
+		generations: make(map[string]uint64),
+		entries:     make(map[string]*cachedResponse),
+	}
+}
+
+// RoundTrip returns a cached response for GET requests if any, sends the request otherwise.
+func (t *CachedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		paths := mutatedPaths(r.URL)
+		t.invalidate(paths...)
+		resp, err := t.transport.RoundTrip(r)
+		t.invalidate(paths...)
+		return resp, err
+	}
+
+	// Only Scaleway API requests are cached, S3-like APIs sign each request differently.
+	if r.Header.Get("X-Auth-Token") == "" || r.Header.Get("Range") != "" {
+		return t.transport.RoundTrip(r)
+	}
+
+	path := resourcePath(r.URL)
+	key, generation := t.key(r, path)
+	if skipsCache(r.Context()) {
+		cached, err := t.fetch(r, key, path, generation)
+		if err != nil {
+			return nil, err
+		}
+		return cached.response(r), nil
+	}
+	if cached := t.get(key); cached != nil {
+		return cached.response(r), nil
+	}
+
+	// The request is shared by the callers, it must not be cancelled with the context of the first one
+	shared := r.Clone(context.WithoutCancel(r.Context()))
+	ch := t.group.DoChan(key, func() (interface{}, error) {
+		return t.fetch(shared, key, path, generation)
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*cachedResponse).response(r), nil
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+}
+
+// fetch sends a GET request and caches its response if it succeeded.
+func (t *CachedTransport) fetch(r *http.Request, key string, path string, generation uint64) (*cachedResponse, error) {
+	resp, err := t.transport.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	cached := &cachedResponse{
+		proto:      resp.Proto,
+		status:     resp.Status,
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+		expiresAt:  time.Now().Add(t.ttl),
+	}
+	if t.ttl > 0 && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		t.set(key, path, generation, cached)
+	}
+	return cached, nil
+}
+
+// key returns the cache key of a request, which changes every time its resource path is invalidated.
+func (t *CachedTransport) key(r *http.Request, path string) (string, uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	generation := t.generations[path]
+	return strings.Join([]string{
+		r.Method,
+		path,
+		strconv.FormatUint(generation, 10),
+		r.URL.String(),
+		r.Header.Get("X-Auth-Token"),
+	}, "\x00"), generation
+}
+
+func (t *CachedTransport) get(key string) *cachedResponse {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cached, exists := t.entries[key]
+	if !exists {
+		return nil
+	}
+	if time.Now().After(cached.expiresAt) {
+		delete(t.entries, key)
+		return nil
+	}
+	return cached
+}
+
+func (t *CachedTransport) set(key string, path string, generation uint64, cached *cachedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Do not cache a response fetched before a mutating request
+	if t.generations[path] != generation {
+		return
+	}
+	t.entries[key] = cached
+}
+
+func (t *CachedTransport) invalidate(paths ...string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, path := range paths {
+		t.generations[path]++
+	}
+	now := time.Now()
+	for key, cached := range t.entries {
+		if now.After(cached.expiresAt) {
+			delete(t.entries, key)
+			continue
+		}
+		for _, path := range paths {
+			if strings.Contains(key, "\x00"+path+"\x00") {
+				delete(t.entries, key)
+				break
+			}
+		}
+	}
+}
+
+func (c *cachedResponse) response(r *http.Request) *http.Response {
+	return &http.Response{
+		Proto:         c.proto,
+		Status:        c.status,
+		StatusCode:    c.statusCode,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       r,
+	}
+}
+
+// resourcePath returns the path of the first resource of an API URL, after its host, product, version and locality.
+// e.g. https://api.scaleway.com/lb/v1/zones/fr-par-1/lbs/{id}/backends => api.scaleway.com/lb/v1/zones/fr-par-1/lbs/{id}
+func resourcePath(u *url.URL) string {
+	return apiPath(u, 2)
+}
+
+// mutatedPaths returns the resource paths whose cached responses a mutating request invalidates:
+// the path of its resource and the one of the collection listing it.
+func mutatedPaths(u *url.URL) []string {
+	resource, collection := apiPath(u, 2), apiPath(u, 1)
+	if resource == collection {
+		return []string{resource}
+	}
+	return []string{resource, collection}
+}
+
+// apiPath returns the host, product, version and locality of an API URL followed by up to depth segments.
+func apiPath(u *url.URL, depth int) string {
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	length := 2
+	if len(segments) >= 4 && (segments[2] == "zones" || segments[2] == "regions") {
+		length = 4
+	}
+	length = min(len(segments), length+depth)
+	return u.Host + "/" + strings.Join(segments[:length], "/")
+}
@@ -0,0 +1,129 @@
+package transport_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedTransport(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			calls.Add(1)
+			time.Sleep(50 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte(`{"id":"lb"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: transport.NewCachedTransport(http.DefaultTransport, time.Minute)}
+	doWithContext := func(ctx context.Context, method string, path string) string {
+		req, err := http.NewRequestWithContext(ctx, method, server.URL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set("X-Auth-Token", "token")
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+	do := func(method string, path string) string {
+		return doWithContext(context.Background(), method, path)
+	}
+
+	wg := sync.WaitGroup{}
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, `{"id":"lb"}`, do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs/lb"))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load(), "concurrent requests should be coalesced")
+
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs/lb")
+	assert.Equal(t, int32(1), calls.Load(), "response should be cached")
+
+	do(http.MethodPost, "/lb/v1/zones/fr-par-2/lbs/lb/backends")
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs/lb")
+	assert.Equal(t, int32(1), calls.Load(), "mutation in another zone should not invalidate the cache")
+
+	do(http.MethodPost, "/lb/v1/zones/fr-par-1/ips/ip")
+	do(http.MethodDelete, "/lb/v1/zones/fr-par-1/lbs/other-lb")
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs/lb")
+	assert.Equal(t, int32(1), calls.Load(), "mutation of another resource should not invalidate the cache")
+
+	do(http.MethodPost, "/lb/v1/zones/fr-par-1/lbs/lb/backends")
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs/lb")
+	assert.Equal(t, int32(2), calls.Load(), "mutation of a sub-resource should invalidate the resource")
+
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs")
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs")
+	assert.Equal(t, int32(3), calls.Load(), "listing should be cached")
+	do(http.MethodPost, "/lb/v1/zones/fr-par-1/lbs")
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs")
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs/lb")
+	assert.Equal(t, int32(4), calls.Load(), "creation should only invalidate the listing")
+	do(http.MethodDelete, "/lb/v1/zones/fr-par-1/lbs/lb")
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs")
+	assert.Equal(t, int32(5), calls.Load(), "deletion should invalidate the listing")
+
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs/lb")
+	assert.Equal(t, int32(6), calls.Load())
+	doWithContext(transport.ContextWithoutCache(context.Background()), http.MethodGet, "/lb/v1/zones/fr-par-1/lbs/lb")
+	assert.Equal(t, int32(7), calls.Load(), "waiter requests should not be served from the cache")
+	do(http.MethodGet, "/lb/v1/zones/fr-par-1/lbs/lb")
+	assert.Equal(t, int32(7), calls.Load(), "waiter responses should be cached")
+}
+
+func TestCachedTransport_CancelledCaller(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"id":"lb"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: transport.NewCachedTransport(http.DefaultTransport, time.Minute)}
+	newRequest := func(ctx context.Context) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/lb/v1/zones/fr-par-1/lbs/lb", nil)
+		require.NoError(t, err)
+		req.Header.Set("X-Auth-Token", "token")
+		return req
+	}
+
+	// The first caller starts the request, then gives up
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	firstErr := make(chan error, 1)
+	go func() {
+		resp, err := client.Do(newRequest(ctx))
+		if err == nil {
+			resp.Body.Close()
+		}
+		firstErr <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	resp, err := client.Do(newRequest(context.Background()))
+	require.NoError(t, err, "the cancellation of the first caller should not fail the others")
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"lb"}`, string(body))
+	assert.ErrorIs(t, <-firstErr, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), calls.Load(), "concurrent requests should be coalesced")
+}
@@ -0,0 +1,122 @@
+package transport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/logging"
+)
+
+// maxLoggedBodySize is the maximum number of bytes of a body logged at the TRACE level.
+const maxLoggedBodySize = 64 * 1024
+
+// LoggingTransport logs each HTTP request in the tflog subsystem of its service, with secrets redacted.
+// Method, URL, status, duration and request ID are logged at the DEBUG level, headers and bodies at the TRACE level.
+type LoggingTransport struct {
+	transport http.RoundTripper
+}
+
+// NewLoggingTransport creates a http transport logging requests.
+func NewLoggingTransport(defaultTransport http.RoundTripper) *LoggingTransport {
+	return &LoggingTransport{
+		transport: defaultTransport,
+	}
+}
+
+// RoundTrip logs the request and its response.
+func (t *LoggingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	subsystem := logging.Subsystem(r.URL)
+	ctx := logging.NewSubsystem(r.Context(), subsystem)
+	logBodies := logging.IsTraceEnabled(subsystem)
+
+	fields := map[string]interface{}{
+		"method": r.Method,
+		"url":    r.URL.Redacted(),
+	}
+	if operation, ok := OperationFromContext(r.Context()); ok {
+		fields["resource_type"] = operation.ResourceType
+		fields["operation"] = operation.Name
+	}
+
+	tflog.SubsystemDebug(ctx, subsystem, "HTTP request", fields)
+	if logBodies {
+		traceFields := map[string]interface{}{
+			"headers": logging.RedactHeaders(r.Header),
+		}
+		if contentType := r.Header.Get("Content-Type"); r.Body != nil && r.Body != http.NoBody && isTextContentType(contentType) {
+			r = r.Clone(r.Context())
+			body, err := peekBody(&r.Body)
+			if err != nil {
+				return nil, err
+			}
+			traceFields["body"] = logging.RedactBody(r.URL.Path, contentType, body)
+		}
+		tflog.SubsystemTrace(ctx, subsystem, "HTTP request details", fields, traceFields)
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(r)
+	responseFields := map[string]interface{}{
+		"duration": time.Since(start).String(),
+	}
+	if err != nil {
+		responseFields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, subsystem, "HTTP request failed", fields, responseFields)
+		return nil, err
+	}
+
+	responseFields["status"] = resp.StatusCode
+	if requestID := resp.Header.Get("X-Request-Id"); requestID != "" {
+		responseFields["request_id"] = requestID
+	}
+	tflog.SubsystemDebug(ctx, subsystem, "HTTP response", fields, responseFields)
+
+	if logBodies {
+		traceFields := map[string]interface{}{
+			"headers": logging.RedactHeaders(resp.Header),
+		}
+		if contentType := resp.Header.Get("Content-Type"); isTextContentType(contentType) {
+			body, err := peekBody(&resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			traceFields["body"] = logging.RedactBody(r.URL.Path, contentType, body)
+		}
+		tflog.SubsystemTrace(ctx, subsystem, "HTTP response details", fields, responseFields, traceFields)
+	}
+
+	return resp, nil
+}
+
+// peekBody reads the beginning of a body and replaces it with a body returning the same content.
+func peekBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil {
+		return nil, nil
+	}
+	prefix, err := io.ReadAll(io.LimitReader(*body, maxLoggedBodySize))
+	if err != nil {
+		return nil, err
+	}
+	*body = readCloser{
+		Reader: io.MultiReader(bytes.NewReader(prefix), *body),
+		Closer: *body,
+	}
+	return prefix, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// isTextContentType returns true for the API bodies worth logging, binary objects are not logged.
+func isTextContentType(contentType string) bool {
+	return strings.Contains(contentType, "json") ||
+		strings.Contains(contentType, "xml") ||
+		strings.HasPrefix(contentType, "text/") ||
+		strings.HasPrefix(contentType, "application/x-www-form-urlencoded")
+}
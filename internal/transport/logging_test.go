@@ -0,0 +1,67 @@
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	t.Setenv("TF_LOG", "TRACE")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"cluster","password":"hunter2"}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "request-id")
+		_, _ = w.Write([]byte(`{"name":"kubeconfig","content":"apiVersion: v1","nested":[{"secret_key":"secret"}]}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	ctx = transport.ContextWithOperation(ctx, "scaleway_k8s_cluster", "create")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/k8s/v1/regions/fr-par/clusters/11111111-1111-1111-1111-111111111111/kubeconfig", strings.NewReader(`{"name":"cluster","password":"hunter2"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Auth-Token", "00000000-0000-0000-0000-000000000000")
+
+	client := &http.Client{Transport: transport.NewLoggingTransport(http.DefaultTransport)}
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Contains(t, string(body), "apiVersion: v1")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	for _, entry := range entries {
+		assert.Equal(t, "provider.k8s", entry["@module"])
+		assert.Equal(t, "POST", entry["method"])
+		assert.Equal(t, "scaleway_k8s_cluster", entry["resource_type"])
+	}
+	assert.Equal(t, "HTTP response", entries[2]["@message"])
+	assert.InDelta(t, http.StatusOK, entries[2]["status"], 0)
+	assert.Equal(t, "request-id", entries[2]["request_id"])
+	assert.NotEmpty(t, entries[2]["duration"])
+
+	logs, err := json.Marshal(entries)
+	require.NoError(t, err)
+	for _, secret := range []string{"hunter2", "00000000-0000-0000-0000-000000000000", "apiVersion", `"secret"`} {
+		assert.NotContains(t, string(logs), secret)
+	}
+}
@@ -0,0 +1,165 @@
+package transport
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// NetworkOptions configures how the clients of the provider reach the APIs.
+type NetworkOptions struct {
+	// ProxyURL is the proxy used for every request, instead of the one from the environment
+	ProxyURL *url.URL
+	// TLSConfig is used instead of the system configuration when a CA bundle or client certificate is set
+	TLSConfig *tls.Config
+}
+
+// LoadTLSConfig creates a TLS configuration trusting the CA bundle and presenting the client certificate if any.
+// It returns nil if none is set.
+func LoadTLSConfig(caBundleFile string, clientCertificateFile string, clientKeyFile string) (*tls.Config, error) {
+	if caBundleFile == "" && clientCertificateFile == "" && clientKeyFile == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caBundleFile != "" {
+		caBundle, err := os.ReadFile(caBundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("no valid certificate found in CA bundle %s", caBundleFile)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if clientCertificateFile != "" || clientKeyFile != "" {
+		if clientCertificateFile == "" || clientKeyFile == "" {
+			return nil, errors.New("both client certificate and client key must be set")
+		}
+		certificate, err := tls.LoadX509KeyPair(clientCertificateFile, clientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// NewHTTPTransport creates the base http transport of the provider, using the given proxy and TLS configuration.
+func NewHTTPTransport(options NetworkOptions) http.RoundTripper {
+	if options.ProxyURL == nil && options.TLSConfig == nil {
+		return http.DefaultTransport
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if options.ProxyURL != nil {
+		t.Proxy = http.ProxyURL(options.ProxyURL)
+	}
+	if options.TLSConfig != nil {
+		t.TLSClientConfig = options.TLSConfig.Clone()
+	}
+	return t
+}
+
+// ProxyDialer opens TCP connections through an HTTP proxy using the CONNECT method.
+// It is used by clients that do not speak HTTP, like NATS.
+type ProxyDialer struct {
+	proxyURL  *url.URL
+	tlsConfig *tls.Config
+	dialer    *net.Dialer
+}
+
+// NewProxyDialer creates a dialer tunneling connections through the proxy.
+// tlsConfig is used to connect to https proxies.
+func NewProxyDialer(proxyURL *url.URL, tlsConfig *tls.Config) *ProxyDialer {
+	return &ProxyDialer{
+		proxyURL:  proxyURL,
+		tlsConfig: tlsConfig,
+		dialer:    &net.Dialer{Timeout: 30 * time.Second},
+	}
+}
+
+// Dial connects to the address through the proxy.
+func (d *ProxyDialer) Dial(network string, address string) (net.Conn, error) {
+	proxyAddress := d.proxyURL.Host
+	if d.proxyURL.Port() == "" {
+		port := "80"
+		if d.proxyURL.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddress = net.JoinHostPort(d.proxyURL.Hostname(), port)
+	}
+
+	var conn net.Conn
+	var err error
+	if d.proxyURL.Scheme == "https" {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if d.tlsConfig != nil {
+			tlsConfig = d.tlsConfig.Clone()
+		}
+		tlsConfig.ServerName = d.proxyURL.Hostname()
+		conn, err = tls.DialWithDialer(d.dialer, network, proxyAddress, tlsConfig)
+	} else {
+		conn, err = d.dialer.Dial(network, proxyAddress)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: http.Header{},
+	}
+	if user := d.proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		_ = conn.Close()
+		return nil, fmt.Errorf("proxy refused connection to %s: %s", address, resp.Status)
+	}
+
+	return &bufferedConn{Conn: conn, reader: reader}, nil
+}
+
+// bufferedConn reads the data that may have been buffered while reading the proxy response.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
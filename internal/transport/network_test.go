@@ -0,0 +1,56 @@
+package transport_test
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTLSConfig(t *testing.T) {
+	tlsConfig, err := transport.LoadTLSConfig("", "", "")
+	require.NoError(t, err)
+	assert.Nil(t, tlsConfig)
+
+	_, err = transport.LoadTLSConfig("testdata/does-not-exist.pem", "", "")
+	require.Error(t, err)
+
+	_, err = transport.LoadTLSConfig("", "client.pem", "")
+	require.Error(t, err)
+}
+
+func TestProxyDialer(t *testing.T) {
+	// The proxy accepts the CONNECT request then behaves like a NATS server, which speaks first.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil || req.Method != http.MethodConnect || req.Host != "nats.example.com:4222" {
+			_, _ = conn.Write([]byte("HTTP/1.1 403 Forbidden\r\n\r\n"))
+			return
+		}
+		_, _ = conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\nINFO {}\r\n"))
+	}()
+
+	dialer := transport.NewProxyDialer(&url.URL{Scheme: "http", Host: listener.Addr().String()}, nil)
+	conn, err := dialer.Dial("tcp", "nats.example.com:4222")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	greeting, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "INFO {}\r\n", string(greeting))
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+)
+
+// Operation describes the terraform operation that sends a request, e.g. the creation of a scaleway_instance_server.
+type Operation struct {
+	// ResourceType is the terraform type of the resource or data source, e.g. scaleway_instance_server
+	ResourceType string
+	// Name is the CRUD operation, e.g. create
+	Name string
+}
+
+func (o Operation) String() string {
+	return fmt.Sprintf("%s of %s", o.Name, o.ResourceType)
+}
+
+type operationContextKey struct{}
+
+// ContextWithOperation returns a context describing the terraform operation that will send the requests.
+func ContextWithOperation(ctx context.Context, resourceType string, name string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, Operation{
+		ResourceType: resourceType,
+		Name:         name,
+	})
+}
+
+// OperationFromContext returns the terraform operation sending a request, if known.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	operation, ok := ctx.Value(operationContextKey{}).(Operation)
+	return operation, ok
+}
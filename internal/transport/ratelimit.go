@@ -0,0 +1,73 @@
+package transport
+
+import (
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit configures the token bucket used to limit requests sent to an API host.
+// An empty Host applies to every host without a specific rate limit.
+type RateLimit struct {
+	Host              string
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// RateLimitedTransport limits the number of requests per second sent to each API host.
+// Every host has its own token bucket, shared by all the requests made with this transport.
+type RateLimitedTransport struct {
+	transport http.RoundTripper
+	limits    map[string]RateLimit
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewRateLimitedTransport creates a http transport waiting for the rate limit of the request host before sending it.
+func NewRateLimitedTransport(defaultTransport http.RoundTripper, limits []RateLimit) *RateLimitedTransport {
+	t := &RateLimitedTransport{
+		transport: defaultTransport,
+		limits:    make(map[string]RateLimit, len(limits)),
+		limiters:  make(map[string]*rate.Limiter),
+	}
+	for _, limit := range limits {
+		t.limits[limit.Host] = limit
+	}
+	return t
+}
+
+// RoundTrip waits for the rate limiter of the request host then sends the request.
+func (t *RateLimitedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if err := t.limiter(r.URL.Host).Wait(r.Context()); err != nil {
+		return nil, err
+	}
+	return t.transport.RoundTrip(r)
+}
+
+func (t *RateLimitedTransport) limiter(host string) *rate.Limiter {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if limiter, exists := t.limiters[host]; exists {
+		return limiter
+	}
+
+	limit, exists := t.limits[host]
+	if !exists {
+		limit, exists = t.limits[""]
+	}
+
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if exists && limit.RequestsPerSecond > 0 {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = max(int(limit.RequestsPerSecond), 1)
+		}
+		limiter = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+	}
+	t.limiters[host] = limiter
+
+	return limiter
+}
@@ -0,0 +1,32 @@
+package transport_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitedTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: transport.NewRateLimitedTransport(http.DefaultTransport, []transport.RateLimit{
+		{RequestsPerSecond: 10, Burst: 1},
+	})}
+
+	start := time.Now()
+	for range 3 {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	// The first request uses the burst, the two others wait 100ms each
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+}
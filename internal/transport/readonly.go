@@ -0,0 +1,85 @@
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrReadOnly is returned when a mutating request is sent while the provider is in read-only mode.
+var ErrReadOnly = errors.New("provider is in read-only mode")
+
+// ReadOnlyTransport rejects every request that could mutate a resource.
+type ReadOnlyTransport struct {
+	transport http.RoundTripper
+}
+
+// NewReadOnlyTransport creates a http transport only allowing GET and HEAD requests,
+// as well as read actions of the AWS-compatible APIs (SQS, SNS) which use POST requests.
+func NewReadOnlyTransport(defaultTransport http.RoundTripper) *ReadOnlyTransport {
+	return &ReadOnlyTransport{
+		transport: defaultTransport,
+	}
+}
+
+// RoundTrip sends the request if it does not mutate a resource.
+func (t *ReadOnlyTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return t.transport.RoundTrip(r)
+	}
+
+	action, err := awsAction(r)
+	if err != nil {
+		return nil, err
+	}
+	if isReadOnlyAWSAction(action) {
+		return t.transport.RoundTrip(r)
+	}
+
+	request := r.Method + " " + r.URL.Redacted()
+	if action != "" {
+		request += " (" + action + ")"
+	}
+	if operation, ok := OperationFromContext(r.Context()); ok {
+		return nil, fmt.Errorf("%w: %s blocked during %s", ErrReadOnly, request, operation)
+	}
+	return nil, fmt.Errorf("%w: %s blocked", ErrReadOnly, request)
+}
+
+// awsAction returns the action of a request sent to an AWS-compatible API.
+// JSON protocols (SQS) send it in the X-Amz-Target header, query protocols (SNS) in the form body.
+func awsAction(r *http.Request) (string, error) {
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		_, action, _ := strings.Cut(target, ".")
+		return action, nil
+	}
+
+	if r.Body == nil || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return "", nil
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return "", err
+	}
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return "", nil //nolint:nilerr
+	}
+	return values.Get("Action"), nil
+}
+
+func isReadOnlyAWSAction(action string) bool {
+	for _, prefix := range []string{"Get", "List", "Describe"} {
+		if strings.HasPrefix(action, prefix) {
+			return true
+		}
+	}
+	return false
+}
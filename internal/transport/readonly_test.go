@@ -0,0 +1,60 @@
+package transport_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: transport.NewReadOnlyTransport(http.DefaultTransport)}
+	ctx := transport.ContextWithOperation(context.Background(), "scaleway_instance_server", "update")
+
+	newRequest := func(method string, body string, headers map[string]string) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, method, server.URL+"/instance/v1/zones/fr-par-1/servers/id", strings.NewReader(body))
+		require.NoError(t, err)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		req     *http.Request
+		allowed bool
+	}{
+		{"get", newRequest(http.MethodGet, "", nil), true},
+		{"head", newRequest(http.MethodHead, "", nil), true},
+		{"patch", newRequest(http.MethodPatch, "{}", nil), false},
+		{"delete", newRequest(http.MethodDelete, "", nil), false},
+		{"sqs read", newRequest(http.MethodPost, "{}", map[string]string{"X-Amz-Target": "AmazonSQS.GetQueueAttributes"}), true},
+		{"sqs write", newRequest(http.MethodPost, "{}", map[string]string{"X-Amz-Target": "AmazonSQS.CreateQueue"}), false},
+		{"sns read", newRequest(http.MethodPost, url.Values{"Action": {"ListTopics"}}.Encode(), map[string]string{"Content-Type": "application/x-www-form-urlencoded"}), true},
+		{"sns write", newRequest(http.MethodPost, url.Values{"Action": {"DeleteTopic"}}.Encode(), map[string]string{"Content-Type": "application/x-www-form-urlencoded"}), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.Do(tt.req)
+			if tt.allowed {
+				require.NoError(t, err)
+				resp.Body.Close()
+				return
+			}
+			require.ErrorIs(t, err, transport.ErrReadOnly)
+			assert.Contains(t, err.Error(), "update of scaleway_instance_server")
+		})
+	}
+}
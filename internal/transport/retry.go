@@ -5,7 +5,10 @@ import (
 	"context"
 	"errors"
 	"io"
+	"math"
+	"math/rand/v2"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -20,6 +23,22 @@ type RetryableTransportOptions struct {
 	RetryMax     *int
 	RetryWaitMax *time.Duration
 	RetryWaitMin *time.Duration
+	// RetryOnStatus lists additional HTTP status codes that should be retried
+	RetryOnStatus []int
+	// RetryNonIdempotent enables retries of non-idempotent requests (POST, PATCH).
+	// They are only retried on 429 Too Many Requests by default as they may have been processed.
+	RetryNonIdempotent bool
+}
+
+type requestMethodContextKey struct{}
+
+// isIdempotentMethod returns true if a request with this method can be sent several times safely.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
 }
 
 func NewRetryableTransportWithOptions(defaultTransport http.RoundTripper, options RetryableTransportOptions) http.RoundTripper {
@@ -31,10 +50,25 @@ func NewRetryableTransportWithOptions(defaultTransport http.RoundTripper, option
 	c.RetryWaitMax = 2 * time.Minute
 	c.Logger = logging.L
 	c.RetryWaitMin = time.Second * 2
+	c.Backoff = RetryBackoff
 	c.CheckRetry = func(ctx context.Context, resp *http.Response, err error) (bool, error) {
-		if resp == nil || resp.StatusCode == http.StatusTooManyRequests {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
 			return true, err
 		}
+		if method, ok := ctx.Value(requestMethodContextKey{}).(string); ok && !options.RetryNonIdempotent && !isIdempotentMethod(method) {
+			return false, err
+		}
+		if resp == nil {
+			return true, err
+		}
+		for _, status := range options.RetryOnStatus {
+			if resp.StatusCode == status {
+				return true, err
+			}
+		}
 		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
 	}
 
@@ -62,6 +96,43 @@ func NewRetryableTransportWithOptions(defaultTransport http.RoundTripper, option
 	return &RetryableTransport{c}
 }
 
+// RetryBackoff honors the Retry-After header of the response if any.
+// Otherwise, it waits exponentially with a random jitter so that parallel requests do not retry in lockstep.
+// The wait is always between minWait and maxWait.
+func RetryBackoff(minWait, maxWait time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(max(retryAfter, minWait), maxWait)
+		}
+	}
+
+	backoff := float64(minWait) * math.Pow(2, float64(attemptNum))
+	if backoff <= 0 || backoff > float64(maxWait) {
+		backoff = float64(maxWait)
+	}
+	// Wait between minWait and the computed backoff. The first attempts still get a jitter of at least half minWait.
+	jitter := max(backoff-float64(minWait), float64(minWait)/2)
+	return min(minWait+time.Duration(rand.Float64()*jitter), maxWait) //nolint:gosec
+}
+
+// parseRetryAfter parses a Retry-After header, either as a number of seconds or as an HTTP date.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(header, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(header)
+	if err != nil {
+		return 0, false
+	}
+	return max(time.Until(date), 0), true
+}
+
 // NewRetryableTransport creates a http transport with retry capability.
 // TODO Retry logic should be moved in the SDK
 func NewRetryableTransport(defaultTransport http.RoundTripper) http.RoundTripper {
@@ -83,7 +154,8 @@ func (c *RetryableTransport) RoundTrip(r *http.Request) (*http.Response, error)
 		}
 		body = bytes.NewReader(bs)
 	}
-	req, err := retryablehttp.NewRequest(r.Method, r.URL.String(), body)
+	ctx := context.WithValue(r.Context(), requestMethodContextKey{}, r.Method)
+	req, err := retryablehttp.NewRequestWithContext(ctx, r.Method, r.URL.String(), body)
 	if err != nil {
 		return nil, err
 	}
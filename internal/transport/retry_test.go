@@ -0,0 +1,109 @@
+package transport_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryableTransport_NonIdempotent(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	retryMax := 2
+	wait := time.Millisecond
+	newClient := func(options transport.RetryableTransportOptions) *http.Client {
+		options.RetryMax = &retryMax
+		options.RetryWaitMin = &wait
+		options.RetryWaitMax = &wait
+		return &http.Client{Transport: transport.NewRetryableTransportWithOptions(http.DefaultTransport, options)}
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		options       transport.RetryableTransportOptions
+		expectedCalls int32
+	}{
+		{"idempotent requests are retried", http.MethodGet, transport.RetryableTransportOptions{}, 3},
+		{"non-idempotent requests are not retried", http.MethodPost, transport.RetryableTransportOptions{}, 1},
+		{"non-idempotent requests are retried when enabled", http.MethodPost, transport.RetryableTransportOptions{RetryNonIdempotent: true}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls.Store(0)
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader("{}"))
+			require.NoError(t, err)
+			resp, err := newClient(tt.options).Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, tt.expectedCalls, calls.Load())
+		})
+	}
+}
+
+func TestRetryableTransport_RetryOnStatus(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	wait := time.Millisecond
+	client := &http.Client{Transport: transport.NewRetryableTransportWithOptions(http.DefaultTransport, transport.RetryableTransportOptions{
+		RetryWaitMin:  &wait,
+		RetryWaitMax:  &wait,
+		RetryOnStatus: []int{http.StatusConflict},
+	})}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRetryBackoff(t *testing.T) {
+	minWait, maxWait := time.Second, time.Minute
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "5")
+	assert.Equal(t, 5*time.Second, transport.RetryBackoff(minWait, maxWait, 0, resp))
+
+	resp.Header.Set("Retry-After", "3600")
+	assert.Equal(t, maxWait, transport.RetryBackoff(minWait, maxWait, 0, resp))
+
+	for attempt := range 10 {
+		backoff := transport.RetryBackoff(minWait, maxWait, attempt, nil)
+		assert.LessOrEqual(t, backoff, maxWait)
+		assert.GreaterOrEqual(t, backoff, minWait)
+	}
+
+	// Parallel requests do not retry in lockstep, even on their first attempt
+	firstBackoffs := map[time.Duration]bool{}
+	for range 100 {
+		backoff := transport.RetryBackoff(minWait, maxWait, 0, nil)
+		assert.GreaterOrEqual(t, backoff, minWait)
+		assert.LessOrEqual(t, backoff, minWait*3/2)
+		firstBackoffs[backoff] = true
+	}
+	assert.Greater(t, len(firstBackoffs), 1)
+
+	// The wait never exceeds maxWait, even when it is lower than minWait
+	assert.Equal(t, time.Duration(0), transport.RetryBackoff(minWait, 0, 3, nil))
+}
@@ -0,0 +1,54 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// TracingTransport creates a span for each HTTP request.
+type TracingTransport struct {
+	transport http.RoundTripper
+}
+
+// NewTracingTransport creates a http transport tracing requests, as children of the span of their context.
+func NewTracingTransport(defaultTransport http.RoundTripper) *TracingTransport {
+	return &TracingTransport{
+		transport: defaultTransport,
+	}
+}
+
+// RoundTrip sends the request within a span.
+func (t *TracingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, span := tracing.Start(r.Context(), "HTTP "+r.Method,
+		semconv.HTTPRequestMethodKey.String(r.Method),
+		semconv.URLFull(r.URL.Redacted()),
+		semconv.ServerAddress(r.URL.Hostname()),
+	)
+	if operation, ok := OperationFromContext(r.Context()); ok {
+		span.SetAttributes(
+			tracing.AttributeResourceType.String(operation.ResourceType),
+			tracing.AttributeOperation.String(operation.Name),
+		)
+	}
+
+	resp, err := t.transport.RoundTrip(r.WithContext(ctx))
+	if err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if requestID := resp.Header.Get("X-Request-Id"); requestID != "" {
+		span.SetAttributes(tracing.AttributeRequestID.String(requestID))
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, fmt.Sprintf("HTTP %d", resp.StatusCode))
+	}
+	span.End()
+
+	return resp, nil
+}
@@ -0,0 +1,130 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultWaitMaxInterval is the maximum interval between two polls of a waiter when the wait block of the provider
+// does not define one.
+const DefaultWaitMaxInterval = time.Minute
+
+// defaultWaitTimeout is the timeout of the waiters called without one, as in the SDK.
+const defaultWaitTimeout = 5 * time.Minute
+
+// pollsPerInterval is the number of polls sent at an interval before doubling it.
+const pollsPerInterval = 4
+
+// WaitOptions configures how the waiters poll the APIs until a resource is ready.
+type WaitOptions struct {
+	// Backoff is true when the wait block of the provider is set, otherwise the waiters poll at their default interval
+	Backoff bool
+	// InitialInterval replaces the default interval of the waiters between their first polls
+	InitialInterval *time.Duration
+	// MaxInterval is the interval up to which the interval between two polls grows
+	MaxInterval *time.Duration
+}
+
+type waitOptionsContextKey struct{}
+
+// ContextWithWaitOptions returns a context whose waiters poll the APIs with the given options.
+func ContextWithWaitOptions(ctx context.Context, options WaitOptions) context.Context {
+	return context.WithValue(ctx, waitOptionsContextKey{}, options)
+}
+
+// WaitOptionsFromContext returns the options of the waiters of an operation.
+func WaitOptionsFromContext(ctx context.Context) WaitOptions {
+	options, _ := ctx.Value(waitOptionsContextKey{}).(WaitOptions)
+	return options
+}
+
+// intervals returns the initial and maximum poll intervals of a waiter whose default interval is defaultInterval.
+// Without backoff, the waiter polls at its default interval.
+func (o WaitOptions) intervals(defaultInterval time.Duration) (time.Duration, time.Duration) {
+	if !o.Backoff {
+		return defaultInterval, defaultInterval
+	}
+
+	initialInterval := defaultInterval
+	if o.InitialInterval != nil {
+		initialInterval = *o.InitialInterval
+	}
+	maxInterval := DefaultWaitMaxInterval
+	if o.MaxInterval != nil {
+		maxInterval = *o.MaxInterval
+	}
+	if maxInterval < initialInterval {
+		maxInterval = initialInterval
+	}
+	return initialInterval, maxInterval
+}
+
+// PollFunc gets a resource and returns true once it reached a terminal state.
+type PollFunc[T any] func(ctx context.Context) (T, bool, error)
+
+// Poll calls get until the resource reaches a terminal state, get fails or the timeout expires.
+// The interval between two calls follows the backoff configured by the options of the context, see WaitBackoff.
+// The context given to get is cancelled at the timeout, so that a slow request does not delay it,
+// and its requests skip the cached responses, see ContextWithoutCache.
+func Poll[T any](ctx context.Context, defaultInterval time.Duration, timeout time.Duration, get PollFunc[T]) (T, error) {
+	var zero T
+	if timeout <= 0 {
+		timeout = defaultWaitTimeout
+	}
+	backoff := NewWaitBackoff(ctx, defaultInterval)
+
+	pollCtx, cancel := context.WithTimeout(ContextWithoutCache(ctx), timeout)
+	defer cancel()
+
+	for {
+		value, isTerminal, err := get(pollCtx)
+		switch {
+		case err != nil && ctx.Err() == nil && pollCtx.Err() != nil:
+			return zero, fmt.Errorf("timeout after %s: %w", timeout, err)
+		case err != nil:
+			return zero, err
+		case isTerminal:
+			return value, nil
+		}
+
+		timer := time.NewTimer(backoff.Next())
+		select {
+		case <-pollCtx.Done():
+			timer.Stop()
+			if ctx.Err() != nil {
+				return zero, ctx.Err()
+			}
+			return zero, fmt.Errorf("timeout after %s", timeout)
+		case <-timer.C:
+		}
+	}
+}
+
+// WaitBackoff returns the intervals between the polls of the waiters.
+// The interval starts at the initial interval, or the default interval of the waiter,
+// and doubles every few polls up to the maximum interval.
+type WaitBackoff struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	polls       int
+}
+
+// NewWaitBackoff returns the backoff of a waiter whose default interval is defaultInterval.
+func NewWaitBackoff(ctx context.Context, defaultInterval time.Duration) *WaitBackoff {
+	if DefaultWaitRetryInterval != nil {
+		return &WaitBackoff{interval: *DefaultWaitRetryInterval, maxInterval: *DefaultWaitRetryInterval}
+	}
+	initialInterval, maxInterval := WaitOptionsFromContext(ctx).intervals(defaultInterval)
+	return &WaitBackoff{interval: initialInterval, maxInterval: maxInterval}
+}
+
+// Next returns the interval to wait before the next poll.
+func (b *WaitBackoff) Next() time.Duration {
+	if b.polls == pollsPerInterval {
+		b.interval = min(2*b.interval, b.maxInterval)
+		b.polls = 0
+	}
+	b.polls++
+	return b.interval
+}
@@ -0,0 +1,113 @@
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoll(t *testing.T) {
+	calls := 0
+	res, err := transport.Poll(context.Background(), time.Millisecond, time.Minute, func(_ context.Context) (string, bool, error) {
+		calls++
+		return "ready", calls == 3, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ready", res)
+	assert.Equal(t, 3, calls)
+}
+
+func TestPollStopsOnError(t *testing.T) {
+	calls := 0
+	_, err := transport.Poll(context.Background(), time.Millisecond, time.Minute, func(_ context.Context) (string, bool, error) {
+		calls++
+		return "", false, errors.New("server is in error state")
+	})
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestPollTimeout(t *testing.T) {
+	_, err := transport.Poll(context.Background(), time.Millisecond, 20*time.Millisecond, func(_ context.Context) (string, bool, error) {
+		return "", false, nil
+	})
+	require.EqualError(t, err, "timeout after 20ms")
+}
+
+func TestPollTimeoutDuringRequest(t *testing.T) {
+	_, err := transport.Poll(context.Background(), time.Millisecond, 20*time.Millisecond, func(ctx context.Context) (string, bool, error) {
+		<-ctx.Done()
+		return "", false, ctx.Err()
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "timeout after 20ms")
+}
+
+func TestPollCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := transport.Poll(ctx, time.Millisecond, time.Minute, func(_ context.Context) (string, bool, error) {
+		cancel()
+		return "", false, nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPollDefaultRetryInterval(t *testing.T) {
+	retryInterval := time.Duration(0)
+	transport.DefaultWaitRetryInterval = &retryInterval
+	defer func() { transport.DefaultWaitRetryInterval = nil }()
+
+	start := time.Now()
+	calls := 0
+	_, err := transport.Poll(context.Background(), time.Hour, time.Minute, func(_ context.Context) (string, bool, error) {
+		calls++
+		return "", calls == 5, nil
+	})
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestWaitBackoff(t *testing.T) {
+	initialInterval := time.Second
+	maxInterval := 3 * time.Second
+	ctx := transport.ContextWithWaitOptions(context.Background(), transport.WaitOptions{
+		Backoff:         true,
+		InitialInterval: &initialInterval,
+		MaxInterval:     &maxInterval,
+	})
+
+	backoff := transport.NewWaitBackoff(ctx, 5*time.Second)
+	intervals := []time.Duration(nil)
+	for range 10 {
+		intervals = append(intervals, backoff.Next()/time.Second)
+	}
+	assert.Equal(t, []time.Duration{1, 1, 1, 1, 2, 2, 2, 2, 3, 3}, intervals)
+}
+
+func TestWaitBackoffWithoutOptions(t *testing.T) {
+	// Without the wait block of the provider, the waiters keep polling at their default interval
+	backoff := transport.NewWaitBackoff(context.Background(), 5*time.Second)
+	for range 10 {
+		assert.Equal(t, 5*time.Second, backoff.Next())
+	}
+}
+
+func TestWaitBackoffDefaultMaxInterval(t *testing.T) {
+	initialInterval := 10 * time.Second
+	ctx := transport.ContextWithWaitOptions(context.Background(), transport.WaitOptions{
+		Backoff:         true,
+		InitialInterval: &initialInterval,
+	})
+
+	backoff := transport.NewWaitBackoff(ctx, 5*time.Second)
+	interval := time.Duration(0)
+	for range 20 {
+		interval = backoff.Next()
+	}
+	assert.Equal(t, transport.DefaultWaitMaxInterval, interval)
+}
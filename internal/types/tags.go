@@ -0,0 +1,106 @@
+package types
+
+import (
+	"sort"
+	"strings"
+)
+
+// MergeTags returns the resource tags followed by the default tags that are not already present.
+func MergeTags(tags []string, defaultTags []string) []string {
+	merged := make([]string, 0, len(tags)+len(defaultTags))
+	merged = append(merged, tags...)
+	for _, tag := range defaultTags {
+		if !SliceContainsString(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+	return merged
+}
+
+// RemoveDefaultTags removes the default tags from a list of tags returned by the API,
+// unless they were explicitly configured on the resource.
+func RemoveDefaultTags(tags []string, defaultTags []string, configuredTags []string) []string {
+	filtered := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if SliceContainsString(defaultTags, tag) && !SliceContainsString(configuredTags, tag) {
+			continue
+		}
+		filtered = append(filtered, tag)
+	}
+	return filtered
+}
+
+// ExpandTagsMap converts a list of "key=value" tags to a map.
+// A tag without "=" is converted to a key with an empty value.
+func ExpandTagsMap(tags []string) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		key, value, _ := strings.Cut(tag, "=")
+		m[key] = value
+	}
+	return m
+}
+
+// FlattenTagsMap converts a map of tags to a sorted list of "key=value" tags.
+func FlattenTagsMap(m map[string]string) []string {
+	tags := make([]string, 0, len(m))
+	for key, value := range m {
+		tags = append(tags, key+"="+value)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// IgnoreTags describes tags managed outside of terraform, identified by their key.
+// The key of a tag is the part before "=", or the whole tag if it has no value.
+type IgnoreTags struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// Match returns true if the tag should be ignored.
+func (i *IgnoreTags) Match(tag string) bool {
+	if i == nil {
+		return false
+	}
+	key, _, _ := strings.Cut(tag, "=")
+	if SliceContainsString(i.Keys, key) {
+		return true
+	}
+	for _, prefix := range i.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns the tags that are not ignored.
+func (i *IgnoreTags) Filter(tags []string) []string {
+	if i == nil {
+		return tags
+	}
+	filtered := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if !i.Match(tag) {
+			filtered = append(filtered, tag)
+		}
+	}
+	return filtered
+}
+
+// Ignored returns the tags that are ignored.
+func (i *IgnoreTags) Ignored(tags []string) []string {
+	ignored := []string(nil)
+	for _, tag := range tags {
+		if i.Match(tag) {
+			ignored = append(ignored, tag)
+		}
+	}
+	return ignored
+}
+
+// IsEmpty returns true if no tag is ignored.
+func (i *IgnoreTags) IsEmpty() bool {
+	return i == nil || len(i.Keys) == 0 && len(i.KeyPrefixes) == 0
+}
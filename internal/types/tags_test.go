@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeTags(t *testing.T) {
+	assert.Equal(t, []string{"web", "team=infra", "env=prod"}, types.MergeTags([]string{"web", "team=infra"}, []string{"env=prod", "team=infra"}))
+	assert.Equal(t, []string{"env=prod"}, types.MergeTags(nil, []string{"env=prod"}))
+	assert.Equal(t, []string{"web"}, types.MergeTags([]string{"web"}, nil))
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	apiTags := []string{"web", "team=infra", "env=prod"}
+	defaultTags := []string{"env=prod", "team=infra"}
+
+	assert.Equal(t, []string{"web"}, types.RemoveDefaultTags(apiTags, defaultTags, []string{"web"}))
+	assert.Equal(t, []string{"web", "team=infra"}, types.RemoveDefaultTags(apiTags, defaultTags, []string{"web", "team=infra"}))
+	assert.Equal(t, apiTags, types.RemoveDefaultTags(apiTags, nil, nil))
+}
+
+func TestTagsMap(t *testing.T) {
+	m := types.ExpandTagsMap([]string{"env=prod", "web", "url=a=b"})
+	assert.Equal(t, map[string]string{"env": "prod", "web": "", "url": "a=b"}, m)
+	assert.Equal(t, []string{"env=prod", "url=a=b", "web="}, types.FlattenTagsMap(m))
+}
+
+func TestIgnoreTags(t *testing.T) {
+	ignoreTags := &types.IgnoreTags{
+		Keys:        []string{"cost-center"},
+		KeyPrefixes: []string{"kapsule"},
+	}
+	tags := []string{"web", "cost-center=42", "kapsule-pool=default", "kapsule", "env=cost-center"}
+
+	assert.Equal(t, []string{"web", "env=cost-center"}, ignoreTags.Filter(tags))
+	assert.Equal(t, []string{"cost-center=42", "kapsule-pool=default", "kapsule"}, ignoreTags.Ignored(tags))
+	assert.False(t, ignoreTags.IsEmpty())
+
+	var noIgnoreTags *types.IgnoreTags
+	assert.Equal(t, tags, noIgnoreTags.Filter(tags))
+	assert.Nil(t, noIgnoreTags.Ignored(tags))
+	assert.True(t, noIgnoreTags.IsEmpty())
+}
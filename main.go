@@ -5,10 +5,9 @@ import (
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal/provider"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal/tracing"
 )
 
 func main() {
@@ -22,21 +21,24 @@ func main() {
 		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
 	}
 
-	providers := []func() tfprotov5.ProviderServer{
-		// Provider using terraform-plugin-sdk
-		provider.Provider(provider.DefaultConfig())().GRPCProvider,
+	providerServer, err := provider.NewProviderServer(ctx, provider.DefaultConfig())
+	if err != nil {
+		log.Fatal(err)
 	}
 
-	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	shutdownTracing, err := tracing.Init(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	err = tf5server.Serve(
 		"registry.terraform.io/scaleway/scaleway",
-		muxServer.ProviderServer,
+		providerServer,
 		serveOpts...,
 	)
+	if shutdownErr := shutdownTracing(ctx); shutdownErr != nil {
+		log.Printf("[WARN] failed to flush traces: %s", shutdownErr)
+	}
 	if err != nil {
 		log.Fatal(err)
 	}